@@ -6,21 +6,29 @@ import (
 	"api/meetings"
 	"api/messages"
 	"api/middlewares"
+	"api/openapi"
 	"api/session"
 	"api/users"
+	"context"
 	"fmt"
-	"log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"interfaces"
 	"net/http"
 	"os"
 	"plugins/config"
 	"plugins/logger"
+	"plugins/tracer"
 	"repositories"
 	"services"
 	"time"
 )
 
+const attachmentsCleanupPeriod = time.Hour
+
 var (
 	addr string
+	// exports spans which are not exported yet
+	shutdownTracing func(context.Context) error
 )
 
 func init() {
@@ -29,47 +37,110 @@ func init() {
 		logger.Error(err)
 		os.Exit(1)
 	}
+	logger.SetLevel(configs.LogLevel)
+
+	shutdownTracing, err = tracer.SetUp(configs.TracingExporter)
+	if err != nil {
+		logger.Error(err)
+		os.Exit(1)
+	}
+
+	if err := services.SetValidationRules(configs.ValidationRules); err != nil {
+		logger.Error(err)
+		os.Exit(1)
+	}
 
 	addr = fmt.Sprintf("0.0.0.0:%s", configs.Port)
-	meetingsRepository := repositories.Meetings(configs.DB)
-	chatsRepository := repositories.Chat(configs.DB)
+	db, queryTimeout := configs.DB, configs.QueryTimeout
+	meetingsRepository := repositories.Meetings(db, queryTimeout)
+	chatsRepository := repositories.Chat(db, queryTimeout)
+	messagesRepository := repositories.Messages(db, queryTimeout)
+	chatAccessorService := services.ChatAccessor(chatsRepository)
+	directChatService := services.DirectChat(chatsRepository, repositories.UsersPrivacy(db, queryTimeout))
 	sessionService := services.Session(configs.CoderKey)
+	contentChecker := services.ContentChecker(configs.ContentFilter, repositories.Moderation(db, queryTimeout))
 	checkSessionMiddleware := middlewares.AuthSession{Service: sessionService}.HasValidSession
+	attachmentsService := services.Attachments(
+		repositories.Attachments(db, queryTimeout),
+		repositories.LocalBlobStore(configs.AttachmentsDir, queryTimeout),
+		configs.AttachmentLimits,
+	)
+	go deleteOrphanAttachments(attachmentsService, configs.AttachmentOrphanTTL)
 
+	api.GetRouter().Use(middlewares.RequestId)
+	api.GetRouter().Use(middlewares.Tracing)
+	api.GetRouter().Use(middlewares.Metrics)
 	api.GetRouter().Use(middlewares.CsrfToken{PrivateKey: configs.CsrfPrivateKey}.Check)
 	chats.InitRequestHandlers(
-		services.Chat(chatsRepository),
-		services.ChatAccessor(chatsRepository),
+		services.Chat(chatsRepository, chatsRepository),
+		chatAccessorService,
+		directChatService,
+		services.ChatTranscript(chatsRepository, chatsRepository),
 		checkSessionMiddleware,
 	)
 	meetings.InitRequestHandlers(
-		services.Meetings(meetingsRepository),
-		services.Participation(repositories.UserSettings(configs.DB), repositories.MeetingsSettings(configs.DB)),
+		services.Meetings(meetingsRepository, contentChecker),
+		services.Participation(
+			repositories.UserSettings(db, queryTimeout),
+			repositories.MeetingsSettings(db, queryTimeout),
+			chatsRepository,
+		),
 		services.MeetingsAccessor(meetingsRepository),
 		checkSessionMiddleware,
 	)
 	messages.InitRequestHandlers(
-		services.Messages(repositories.Messages(configs.DB)),
+		services.Messages(messagesRepository, contentChecker),
+		chatAccessorService,
+		directChatService,
+		services.ReadReceipts(repositories.ReadReceipts(db, queryTimeout)),
+		services.MessagesEditor(messagesRepository, configs.MessageEditWindow),
+		services.Reactions(messagesRepository),
+		attachmentsService,
+		services.MessagesSearch(messagesRepository),
+		services.Notifications(messagesRepository),
+		configs.MessageRateLimits,
+		configs.AttachmentLimits.UploadTimeout,
 		checkSessionMiddleware,
 	)
 	session.InitRequestHandlers(
-		services.Authentication(repositories.Credentials(configs.DB)),
+		services.Authentication(repositories.Credentials(db, queryTimeout)),
 		sessionService,
 		checkSessionMiddleware,
 	)
 	users.InitRequestHandlers(
-		services.UserSettings(repositories.UserSettings(configs.DB)),
+		services.UserSettings(repositories.UserSettings(db, queryTimeout), contentChecker),
+		services.UsersPrivacy(repositories.UsersPrivacy(db, queryTimeout)),
 		checkSessionMiddleware,
 	)
+	openapi.InitRequestHandlers()
+}
+
+func deleteOrphanAttachments(service interfaces.Attachments, ttl time.Duration) {
+	for range time.Tick(attachmentsCleanupPeriod) {
+		count, err := service.DeleteOrphans(context.Background(), ttl)
+		if err != nil {
+			logger.ErrorF("Error while deleting orphan attachments: %v", err)
+		} else if count != 0 {
+			logger.Info(fmt.Sprintf("Orphan attachments deleted: %d", count))
+		}
+	}
 }
 
 func main() {
 	logger.Info("Starting application on address " + addr)
 
-	log.Fatal((&http.Server{
-		Handler:      api.GetRouter(),
+	// metrics are served outside of API prefix, so they are not proxied to clients
+	handler := http.NewServeMux()
+	handler.Handle("/metrics", promhttp.Handler())
+	handler.Handle("/", api.GetRouter())
+
+	err := (&http.Server{
+		Handler:      handler,
 		Addr:         addr,
-		WriteTimeout: 15 * time.Second,
-		ReadTimeout:  15 * time.Second,
-	}).ListenAndServe())
+		WriteTimeout: api.WriteTimeout,
+		ReadTimeout:  api.ReadTimeout,
+	}).ListenAndServe()
+	logger.Error(err)
+	_ = shutdownTracing(context.Background())
+	os.Exit(1)
 }
@@ -7,41 +7,61 @@ import (
 	"models"
 	"net/http"
 	"plugins/logger"
+	"runtime/debug"
+	serviceErrors "services/errors"
 )
 
 const (
 	StatusOk    = "ok"
 	StatusError = "error"
+	// all routes are mounted under version prefix, incompatible changes of API go to the next version
+	BasePath = "/api/v1"
+	// id of request is sent back in response, so client can find logs of request by it
+	RequestIdHeader = "X-Request-ID"
 )
 
 var r *mux.Router
 
 func init() {
-	r = mux.NewRouter()
+	r = mux.NewRouter().PathPrefix(BasePath).Subrouter()
 }
 
 func GetRouter() *mux.Router {
 	return r
 }
 
-func DecodeRequestBody(r *http.Request, target interface{}) {
+// HandlerFunc is a handler which returns errors instead of sending them, so handlers do not
+// depend on how errors are encoded and can be tested by calling them directly
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+func (h HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	defer recoverPanic(w, r)
+
+	if err := h(w, r); err != nil {
+		SendError(w, r, err)
+	}
+}
+
+func DecodeRequestBody(r *http.Request, target interface{}) error {
 	requestBody, err := ioutil.ReadAll(r.Body)
 	if err != nil {
-		logger.ErrorF("Error while reading request body: %v", err)
-		panic(ReadRequestBodyError)
+		logger.WithContext(r.Context()).ErrorF("Error while reading request body: %v", err)
+		return ReadRequestBodyError
 	}
 
 	err = json.Unmarshal(requestBody, target)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(r.Context(), logger.Fields{
 			MessageTemplate: "Error while decoding request body: %v",
 			Args: []interface{}{
 				err,
 			},
-		}, logger.Error)
+		}, logger.ErrorLevel)
 
-		panic(CannotDecodeRequestBody)
+		return CannotDecodeRequestBody
 	}
+
+	return nil
 }
 
 func SendDefaultResponse(w http.ResponseWriter) {
@@ -59,38 +79,59 @@ func EncodeAndSendResponse(w http.ResponseWriter, v interface{}) {
 	makeResponse(w, output)
 }
 
-func SendErrorIfPanicked(w http.ResponseWriter) {
+// SendError sends error response with status found by error, errors which are not
+// application errors are treated as application errors with the same original error
+func SendError(w http.ResponseWriter, r *http.Request, err error) {
+	appError, isAppError := err.(ApplicationError)
+	if !isAppError {
+		appError = ApplicationError{OriginalError: err}
+	}
+
+	status := appError.StatusCode()
+	log := logger.WithContext(r.Context())
+	if status >= http.StatusInternalServerError {
+		log.ErrorF("Error while handling %s %s: %v", r.Method, r.URL.Path, appError)
+	} else {
+		log.WarningF("Error while handling %s %s: %v", r.Method, r.URL.Path, appError)
+	}
+
+	output, _ := json.Marshal(NewErrorResponse(appError, RequestLanguage(r)))
+	makeResponseWithStatus(w, status, output)
+}
+
+// panic is a bug, not a business error, so it is logged with stack and client gets internal error
+func recoverPanic(w http.ResponseWriter, r *http.Request) {
 	if err := recover(); err != nil {
-		logger.WarningF("Panicked: %v", err)
-
-		err, isAppError := err.(ApplicationError)
-		if isAppError {
-			output, _ := json.Marshal(models.ErrorResponse{
-				Status:      StatusError,
-				ErrorDetail: err.Error(),
-			})
-			makeResponse(w, output)
-		} else {
-			sendInternalError(w, err)
-		}
+		logger.WithContext(r.Context()).ErrorF(
+			"Panicked while handling %s %s: %v\n%s", r.Method, r.URL.Path, err, debug.Stack())
+		SendError(w, r, ApplicationError{
+			OriginalError: serviceErrors.InternalError,
+			Status:        http.StatusInternalServerError,
+		})
 	}
 }
 
 func makeResponse(w http.ResponseWriter, data []byte) {
+	makeResponseWithStatus(w, http.StatusOK, data)
+}
+
+func makeResponseWithStatus(w http.ResponseWriter, status int, data []byte) {
 	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(status)
 
 	if _, err := w.Write(data); err != nil {
 		sendInternalError(w, err)
 	}
 }
 
+// request is not available here, so its id is taken from response header
 func sendInternalError(w http.ResponseWriter, err interface{}) {
-	logger.WithFields(logger.Fields{
+	logger.WithRequestId(w.Header().Get(RequestIdHeader)).WithFields(logger.Fields{
 		MessageTemplate: "Error while trying to write response: %v",
 		Args: []interface{}{
 			err,
 		},
-	}, logger.Error)
+	}, logger.ErrorLevel)
 
 	http.Error(w, "internal server error", http.StatusInternalServerError)
 }
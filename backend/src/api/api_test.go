@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"models"
+	"net/http"
+	"net/http/httptest"
+	serviceErrors "services/errors"
+	"strings"
+	"testing"
+	"utils"
+)
+
+func serve(handler HandlerFunc, request *http.Request) (*httptest.ResponseRecorder, models.ErrorResponse) {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, request)
+
+	var response models.ErrorResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &response)
+	return w, response
+}
+
+func TestHandlerFunc_Success(t *testing.T) {
+	w, response := serve(func(w http.ResponseWriter, r *http.Request) error {
+		SendDefaultResponse(w)
+		return nil
+	}, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	utils.AssertEqual(http.StatusOK, w.Code, t)
+	utils.AssertEqual(StatusOk, response.Status, t)
+}
+
+func TestHandlerFunc_ReturnedError(t *testing.T) {
+	w, response := serve(func(w http.ResponseWriter, r *http.Request) error {
+		return serviceErrors.MeetingIdNotFound
+	}, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	utils.AssertEqual(http.StatusNotFound, w.Code, t)
+	utils.AssertEqual(StatusError, response.Status, t)
+	utils.AssertEqual(serviceErrors.MeetingIdNotFound.Error(), response.ErrorDetail, t)
+}
+
+func TestHandlerFunc_UnknownError(t *testing.T) {
+	w, response := serve(func(w http.ResponseWriter, r *http.Request) error {
+		return errors.New("unknown-error")
+	}, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	utils.AssertEqual(http.StatusInternalServerError, w.Code, t)
+	utils.AssertEqual("unknown-error", response.ErrorDetail, t)
+}
+
+func TestHandlerFunc_Panic(t *testing.T) {
+	w, response := serve(func(w http.ResponseWriter, r *http.Request) error {
+		var messages map[string]string
+		messages["key"] = "value"
+		return nil
+	}, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	utils.AssertEqual(http.StatusInternalServerError, w.Code, t)
+	utils.AssertEqual("application/json", w.Header().Get("content-type"), t)
+	utils.AssertEqual(serviceErrors.InternalError.Error(), response.ErrorDetail, t)
+}
+
+func TestDecodeRequestBody(t *testing.T) {
+	var target models.DefaultResponse
+	err := DecodeRequestBody(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"status":"ok"}`)), &target)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(StatusOk, target.Status, t)
+}
+
+func TestDecodeRequestBody_InvalidJSON(t *testing.T) {
+	var target models.DefaultResponse
+	err := DecodeRequestBody(httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")), &target)
+
+	utils.AssertEqual(CannotDecodeRequestBody, err, t)
+}
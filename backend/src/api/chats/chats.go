@@ -2,6 +2,7 @@ package chats
 
 import (
 	"api"
+	"api/messages"
 	"github.com/gorilla/mux"
 	"interfaces"
 	"models"
@@ -12,93 +13,134 @@ import (
 type Handler struct {
 	chat         interfaces.Chat
 	chatAccessor interfaces.ChatAccessor
+	directChat   interfaces.DirectChat
+	transcript   interfaces.ChatTranscript
 }
 
 func InitRequestHandlers(
 	chat interfaces.Chat,
 	chatAccessor interfaces.ChatAccessor,
+	directChat interfaces.DirectChat,
+	transcript interfaces.ChatTranscript,
 	middlewares ...mux.MiddlewareFunc,
 ) {
-	handler := Handler{chat, chatAccessor}
+	handler := Handler{chat, chatAccessor, directChat, transcript}
 	chatsAPI := api.GetRouter().PathPrefix("/chat").Subrouter()
 	for _, middleware := range middlewares {
 		chatsAPI.Use(middleware)
 	}
 
-	chatsAPI.HandleFunc("/meeting/{id:[0-9]+}", handler.getMeetingChat).Methods(http.MethodGet)
-	chatsAPI.HandleFunc("/user/{id:[0-9]+}", handler.getUserChats).Methods(http.MethodGet)
-	chatsAPI.HandleFunc("/meeting", handler.createMeetingChat).Methods(http.MethodPost)
-	chatsAPI.HandleFunc("/meeting/request", handler.createMeetingRequestChat).Methods(http.MethodPost)
-	chatsAPI.HandleFunc("/meeting", handler.closeChat).Methods(http.MethodDelete)
-	chatsAPI.HandleFunc("/meeting/request", handler.closeChat).Methods(http.MethodDelete)
+	chatsAPI.Handle("/meeting/{id:[0-9]+}", api.HandlerFunc(handler.getMeetingChat)).Methods(http.MethodGet)
+	chatsAPI.Handle("/user/{id:[0-9]+}", api.HandlerFunc(handler.getUserChats)).Methods(http.MethodGet)
+	chatsAPI.Handle(
+		"/direct/{user_id:[0-9]+}/{interlocutor_id:[0-9]+}", api.HandlerFunc(handler.getDirectChat)).Methods(http.MethodGet)
+	chatsAPI.Handle(
+		"/export/{chat_id:[0-9]+}/{admin_id:[0-9]+}", api.HandlerFunc(handler.exportChat)).Methods(http.MethodGet)
+	chatsAPI.Handle("/meeting", api.HandlerFunc(handler.createMeetingChat)).Methods(http.MethodPost)
+	chatsAPI.Handle("/meeting/request", api.HandlerFunc(handler.createMeetingRequestChat)).Methods(http.MethodPost)
+	chatsAPI.Handle("/meeting", api.HandlerFunc(handler.closeChat)).Methods(http.MethodDelete)
+	chatsAPI.Handle("/meeting/request", api.HandlerFunc(handler.closeChat)).Methods(http.MethodDelete)
+	chatsAPI.Handle("/reopen", api.HandlerFunc(handler.reopenChat)).Methods(http.MethodPost)
 }
 
-func (h Handler) getMeetingChat(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) getMeetingChat(w http.ResponseWriter, r *http.Request) error {
 	vars := mux.Vars(r)
 	// checking of this parameter will be performed in validation proxy
 	meetingId, _ := strconv.Atoi(vars["id"])
-	chat, err := h.chatAccessor.GetMeetingChat(uint(meetingId))
+	chat, err := h.chatAccessor.GetMeetingChat(r.Context(), uint(meetingId))
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.EncodeAndSendResponse(w, chat)
+	return nil
 }
 
-func (h Handler) getUserChats(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) getUserChats(w http.ResponseWriter, r *http.Request) error {
 	vars := mux.Vars(r)
 	// checking of this parameter will be performed in validation proxy
 	userId, _ := strconv.Atoi(vars["id"])
-	chats, err := h.chatAccessor.GetUserChats(uint(userId))
+	chats, err := h.chatAccessor.GetUserChats(r.Context(), uint(userId))
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.EncodeAndSendResponse(w, chats)
+	return nil
 }
 
-func (h Handler) createMeetingChat(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
+func (h Handler) getDirectChat(w http.ResponseWriter, r *http.Request) error {
+	vars := mux.Vars(r)
+	// checking of these parameters will be performed in validation proxy
+	userId, _ := strconv.Atoi(vars["user_id"])
+	interlocutorId, _ := strconv.Atoi(vars["interlocutor_id"])
+	chat, err := h.directChat.GetDirectChat(r.Context(), uint(userId), uint(interlocutorId))
+	if err != nil {
+		return err
+	}
 
+	api.EncodeAndSendResponse(w, chat)
+	return nil
+}
+
+func (h Handler) createMeetingChat(w http.ResponseWriter, r *http.Request) error {
 	var request models.GeneralMeetingRequest
-	api.DecodeRequestBody(r, &request)
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	err := h.chat.CreateMeetingChat(request.MeetingId)
+	err := h.chat.CreateMeetingChat(r.Context(), request.MeetingId)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
 
-func (h Handler) createMeetingRequestChat(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
-	var request models.GeneralMeetingRequest
-	api.DecodeRequestBody(r, &request)
+func (h Handler) createMeetingRequestChat(w http.ResponseWriter, r *http.Request) error {
+	var request models.MeetingUserRequest
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	err := h.chat.CreateMeetingRequestChat(request.MeetingId)
+	err := h.chat.CreateMeetingRequestChat(r.Context(), request.MeetingId, request.UserId)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
 
-func (h Handler) closeChat(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) closeChat(w http.ResponseWriter, r *http.Request) error {
 	var request models.CloseChatRequest
-	api.DecodeRequestBody(r, &request)
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
+
+	err := h.chat.CloseChat(r.Context(), request.ChatId)
+	if err != nil {
+		return err
+	}
+
+	messages.SendChatEventToChatConnections(messages.ChatArchivedFrameType, request.ChatId)
+	api.SendDefaultResponse(w)
+	return nil
+}
+
+func (h Handler) reopenChat(w http.ResponseWriter, r *http.Request) error {
+	var request models.ReopenChatRequest
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	err := h.chat.CloseChat(request.ChatId)
+	err := h.chat.ReopenChat(r.Context(), request.ChatId, request.AdminId)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
+	messages.SendChatEventToChatConnections(messages.ChatReopenedFrameType, request.ChatId)
 	api.SendDefaultResponse(w)
+	return nil
 }
@@ -3,6 +3,7 @@ package chats
 import (
 	"api"
 	"api/middlewares"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"github.com/jmoiron/sqlx"
@@ -14,6 +15,7 @@ import (
 	"models"
 	"os"
 	"plugins/config"
+	"plugins/transcript"
 	"repositories"
 	"services"
 	"services/errors"
@@ -45,10 +47,12 @@ func init() {
 	}
 
 	sessionService = services.Session(coderKey)
-	chatRepository := repositories.Chat(db)
+	chatRepository := repositories.Chat(db, repositoriesMock.QueryTimeout)
 	InitRequestHandlers(
-		services.Chat(chatRepository),
+		services.Chat(chatRepository, chatRepository),
 		services.ChatAccessor(chatRepository),
+		services.DirectChat(chatRepository, repositories.UsersPrivacy(db, repositoriesMock.QueryTimeout)),
+		services.ChatTranscript(chatRepository, chatRepository),
 		middlewares.AuthSession{Service: sessionService}.HasValidSession,
 	)
 }
@@ -227,6 +231,19 @@ func TestCreateMeetingRequestChat_Success(t *testing.T) {
 	utils.AssertEqual(api.StatusOk, response.Status, t)
 }
 
+func TestCreateMeetingRequestChat_AlreadyExists(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(chatAPIMock.CreateExistingMeetingRequestChatRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(errors.RequestChatExists.Error(), response.ErrorDetail, t)
+}
+
 func TestCreateMeetingRequestChat_NoSession(t *testing.T) {
 	repositoriesMock.InitTables(db)
 	defer repositoriesMock.DropTables(db)
@@ -381,3 +398,123 @@ func TestCloseMeetingRequestChat_InternalError(t *testing.T) {
 	utils.AssertEqual(api.StatusError, response.Status, t)
 	utils.AssertEqual(errors.InternalError.Error(), response.ErrorDetail, t)
 }
+
+func TestGetDirectChat_Success(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var response chatAPIMock.MeetingChatResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(chatAPIMock.GetDirectChatRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+	utils.AssertEqual(repositoriesMock.FirstDirectChatId, response.Data.Id, t)
+	utils.AssertEqual(repositoriesMock.DirectType, response.Data.Type, t)
+}
+
+func TestGetDirectChat_NotFound(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(chatAPIMock.GetNotExistsDirectChatRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(errors.DirectChatNotFound.Error(), response.ErrorDetail, t)
+}
+
+func TestGetDirectChat_InvalidId(t *testing.T) {
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(chatAPIMock.GetDirectChatInvalidIdRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(validation.InvalidId, response.ErrorDetail, t)
+}
+
+func TestExportChat_JSON(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var messages []models.TranscriptMessage
+	err := json.NewDecoder(utils.MakeRequest(chatAPIMock.ExportChatRequest(
+		router, repositoriesMock.GetFirstChatExportRequest(transcript.JSONFormat)))).Decode(&messages)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(repositoriesMock.GetChatMessagesCount(1), len(messages), t)
+	utils.AssertEqual(repositoriesMock.GetUserNickname(messages[0].SenderId), messages[0].SenderNickname, t)
+}
+
+func TestExportChat_CSV(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	records, err := csv.NewReader(utils.MakeRequest(chatAPIMock.ExportChatRequest(
+		router, repositoriesMock.GetFirstChatExportRequest(transcript.CSVFormat)))).ReadAll()
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(repositoriesMock.GetChatMessagesCount(1)+1, len(records), t)
+}
+
+func TestExportChat_NotAdmin(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	request := repositoriesMock.GetFirstChatExportRequest(transcript.TextFormat)
+	request.AdminId++
+	var response models.ErrorResponse
+	err := json.NewDecoder(utils.MakeRequest(chatAPIMock.ExportChatRequest(router, request))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(errors.ChatExportForbidden.Error(), response.ErrorDetail, t)
+}
+
+func TestExportChat_InvalidFormat(t *testing.T) {
+	var response models.ErrorResponse
+	err := json.NewDecoder(utils.MakeRequest(chatAPIMock.ExportChatRequest(
+		router, repositoriesMock.GetFirstChatExportRequest("xml")))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(validation.InvalidTranscriptFormat, response.ErrorDetail, t)
+}
+
+func TestReopenChat_Success(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	_ = utils.MakeRequest(chatAPIMock.CloseMeetingChatRequest(router))
+	var response models.DefaultResponse
+	err := json.NewDecoder(utils.MakeRequest(
+		chatAPIMock.ReopenChatRequest(router, 1, repositoriesMock.GetChatMeetingAdminId(1)))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+}
+
+func TestReopenChat_NotAdmin(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(utils.MakeRequest(
+		chatAPIMock.ReopenChatRequest(router, 1, repositoriesMock.GetChatMeetingAdminId(1)+1))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(errors.ChatReopenForbidden.Error(), response.ErrorDetail, t)
+}
+
+func TestReopenChat_InvalidId(t *testing.T) {
+	var response models.ErrorResponse
+	err := json.NewDecoder(utils.MakeRequest(chatAPIMock.ReopenChatRequest(router, 0, 1))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(validation.InvalidId, response.ErrorDetail, t)
+}
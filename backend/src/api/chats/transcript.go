@@ -0,0 +1,74 @@
+package chats
+
+import (
+	"api"
+	"github.com/gorilla/mux"
+	"mime"
+	"models"
+	"net/http"
+	"plugins/logger"
+	"plugins/transcript"
+	"strconv"
+)
+
+const defaultTranscriptFormat = transcript.JSONFormat
+
+// headers of transcript are set only before its first part,
+// so errors found before export are sent as usual JSON response
+type transcriptWriter struct {
+	w       http.ResponseWriter
+	request models.ChatExportRequest
+	started bool
+}
+
+func (t *transcriptWriter) Write(p []byte) (int, error) {
+	t.start()
+	return t.w.Write(p)
+}
+
+func (t *transcriptWriter) start() {
+	if t.started {
+		return
+	}
+
+	t.started = true
+	t.w.Header().Set("content-type", transcript.ContentType(t.request.Format))
+	t.w.Header().Set("content-disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": transcript.FileName(t.request.ChatId, t.request.Format),
+	}))
+}
+
+// format is passed in optional format query parameter (json, csv or text), json is used by default,
+// transcript can be sent longer than server write timeout, so it is limited only by connection
+func (h Handler) exportChat(w http.ResponseWriter, r *http.Request) error {
+	if err := api.LiftWriteDeadline(w); err != nil {
+		logger.WithContext(r.Context()).ErrorF("Error while lifting write deadline of export: %v", err)
+	}
+
+	vars := mux.Vars(r)
+	// checking of these parameters will be performed in validation proxy
+	chatId, _ := strconv.Atoi(vars["chat_id"])
+	adminId, _ := strconv.Atoi(vars["admin_id"])
+	request := models.ChatExportRequest{
+		ChatId:  uint(chatId),
+		AdminId: uint(adminId),
+		Format:  r.URL.Query().Get("format"),
+	}
+	if request.Format == "" {
+		request.Format = defaultTranscriptFormat
+	}
+
+	writer := &transcriptWriter{w: w, request: request}
+	err := h.transcript.ExportChat(r.Context(), request, writer)
+	switch {
+	case err != nil && !writer.started:
+		return err
+	case err != nil:
+		// part of transcript is already sent, so error can only be logged
+		logger.WithContext(r.Context()).ErrorF("Error while sending chat transcript: %v", err)
+	default:
+		// empty transcript has no parts, but its headers are still required
+		writer.start()
+	}
+	return nil
+}
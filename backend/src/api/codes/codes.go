@@ -0,0 +1,19 @@
+package codes
+
+// error details of api packages, they are kept apart so that i18n can import them
+const (
+	ReadRequestBody         = "read-request-body-error"
+	DecodeRequestBody       = "decode-request-body-error"
+	NoSession               = "no-session"
+	NoCSRFCookie            = "no-csrf-cookie"
+	InvalidCSRFCookie       = "invalid-csrf-cookie"
+	NoCSRFHeader            = "no-csrf-header"
+	InvalidCSRFHeader       = "invalid-csrf-header"
+	InvalidCSRFToken        = "invalid-csrf-token"
+	CSRFInternalError       = "csrf-internal-error"
+	ReadJSONFromWS          = "unable-to-read-json-from-ws"
+	UnknownWSFrameType      = "unknown-ws-frame-type"
+	InvalidAttachmentUpload = "invalid-attachment-upload"
+	RateLimited             = "rate-limited"
+	NotChatMember           = "not-chat-member"
+)
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"net/http"
+	"time"
+)
+
+// server deadlines of reading request and writing response, they are counted from the start of request,
+// handlers of bodies that can not be transferred in this time set their own deadlines
+const (
+	ReadTimeout  = 15 * time.Second
+	WriteTimeout = 15 * time.Second
+)
+
+// SetDeadlines replaces server deadlines of request, zero time lifts deadline,
+// middlewares that wrap response writer must let it be unwrapped
+func SetDeadlines(w http.ResponseWriter, read, write time.Time) error {
+	controller := http.NewResponseController(w)
+	if err := controller.SetReadDeadline(read); err != nil {
+		return err
+	}
+
+	return controller.SetWriteDeadline(write)
+}
+
+// LiftWriteDeadline lets handler stream response which size is not known in advance (e.g. export of chat),
+// such response is limited only by connection
+func LiftWriteDeadline(w http.ResponseWriter) error {
+	return http.NewResponseController(w).SetWriteDeadline(time.Time{})
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"utils"
+)
+
+const testServerTimeout = 50 * time.Millisecond
+
+// handler responds after server deadlines have passed
+func getSlowServer(setDeadlines func(w http.ResponseWriter) error) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if setDeadlines != nil {
+			_ = setDeadlines(w)
+		}
+		time.Sleep(3 * testServerTimeout)
+		_, _ = w.Write([]byte("done"))
+	}))
+	server.Config.ReadTimeout, server.Config.WriteTimeout = testServerTimeout, testServerTimeout
+	server.Start()
+
+	return server
+}
+
+func getSlowResponse(server *httptest.Server) (string, error) {
+	response, err := http.Get(server.URL)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	return string(body), err
+}
+
+func TestSetDeadlines_ExtendsServerTimeouts(t *testing.T) {
+	server := getSlowServer(func(w http.ResponseWriter) error {
+		deadline := time.Now().Add(time.Second)
+		return SetDeadlines(w, deadline, deadline)
+	})
+	defer server.Close()
+
+	body, err := getSlowResponse(server)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual("done", body, t)
+}
+
+func TestLiftWriteDeadline_StreamsLongerThanServerTimeout(t *testing.T) {
+	server := getSlowServer(LiftWriteDeadline)
+	defer server.Close()
+
+	body, err := getSlowResponse(server)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual("done", body, t)
+}
+
+func TestSetDeadlines_ServerTimeoutsWithoutThem(t *testing.T) {
+	server := getSlowServer(nil)
+	defer server.Close()
+
+	_, err := getSlowResponse(server)
+
+	utils.AssertNotNil(err, t)
+}
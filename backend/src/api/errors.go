@@ -1,16 +1,64 @@
 package api
 
-import "errors"
+import (
+	"api/codes"
+	"api/i18n"
+	"errors"
+	"models"
+	"net/http"
+	"strings"
+)
 
 type ApplicationError struct {
 	OriginalError error
+	// HTTP status of response, it is found by original error if not set
+	Status int
 }
 
 var (
-	ReadRequestBodyError    = ApplicationError{errors.New("read-request-body-error")}
-	CannotDecodeRequestBody = ApplicationError{errors.New("decode-request-body-error")}
+	ReadRequestBodyError = ApplicationError{
+		OriginalError: errors.New(codes.ReadRequestBody),
+		Status:        http.StatusBadRequest,
+	}
+	CannotDecodeRequestBody = ApplicationError{
+		OriginalError: errors.New(codes.DecodeRequestBody),
+		Status:        http.StatusBadRequest,
+	}
 )
 
+// validation errors describe each invalid field of request
+type fieldsError interface {
+	Fields() []models.FieldError
+}
+
 func (a ApplicationError) Error() string {
 	return a.OriginalError.Error()
 }
+
+// error detail is kept for clients that do not read fields and messages
+func NewErrorResponse(err error, language string) models.ErrorResponse {
+	if appError, isAppError := err.(ApplicationError); isAppError {
+		err = appError.OriginalError
+	}
+
+	response := models.ErrorResponse{
+		Status:      StatusError,
+		ErrorDetail: err.Error(),
+		Message:     i18n.Translate(language, err.Error(), nil),
+	}
+	if fieldsErr, hasFields := err.(fieldsError); hasFields {
+		var messages []string
+		for _, field := range fieldsErr.Fields() {
+			field.Message = i18n.Translate(language, field.Code, field.Params)
+			response.Errors = append(response.Errors, field)
+			messages = append(messages, field.Message)
+		}
+		response.Message = strings.Join(messages, "; ")
+	}
+
+	return response
+}
+
+func RequestLanguage(r *http.Request) string {
+	return i18n.Language(r.Header.Get("Accept-Language"))
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"api/i18n"
+	"context"
+	"models"
+	"services/errors"
+	"services/proxies/validation"
+	"strings"
+	"testing"
+	"utils"
+)
+
+func TestNewErrorResponse_ServiceError(t *testing.T) {
+	response := NewErrorResponse(ApplicationError{OriginalError: errors.ChatIdNotFound}, i18n.Russian)
+
+	utils.AssertEqual(StatusError, response.Status, t)
+	utils.AssertEqual(errors.ChatIdNotFound.Error(), response.ErrorDetail, t)
+	utils.AssertEqual("Чат не найден", response.Message, t)
+	utils.AssertEqual(0, len(response.Errors), t)
+}
+
+func TestNewErrorResponse_ValidationError(t *testing.T) {
+	_, err := validation.NewMessagesProxy(nil, validation.ContentChecker{}).Save(
+		context.Background(), models.Message{ChatId: 1, SenderId: 1, Text: strings.Repeat("a", 2000)})
+	response := NewErrorResponse(ApplicationError{OriginalError: err}, i18n.English)
+
+	utils.AssertEqual(validation.InvalidMessageText, response.ErrorDetail, t)
+	utils.AssertEqual(1, len(response.Errors), t)
+	utils.AssertEqual("text", response.Errors[0].Field, t)
+	utils.AssertEqual("Message must be from 1 to 1024 symbols long and must not contain < and >",
+		response.Errors[0].Message, t)
+	utils.AssertEqual(response.Errors[0].Message, response.Message, t)
+}
@@ -0,0 +1,88 @@
+package i18n
+
+import (
+	"api/codes"
+	"services/errors"
+	"services/proxies/validation"
+	"services/proxies/validation/plugins/content_filter"
+)
+
+var english = map[string]string{
+	errors.InternalError.Error():        "Internal server error, please try again later",
+	errors.UserIdNotFound.Error():       "User not found",
+	errors.UserAlreadyInMeeting.Error(): "User is already a member of the meeting",
+	errors.UserNotInMeeting.Error():     "User is not a member of the meeting",
+	errors.RequestChatExists.Error():    "Participation request chat already exists",
+	errors.RequestNotFound.Error():      "Participation request not found",
+	errors.MeetingIdNotFound.Error():    "Meeting not found",
+	errors.ChatIdNotFound.Error():       "Chat not found",
+	errors.ChatArchived.Error():         "Chat is archived",
+	errors.ChatReopenForbidden.Error():  "Only meeting admin can reopen the chat",
+	errors.DirectChatNotFound.Error():   "Direct chat not found",
+	errors.DirectMessagesDenied.Error(): "User does not accept direct messages",
+	errors.ChatMessageNotFound.Error():  "Message not found in the chat",
+	errors.MessageNotFound.Error():      "Message not found",
+	errors.ReplyMessageNotFound.Error(): "Replied message not found",
+	errors.AttachmentNotFound.Error():   "Attachment not found",
+	errors.AttachmentTooLarge.Error():   "Attachment is too large",
+	errors.AttachmentNotAllowed.Error(): "Attachment type is not allowed",
+	errors.MessageEditForbidden.Error(): "Only author can edit the message",
+	errors.ChatExportForbidden.Error():  "Only meeting admin can export the chat",
+	errors.MessagePinForbidden.Error():  "Only meeting admin can pin messages",
+	errors.AnnouncementDenied.Error():   "Only meeting admin can send announcements",
+	errors.EditWindowExpired.Error():    "Message can not be changed anymore",
+	errors.EmailExists.Error():          "User with this email already exists",
+	errors.CredentialsNotFound.Error():  "Wrong email or password",
+	errors.NoAuthCookie.Error():         "Authorization is required",
+	errors.InvalidAuthCookie.Error():    "Session is invalid, please log in again",
+
+	validation.InvalidEmail:                           "Email is invalid",
+	validation.InvalidPassword:                        "Password must be from {min} to {max} symbols long and contain only latin letters, digits and -_$*%&",
+	validation.InvalidId:                              "Identifier must be a positive number",
+	validation.InvalidMeetingTitle:                    "Title must be from {min} to {max} symbols long and must not contain < and >",
+	validation.InvalidMeetingDescription:              "Description must be from {min} to {max} symbols long and must not contain < and >",
+	validation.InvalidMeetingTag:                      "Tag must be from {min} to {max} symbols long and contain only letters, digits, spaces and .'-",
+	validation.InvalidMeetingMaxUsers:                 "Maximum number of users must be a positive number",
+	validation.InvalidMeetingDuration:                 "Duration must be a positive number",
+	validation.InvalidMeetingMinAge:                   "Minimum age must be a positive number",
+	validation.InvalidMeetingGender:                   "Gender must be male, female or empty",
+	validation.InvalidMeetingLatitude:                 "Latitude must be from -90 to 90",
+	validation.InvalidMeetingLongitude:                "Longitude must be from -180 to 180",
+	validation.InvalidMeetingLabel:                    "Place label must be from {min} to {max} symbols long and must not contain < and >",
+	validation.InvalidParticipationRequestDescription: "Request description must be from {min} to {max} symbols long and must not contain < and >",
+	validation.InvalidUserName:                        "Name must be from {min} to {max} symbols long and contain only letters, digits, spaces and .'-",
+	validation.InvalidUserNickname:                    "Nickname must be from {min} to {max} symbols long and contain only letters, digits and -_$",
+	validation.InvalidUserGender:                      "Gender must be male, female or empty",
+	validation.InvalidUserAge:                         "Age must be a positive number",
+	validation.InvalidUserAvatarURL:                   "Avatar must be a valid URL",
+	validation.InvalidCount:                           "Count must be a positive number",
+	validation.InvalidDate:                            "Date is invalid",
+	validation.InvalidMessageText:                     "Message must be from {min} to {max} symbols long and must not contain < and >",
+	validation.InvalidEmoji:                           "Reaction must be a single emoji",
+	validation.InvalidAttachmentName:                  "File name must be from {min} to {max} symbols long and must not contain slashes",
+	validation.InvalidSearchText:                      "Search text must be from {min} to {max} symbols long",
+	validation.InvalidTranscriptFormat:                "Export format must be json, csv or text",
+	validation.InappropriateMessageText:               "Message contains inappropriate content: {reasons}",
+	validation.InappropriateMeetingTitle:              "Title contains inappropriate content: {reasons}",
+	validation.InappropriateMeetingDescription:        "Description contains inappropriate content: {reasons}",
+	validation.InappropriateMeetingLabel:              "Place label contains inappropriate content: {reasons}",
+	validation.InappropriateUserNickname:              "Nickname contains inappropriate content: {reasons}",
+	content_filter.ProfanityReason:                    "profanity",
+	content_filter.LinkSpamReason:                     "too many links",
+	content_filter.RepeatedCharsReason:                "repeated characters",
+
+	codes.ReadRequestBody:         "Request body can not be read",
+	codes.DecodeRequestBody:       "Request body is not valid JSON",
+	codes.NoSession:               "Authorization is required",
+	codes.NoCSRFCookie:            "CSRF cookie is missing",
+	codes.InvalidCSRFCookie:       "CSRF cookie is invalid",
+	codes.NoCSRFHeader:            "CSRF header is missing",
+	codes.InvalidCSRFHeader:       "CSRF header is invalid",
+	codes.InvalidCSRFToken:        "CSRF token is invalid",
+	codes.CSRFInternalError:       "CSRF token can not be checked, please try again later",
+	codes.ReadJSONFromWS:          "Frame is not valid JSON",
+	codes.UnknownWSFrameType:      "Unknown frame type",
+	codes.InvalidAttachmentUpload: "Attachment upload is invalid",
+	codes.RateLimited:             "Too many messages, please wait a little",
+	codes.NotChatMember:           "User is not a member of chat",
+}
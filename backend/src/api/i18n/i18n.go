@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	English = "en"
+	Russian = "ru"
+
+	DefaultLanguage = English
+)
+
+var catalogs = map[string]map[string]string{
+	English: english,
+	Russian: russian,
+}
+
+// the most preferred supported language of Accept-Language header, default language if none is supported
+func Language(acceptLanguage string) string {
+	type languageRange struct {
+		tag     string
+		quality float64
+	}
+
+	var ranges []languageRange
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		ranges = append(ranges, languageRange{tag, quality})
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	for _, r := range ranges {
+		if r.quality <= 0 {
+			continue
+		}
+		// region is ignored, so ru-RU is Russian
+		language := strings.SplitN(r.tag, "-", 2)[0]
+		if _, supported := catalogs[language]; supported {
+			return language
+		}
+		if language == "*" {
+			return DefaultLanguage
+		}
+	}
+
+	return DefaultLanguage
+}
+
+// params are inserted instead of {name}, unknown code is returned as is
+func Translate(language, code string, params map[string]interface{}) string {
+	catalog, supported := catalogs[language]
+	if !supported {
+		catalog = catalogs[DefaultLanguage]
+	}
+
+	message, found := catalog[code]
+	if !found {
+		if message, found = catalogs[DefaultLanguage][code]; !found {
+			return code
+		}
+	}
+
+	if len(params) == 0 {
+		return message
+	}
+
+	var replacements []string
+	for name, value := range params {
+		replacements = append(replacements, "{"+name+"}", formatParam(catalog, value))
+	}
+	return strings.NewReplacer(replacements...).Replace(message)
+}
+
+// list values are codes (for example reasons of content filter), so they are translated too
+func formatParam(catalog map[string]string, value interface{}) string {
+	values, isList := value.([]string)
+	if !isList {
+		return fmt.Sprint(value)
+	}
+
+	translated := make([]string, 0, len(values))
+	for _, v := range values {
+		if message, found := catalog[v]; found {
+			v = message
+		}
+		translated = append(translated, v)
+	}
+	return strings.Join(translated, ", ")
+}
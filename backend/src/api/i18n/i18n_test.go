@@ -0,0 +1,129 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"services/errors"
+	"services/proxies/validation"
+	"services/proxies/validation/plugins/content_filter"
+	"strconv"
+	"strings"
+	"testing"
+	"utils"
+)
+
+// codes are read from sources, so code added without messages fails the test
+var codeSources = []struct {
+	path   string
+	isCode func(name string) bool
+}{
+	{"../../services/errors/errors.go", isAnyName},
+	{"../../services/proxies/validation/errors.go", isAnyName},
+	{"../../services/proxies/validation/plugins/content_filter/content_filter.go", isReasonName},
+	{"../codes/codes.go", isAnyName},
+}
+
+func isAnyName(string) bool {
+	return true
+}
+
+func isReasonName(name string) bool {
+	return strings.HasSuffix(name, "Reason")
+}
+
+// code is string literal of constant or argument of errors.New
+func getSourceCodes(path string, isCode func(name string) bool, t *testing.T) []string {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	utils.AssertNil(err, t)
+
+	var codes []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, isSpec := node.(*ast.ValueSpec)
+		if !isSpec {
+			return true
+		}
+
+		for i, name := range spec.Names {
+			if i >= len(spec.Values) || !isCode(name.Name) {
+				continue
+			}
+			value := spec.Values[i]
+			if call, isCall := value.(*ast.CallExpr); isCall && len(call.Args) == 1 {
+				value = call.Args[0]
+			}
+			if literal, isLiteral := value.(*ast.BasicLit); isLiteral && literal.Kind == token.STRING {
+				code, _ := strconv.Unquote(literal.Value)
+				codes = append(codes, code)
+			}
+		}
+		return false
+	})
+	return codes
+}
+
+func TestCatalogs_SourceCodesHaveMessages(t *testing.T) {
+	for _, source := range codeSources {
+		codes := getSourceCodes(source.path, source.isCode, t)
+		utils.AssertTrue(len(codes) > 0, t)
+
+		for language, catalog := range catalogs {
+			for _, code := range codes {
+				if _, found := catalog[code]; !found {
+					t.Errorf("no %s message of %s from %s", language, code, source.path)
+				}
+			}
+		}
+	}
+}
+
+func TestCatalogs_SameCodes(t *testing.T) {
+	utils.AssertEqual(len(english), len(russian), t)
+	for code := range english {
+		_, found := russian[code]
+		if !found {
+			t.Errorf("no russian message of %s", code)
+		}
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	for header, language := range map[string]string{
+		"":                               DefaultLanguage,
+		"ru":                             Russian,
+		"ru-RU,ru;q=0.9,en-US;q=0.8":     Russian,
+		"en-US,en;q=0.9,ru;q=0.8":        English,
+		"de-DE,de;q=0.9,ru;q=0.8":        Russian,
+		"en;q=0.5, RU;q=0.7":             Russian,
+		"de, fr":                         DefaultLanguage,
+		"ru;q=0, en;q=0.1":               English,
+		"*":                              DefaultLanguage,
+		"fr;q=0.9, *;q=0.5, ru;q=0.1":    DefaultLanguage,
+		"invalid;;;q=x, ru":              Russian,
+		"zh-Hant-TW, ru-Latn-RU;q=0.001": Russian,
+	} {
+		utils.AssertEqual(language, Language(header), t)
+	}
+}
+
+func TestTranslate_Params(t *testing.T) {
+	message := Translate(Russian, validation.InvalidMessageText, map[string]interface{}{"min": 1, "max": 1024})
+
+	utils.AssertEqual("Сообщение должно быть длиной от 1 до 1024 символов и не содержать < и >", message, t)
+}
+
+func TestTranslate_ListParamIsTranslated(t *testing.T) {
+	message := Translate(English, validation.InappropriateMessageText, map[string]interface{}{
+		"reasons": []string{content_filter.ProfanityReason, content_filter.LinkSpamReason},
+	})
+
+	utils.AssertEqual("Message contains inappropriate content: profanity, too many links", message, t)
+}
+
+func TestTranslate_UnsupportedLanguage(t *testing.T) {
+	utils.AssertEqual(english[errors.ChatArchived.Error()], Translate("de", errors.ChatArchived.Error(), nil), t)
+}
+
+func TestTranslate_UnknownCode(t *testing.T) {
+	utils.AssertEqual("some-unknown-code", Translate(Russian, "some-unknown-code", nil), t)
+}
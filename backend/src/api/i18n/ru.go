@@ -0,0 +1,88 @@
+package i18n
+
+import (
+	"api/codes"
+	"services/errors"
+	"services/proxies/validation"
+	"services/proxies/validation/plugins/content_filter"
+)
+
+var russian = map[string]string{
+	errors.InternalError.Error():        "Внутренняя ошибка сервера, попробуйте позже",
+	errors.UserIdNotFound.Error():       "Пользователь не найден",
+	errors.UserAlreadyInMeeting.Error(): "Пользователь уже участвует во встрече",
+	errors.UserNotInMeeting.Error():     "Пользователь не участвует во встрече",
+	errors.RequestChatExists.Error():    "Чат заявки на участие уже существует",
+	errors.RequestNotFound.Error():      "Заявка на участие не найдена",
+	errors.MeetingIdNotFound.Error():    "Встреча не найдена",
+	errors.ChatIdNotFound.Error():       "Чат не найден",
+	errors.ChatArchived.Error():         "Чат в архиве",
+	errors.ChatReopenForbidden.Error():  "Открыть чат заново может только администратор встречи",
+	errors.DirectChatNotFound.Error():   "Личный чат не найден",
+	errors.DirectMessagesDenied.Error(): "Пользователь не принимает личные сообщения",
+	errors.ChatMessageNotFound.Error():  "Сообщение не найдено в чате",
+	errors.MessageNotFound.Error():      "Сообщение не найдено",
+	errors.ReplyMessageNotFound.Error(): "Сообщение, на которое вы отвечаете, не найдено",
+	errors.AttachmentNotFound.Error():   "Вложение не найдено",
+	errors.AttachmentTooLarge.Error():   "Вложение слишком большое",
+	errors.AttachmentNotAllowed.Error(): "Недопустимый тип вложения",
+	errors.MessageEditForbidden.Error(): "Изменить сообщение может только его автор",
+	errors.ChatExportForbidden.Error():  "Выгрузить чат может только администратор встречи",
+	errors.MessagePinForbidden.Error():  "Закреплять сообщения может только администратор встречи",
+	errors.AnnouncementDenied.Error():   "Отправлять объявления может только администратор встречи",
+	errors.EditWindowExpired.Error():    "Сообщение больше нельзя изменить",
+	errors.EmailExists.Error():          "Пользователь с таким email уже существует",
+	errors.CredentialsNotFound.Error():  "Неверный email или пароль",
+	errors.NoAuthCookie.Error():         "Необходима авторизация",
+	errors.InvalidAuthCookie.Error():    "Сессия недействительна, войдите заново",
+
+	validation.InvalidEmail:                           "Некорректный email",
+	validation.InvalidPassword:                        "Пароль должен быть длиной от {min} до {max} символов и содержать только латинские буквы, цифры и -_$*%&",
+	validation.InvalidId:                              "Идентификатор должен быть положительным числом",
+	validation.InvalidMeetingTitle:                    "Название должно быть длиной от {min} до {max} символов и не содержать < и >",
+	validation.InvalidMeetingDescription:              "Описание должно быть длиной от {min} до {max} символов и не содержать < и >",
+	validation.InvalidMeetingTag:                      "Тег должен быть длиной от {min} до {max} символов и содержать только буквы, цифры, пробелы и .'-",
+	validation.InvalidMeetingMaxUsers:                 "Максимальное число участников должно быть положительным числом",
+	validation.InvalidMeetingDuration:                 "Продолжительность должна быть положительным числом",
+	validation.InvalidMeetingMinAge:                   "Минимальный возраст должен быть положительным числом",
+	validation.InvalidMeetingGender:                   "Пол должен быть male, female или пустым",
+	validation.InvalidMeetingLatitude:                 "Широта должна быть от -90 до 90",
+	validation.InvalidMeetingLongitude:                "Долгота должна быть от -180 до 180",
+	validation.InvalidMeetingLabel:                    "Название места должно быть длиной от {min} до {max} символов и не содержать < и >",
+	validation.InvalidParticipationRequestDescription: "Описание заявки должно быть длиной от {min} до {max} символов и не содержать < и >",
+	validation.InvalidUserName:                        "Имя должно быть длиной от {min} до {max} символов и содержать только буквы, цифры, пробелы и .'-",
+	validation.InvalidUserNickname:                    "Никнейм должен быть длиной от {min} до {max} символов и содержать только буквы, цифры и -_$",
+	validation.InvalidUserGender:                      "Пол должен быть male, female или пустым",
+	validation.InvalidUserAge:                         "Возраст должен быть положительным числом",
+	validation.InvalidUserAvatarURL:                   "Аватар должен быть корректной ссылкой",
+	validation.InvalidCount:                           "Количество должно быть положительным числом",
+	validation.InvalidDate:                            "Некорректная дата",
+	validation.InvalidMessageText:                     "Сообщение должно быть длиной от {min} до {max} символов и не содержать < и >",
+	validation.InvalidEmoji:                           "Реакция должна быть одним эмодзи",
+	validation.InvalidAttachmentName:                  "Имя файла должно быть длиной от {min} до {max} символов и не содержать слешей",
+	validation.InvalidSearchText:                      "Текст поиска должен быть длиной от {min} до {max} символов",
+	validation.InvalidTranscriptFormat:                "Формат выгрузки должен быть json, csv или text",
+	validation.InappropriateMessageText:               "Сообщение содержит недопустимый контент: {reasons}",
+	validation.InappropriateMeetingTitle:              "Название содержит недопустимый контент: {reasons}",
+	validation.InappropriateMeetingDescription:        "Описание содержит недопустимый контент: {reasons}",
+	validation.InappropriateMeetingLabel:              "Название места содержит недопустимый контент: {reasons}",
+	validation.InappropriateUserNickname:              "Никнейм содержит недопустимый контент: {reasons}",
+	content_filter.ProfanityReason:                    "нецензурная лексика",
+	content_filter.LinkSpamReason:                     "слишком много ссылок",
+	content_filter.RepeatedCharsReason:                "повторяющиеся символы",
+
+	codes.ReadRequestBody:         "Не удалось прочитать тело запроса",
+	codes.DecodeRequestBody:       "Тело запроса не является корректным JSON",
+	codes.NoSession:               "Необходима авторизация",
+	codes.NoCSRFCookie:            "Отсутствует CSRF cookie",
+	codes.InvalidCSRFCookie:       "Некорректный CSRF cookie",
+	codes.NoCSRFHeader:            "Отсутствует CSRF заголовок",
+	codes.InvalidCSRFHeader:       "Некорректный CSRF заголовок",
+	codes.InvalidCSRFToken:        "Некорректный CSRF токен",
+	codes.CSRFInternalError:       "Не удалось проверить CSRF токен, попробуйте позже",
+	codes.ReadJSONFromWS:          "Фрейм не является корректным JSON",
+	codes.UnknownWSFrameType:      "Неизвестный тип фрейма",
+	codes.InvalidAttachmentUpload: "Некорректная загрузка вложения",
+	codes.RateLimited:             "Слишком много сообщений, подождите немного",
+	codes.NotChatMember:           "Пользователь не является участником чата",
+}
@@ -33,121 +33,143 @@ func InitRequestHandlers(
 		meetingsAPI.Use(middleware)
 	}
 
-	api.GetRouter().HandleFunc("/meetings", handler.getPublicMeetings).Methods(http.MethodGet)
-	meetingsAPI.HandleFunc("/{id:[0-9]+}", handler.getExtendedMeetings).Methods(http.MethodGet)
-	meetingAPI.HandleFunc("/", handler.createMeeting).Methods(http.MethodPost)
-	meetingAPI.HandleFunc("/", handler.deleteMeeting).Methods(http.MethodDelete)
-	meetingAPI.HandleFunc("/settings", handler.updateMeetingSettings).Methods(http.MethodPatch)
-	meetingAPI.HandleFunc("/request-participation", handler.handleParticipationRequest).Methods(http.MethodPost)
-	meetingAPI.HandleFunc("/user", handler.inviteUser).Methods(http.MethodPost)
-	meetingAPI.HandleFunc("/user", handler.kickUser).Methods(http.MethodDelete)
+	api.GetRouter().Handle("/meetings", api.HandlerFunc(handler.getPublicMeetings)).Methods(http.MethodGet)
+	meetingsAPI.Handle("/{id:[0-9]+}", api.HandlerFunc(handler.getExtendedMeetings)).Methods(http.MethodGet)
+	meetingAPI.Handle("/", api.HandlerFunc(handler.createMeeting)).Methods(http.MethodPost)
+	meetingAPI.Handle("/", api.HandlerFunc(handler.deleteMeeting)).Methods(http.MethodDelete)
+	meetingAPI.Handle("/settings", api.HandlerFunc(handler.updateMeetingSettings)).Methods(http.MethodPatch)
+	meetingAPI.Handle(
+		"/request-participation", api.HandlerFunc(handler.handleParticipationRequest)).Methods(http.MethodPost)
+	meetingAPI.Handle(
+		"/request-participation", api.HandlerFunc(handler.declineParticipationRequest)).Methods(http.MethodDelete)
+	meetingAPI.Handle("/user", api.HandlerFunc(handler.inviteUser)).Methods(http.MethodPost)
+	meetingAPI.Handle("/user", api.HandlerFunc(handler.kickUser)).Methods(http.MethodDelete)
 }
 
-func (h Handler) getPublicMeetings(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
-	meetings, err := h.meetingsAccessorService.GetPublicMeetings()
+func (h Handler) getPublicMeetings(w http.ResponseWriter, r *http.Request) error {
+	meetings, err := h.meetingsAccessorService.GetPublicMeetings(r.Context())
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.EncodeAndSendResponse(w, meetings)
+	return nil
 }
 
-func (h Handler) getExtendedMeetings(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) getExtendedMeetings(w http.ResponseWriter, r *http.Request) error {
 	vars := mux.Vars(r)
 	// checking of this parameter will be performed in validation proxy
 	userId, _ := strconv.Atoi(vars["id"])
-	meetings, err := h.meetingsAccessorService.GetExtendedMeetings(uint(userId))
+	meetings, err := h.meetingsAccessorService.GetExtendedMeetings(r.Context(), uint(userId))
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.EncodeAndSendResponse(w, meetings)
+	return nil
 }
 
-func (h Handler) createMeeting(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) createMeeting(w http.ResponseWriter, r *http.Request) error {
 	var request models.CreateMeetingRequest
-	api.DecodeRequestBody(r, &request)
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	err := h.meetingsService.CreateMeeting(request.AdminId, request.Settings)
+	err := h.meetingsService.CreateMeeting(r.Context(), request.AdminId, request.Settings)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
 
-func (h Handler) deleteMeeting(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) deleteMeeting(w http.ResponseWriter, r *http.Request) error {
 	var request models.GeneralMeetingRequest
-	api.DecodeRequestBody(r, &request)
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	err := h.meetingsService.DeleteMeeting(request.MeetingId)
+	err := h.meetingsService.DeleteMeeting(r.Context(), request.MeetingId)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
 
-func (h Handler) updateMeetingSettings(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) updateMeetingSettings(w http.ResponseWriter, r *http.Request) error {
 	var request models.UpdateMeetingSettingsRequest
-	api.DecodeRequestBody(r, &request)
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	err := h.meetingsService.UpdateSettings(request.MeetingId, request.Settings)
+	err := h.meetingsService.UpdateSettings(r.Context(), request.MeetingId, request.Settings)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
 
-func (h Handler) handleParticipationRequest(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) handleParticipationRequest(w http.ResponseWriter, r *http.Request) error {
 	var request models.ParticipationRequest
-	api.DecodeRequestBody(r, &request)
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	rejectInfo, err := h.participationService.HandleParticipationRequest(request)
+	rejectInfo, err := h.participationService.HandleParticipationRequest(r.Context(), request)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.EncodeAndSendResponse(w, rejectInfo)
+	return nil
 }
 
-func (h Handler) inviteUser(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) declineParticipationRequest(w http.ResponseWriter, r *http.Request) error {
 	var request models.MeetingUserRequest
-	api.DecodeRequestBody(r, &request)
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	err := h.meetingsService.AddUserToMeeting(request.MeetingId, request.UserId)
+	err := h.participationService.DeclineParticipationRequest(r.Context(), request.MeetingId, request.UserId)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
 
-func (h Handler) kickUser(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
+func (h Handler) inviteUser(w http.ResponseWriter, r *http.Request) error {
+	var request models.MeetingUserRequest
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
+
+	err := h.meetingsService.AddUserToMeeting(r.Context(), request.MeetingId, request.UserId)
+	if err != nil {
+		return err
+	}
+
+	api.SendDefaultResponse(w)
+	return nil
+}
 
+func (h Handler) kickUser(w http.ResponseWriter, r *http.Request) error {
 	var request models.MeetingUserRequest
-	api.DecodeRequestBody(r, &request)
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	err := h.meetingsService.KickUserFromMeeting(request.MeetingId, request.UserId)
+	err := h.meetingsService.KickUserFromMeeting(r.Context(), request.MeetingId, request.UserId)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
@@ -47,9 +47,13 @@ func init() {
 
 	sessionService = services.Session(coderKey)
 	InitRequestHandlers(
-		services.Meetings(repositories.Meetings(db)),
-		services.Participation(repositories.UserSettings(db), repositories.MeetingsSettings(db)),
-		services.MeetingsAccessor(repositories.Meetings(db)),
+		services.Meetings(repositories.Meetings(db, mock.QueryTimeout), validation.ContentChecker{}),
+		services.Participation(
+			repositories.UserSettings(db, mock.QueryTimeout),
+			repositories.MeetingsSettings(db, mock.QueryTimeout),
+			repositories.Chat(db, mock.QueryTimeout),
+		),
+		services.MeetingsAccessor(repositories.Meetings(db, mock.QueryTimeout)),
 		middlewares.AuthSession{Service: sessionService}.HasValidSession,
 	)
 }
@@ -397,6 +401,69 @@ func TestHandleParticipation_InternalError(t *testing.T) {
 	utils.AssertEqual(errors.InternalError.Error(), response.ErrorDetail, t)
 }
 
+func TestDeclineParticipation_Success(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	var response models.DefaultResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(meetingsAPIMock.DeclineParticipationRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+}
+
+func TestDeclineParticipation_NoSession(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(meetingsAPIMock.DeclineParticipationRequestWithoutSession(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(middlewares.NoSession.Error(), response.ErrorDetail, t)
+}
+
+func TestDeclineParticipation_RequestNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(meetingsAPIMock.DeclineNotExistsParticipationRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(errors.RequestNotFound.Error(), response.ErrorDetail, t)
+}
+
+func TestDeclineParticipation_InvalidIds(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(meetingsAPIMock.DeclineParticipationInvalidIdsRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(validation.InvalidId, response.ErrorDetail, t)
+}
+
+func TestDeclineParticipation_InternalError(t *testing.T) {
+	mock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(meetingsAPIMock.DeclineParticipationRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(errors.InternalError.Error(), response.ErrorDetail, t)
+}
+
 func TestInviteUser_Success(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
@@ -0,0 +1,112 @@
+package messages
+
+import (
+	"api"
+	"github.com/gorilla/mux"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"models"
+	"net/http"
+	"plugins/logger"
+	"strconv"
+	"time"
+)
+
+const (
+	uploadUserIdField = "user_id"
+	uploadFileField   = "file"
+	// enough for any uint
+	maxUserIdFieldLength = 20
+)
+
+var invalidUpload = api.ApplicationError{OriginalError: InvalidUploadError, Status: http.StatusBadRequest}
+
+// file is streamed to storage, so user id field must be sent before file,
+// server deadlines are too short for large files, so they are extended by upload timeout
+func (h Handler) uploadAttachment(w http.ResponseWriter, r *http.Request) error {
+	readDeadline := time.Now().Add(h.uploadTimeout)
+	if err := api.SetDeadlines(w, readDeadline, readDeadline.Add(api.WriteTimeout)); err != nil {
+		logger.WithContext(r.Context()).ErrorF("Error while extending deadlines of upload: %v", err)
+	}
+
+	upload, file, err := readUpload(r)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	attachment, err := h.attachments.Upload(r.Context(), upload)
+	if err != nil {
+		return err
+	}
+
+	api.EncodeAndSendResponse(w, attachment)
+	return nil
+}
+
+func (h Handler) downloadAttachment(w http.ResponseWriter, r *http.Request) error {
+	return h.sendAttachment(w, r, false)
+}
+
+func (h Handler) downloadThumbnail(w http.ResponseWriter, r *http.Request) error {
+	return h.sendAttachment(w, r, true)
+}
+
+// attachment is sent to user of session, so it can not be read by passing id of other user,
+// large file can be sent longer than server write timeout, so it is limited only by connection
+func (h Handler) sendAttachment(w http.ResponseWriter, r *http.Request, thumbnail bool) error {
+	if err := api.LiftWriteDeadline(w); err != nil {
+		logger.WithContext(r.Context()).ErrorF("Error while lifting write deadline of download: %v", err)
+	}
+
+	attachmentId, _ := strconv.Atoi(mux.Vars(r)["attachment_id"])
+	userId := api.SessionUserId(r.Context())
+	content, err := h.attachments.Download(r.Context(), uint(attachmentId), userId, thumbnail)
+	if err != nil {
+		return err
+	}
+	defer content.Content.Close()
+
+	disposition := "attachment"
+	if thumbnail {
+		disposition = "inline"
+	}
+	w.Header().Set("content-type", content.MimeType)
+	w.Header().Set("content-disposition", mime.FormatMediaType(disposition, map[string]string{
+		"filename": content.FileName,
+	}))
+
+	// headers are already sent, so error can only be logged
+	if _, err = io.Copy(w, content.Content); err != nil {
+		logger.WithContext(r.Context()).ErrorF("Error while sending attachment: %v", err)
+	}
+	return nil
+}
+
+func readUpload(r *http.Request) (models.AttachmentUpload, *multipart.Part, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return models.AttachmentUpload{}, nil, invalidUpload
+	}
+
+	var upload models.AttachmentUpload
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return models.AttachmentUpload{}, nil, invalidUpload
+		}
+
+		switch part.FormName() {
+		case uploadUserIdField:
+			value, _ := ioutil.ReadAll(io.LimitReader(part, maxUserIdFieldLength))
+			userId, _ := strconv.ParseUint(string(value), 10, 32)
+			upload.UploaderId = uint(userId)
+		case uploadFileField:
+			upload.FileName, upload.Content = part.FileName(), part
+			return upload, part, nil
+		}
+		_ = part.Close()
+	}
+}
@@ -2,33 +2,67 @@ package messages
 
 import (
 	"github.com/gorilla/websocket"
+	"sort"
+	"sync"
 )
 
-var chatId2Conns = map[uint]map[*websocket.Conn]bool{}
+type chatUser struct {
+	chatId uint
+	userId uint
+}
+
+var (
+	connectionsMutex sync.RWMutex
+	// every chat connection stores id of user that uses it, it is needed to know who is online
+	chatId2Conns = map[uint]map[*websocket.Conn]uint{}
+	// websocket connection supports only one concurrent writer
+	writeMutexes sync.Map
+)
+
+// returns true if user had no connections to chat before
+func AddConnection(chatId, userId uint, conn *websocket.Conn) bool {
+	connectionsMutex.Lock()
+	defer connectionsMutex.Unlock()
 
-func AddConnection(chatId uint, conn *websocket.Conn) {
 	conns, chatHasConns := chatId2Conns[chatId]
 	if !chatHasConns {
-		chatId2Conns[chatId] = make(map[*websocket.Conn]bool)
+		chatId2Conns[chatId] = make(map[*websocket.Conn]uint)
 		conns = chatId2Conns[chatId]
 	}
 
-	_, connectionFound := conns[conn]
-	if !connectionFound {
-		conns[conn] = true
+	wasOnline := userHasConnection(conns, userId)
+	if _, subscribed := conns[conn]; !subscribed {
+		chatSubscriptions.Inc()
 	}
+	conns[conn] = userId
+	return !wasOnline
 }
 
-func RemoveConnection(conn *websocket.Conn) {
-	for _, conns := range chatId2Conns {
-		_, hasConnection := conns[conn]
+// returns users that have no more connections to chats after removing
+func RemoveConnection(conn *websocket.Conn) []chatUser {
+	connectionsMutex.Lock()
+	defer connectionsMutex.Unlock()
+
+	var wentOffline []chatUser
+	for chatId, conns := range chatId2Conns {
+		userId, hasConnection := conns[conn]
 		if hasConnection {
 			delete(conns, conn)
+			chatSubscriptions.Dec()
+			if !userHasConnection(conns, userId) {
+				wentOffline = append(wentOffline, chatUser{chatId, userId})
+			}
 		}
 	}
+	writeMutexes.Delete(conn)
+
+	return wentOffline
 }
 
 func GetConnections(chatId uint) []*websocket.Conn {
+	connectionsMutex.RLock()
+	defer connectionsMutex.RUnlock()
+
 	var connections []*websocket.Conn
 	for conn := range chatId2Conns[chatId] {
 		connections = append(connections, conn)
@@ -36,3 +70,52 @@ func GetConnections(chatId uint) []*websocket.Conn {
 
 	return connections
 }
+
+func GetOnlineUsers(chatId uint) []uint {
+	connectionsMutex.RLock()
+	defer connectionsMutex.RUnlock()
+
+	usersIds := []uint{}
+	usersFound := map[uint]bool{}
+	for _, userId := range chatId2Conns[chatId] {
+		if !usersFound[userId] {
+			usersFound[userId] = true
+			usersIds = append(usersIds, userId)
+		}
+	}
+	sort.Slice(usersIds, func(i, j int) bool { return usersIds[i] < usersIds[j] })
+
+	return usersIds
+}
+
+func WriteJSON(conn *websocket.Conn, v interface{}) error {
+	mutex, _ := writeMutexes.LoadOrStore(conn, &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock()
+	defer mutex.(*sync.Mutex).Unlock()
+
+	return conn.WriteJSON(v)
+}
+
+// sends v to all chat connections except sender, sender can be nil
+func sendToChatConnections(chatId uint, v interface{}, sender *websocket.Conn) {
+	for _, connection := range GetConnections(chatId) {
+		if connection != sender {
+			_ = WriteJSON(connection, v)
+		}
+	}
+}
+
+func userHasConnection(conns map[*websocket.Conn]uint, userId uint) bool {
+	for _, connUserId := range conns {
+		if connUserId == userId {
+			return true
+		}
+	}
+
+	return false
+}
+
+// notifies subscribers of chat about changes of chat made outside of messages API
+func SendChatEventToChatConnections(eventType string, chatId uint) {
+	sendToChatConnections(chatId, ChatEventFrame{Type: eventType, ChatId: chatId}, nil)
+}
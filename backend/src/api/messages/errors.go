@@ -1,7 +1,29 @@
 package messages
 
-import "errors"
+import (
+	"api/codes"
+	"errors"
+	"services/proxies/validation"
+	"time"
+)
+
+const RateLimitedErrorDetail = codes.RateLimited
 
 var (
-	ReadJSONError = errors.New("unable-to-read-json-from-ws")
+	ReadJSONError         = errors.New(codes.ReadJSONFromWS)
+	UnknownFrameTypeError = errors.New(codes.UnknownWSFrameType)
+	InvalidFrameIdError   = errors.New(validation.InvalidId)
+	InvalidUploadError    = errors.New(codes.InvalidAttachmentUpload)
+	NotChatMemberError    = errors.New(codes.NotChatMember)
 )
+
+// message is rejected by flood protection, connection stays open and message can be sent after RetryAfter
+type RateLimitedError struct {
+	ChatId     uint
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e RateLimitedError) Error() string {
+	return RateLimitedErrorDetail
+}
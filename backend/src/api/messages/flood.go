@@ -0,0 +1,52 @@
+package messages
+
+import (
+	"models"
+	"plugins/ratelimit"
+)
+
+// reasons of rejecting message by flood protection
+const (
+	UserRateLimitReason = "user"
+	ChatRateLimitReason = "chat"
+	DuplicateReason     = "duplicate"
+)
+
+// flood protection is shared by all connections of handler
+type floodGuard struct {
+	users      *ratelimit.Limiter
+	chats      *ratelimit.Limiter
+	duplicates *ratelimit.DuplicatesFilter
+}
+
+func newFloodGuard(limits models.MessageRateLimits) floodGuard {
+	return floodGuard{
+		users:      ratelimit.NewLimiter(limits.UserRate, limits.UserBurst),
+		chats:      ratelimit.NewLimiter(limits.ChatRate, limits.ChatBurst),
+		duplicates: ratelimit.NewDuplicatesFilter(limits.DuplicateWindow),
+	}
+}
+
+// duplicate is checked first so that it does not spend tokens of sender
+func (g floodGuard) check(message models.Message) error {
+	if duplicate, retryAfter := g.duplicates.IsDuplicate(getDuplicateKey(message), message.Text); duplicate {
+		return RateLimitedError{ChatId: message.ChatId, Reason: DuplicateReason, RetryAfter: retryAfter}
+	}
+	if allowed, retryAfter := g.users.Allow(message.SenderId); !allowed {
+		return RateLimitedError{ChatId: message.ChatId, Reason: UserRateLimitReason, RetryAfter: retryAfter}
+	}
+	if allowed, retryAfter := g.chats.Allow(message.ChatId); !allowed {
+		return RateLimitedError{ChatId: message.ChatId, Reason: ChatRateLimitReason, RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// only saved messages are remembered, so failed message can be sent again
+func (g floodGuard) remember(message models.Message) {
+	g.duplicates.Remember(getDuplicateKey(message), message.Text)
+}
+
+func getDuplicateKey(message models.Message) chatUser {
+	return chatUser{message.ChatId, message.SenderId}
+}
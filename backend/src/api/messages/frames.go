@@ -0,0 +1,103 @@
+package messages
+
+import (
+	"context"
+	"models"
+)
+
+// type of frame sent through websocket, frames without type are regular messages
+const (
+	MessageFrameType  = ""
+	ReadFrameType     = "read"
+	TypingFrameType   = "typing"
+	PresenceFrameType = "presence"
+	EditFrameType     = "edit"
+	DeleteFrameType   = "delete"
+	ReactFrameType    = "react"
+	UnreactFrameType  = "unreact"
+	// sent by server when message was changed
+	EditedFrameType          = "edited"
+	DeletedFrameType         = "deleted"
+	ReactionAddedFrameType   = "reaction_added"
+	ReactionRemovedFrameType = "reaction_removed"
+	PinnedFrameType          = "message_pinned"
+	UnpinnedFrameType        = "message_unpinned"
+	// sent by server when chat status was changed
+	ChatArchivedFrameType = "chat_archived"
+	ChatReopenedFrameType = "chat_reopened"
+	// sent by server when frame was rejected but connection stays open
+	ErrorFrameType = "error"
+)
+
+type (
+	reactionChanger   func(ctx context.Context, reaction models.MessageReaction) (models.ReactionChange, error)
+	messagePinChanger func(ctx context.Context, request models.PinMessageRequest) (models.Message, error)
+)
+
+type (
+	frameHeader struct {
+		Type string `json:"type"`
+	}
+
+	ReadReceiptFrame struct {
+		Type string `json:"type"`
+		models.ReadReceipt
+	}
+
+	// typing and presence frames are ephemeral and never saved
+	TypingFrame struct {
+		Type   string `json:"type"`
+		ChatId uint   `json:"chat_id"`
+		UserId uint   `json:"user_id"`
+		Typing bool   `json:"typing"`
+	}
+
+	EditFrame struct {
+		Type string `json:"type"`
+		models.EditMessageRequest
+	}
+
+	DeleteFrame struct {
+		Type string `json:"type"`
+		models.DeleteMessageRequest
+	}
+
+	ReactionFrame struct {
+		Type string `json:"type"`
+		models.MessageReaction
+	}
+
+	// contains count of reactions with the same emoji after change
+	ReactionEventFrame struct {
+		Type string `json:"type"`
+		models.ReactionChange
+	}
+
+	MessageEventFrame struct {
+		Type    string         `json:"type"`
+		Message models.Message `json:"message"`
+	}
+
+	ChatEventFrame struct {
+		Type   string `json:"type"`
+		ChatId uint   `json:"chat_id"`
+	}
+
+	PresenceFrame struct {
+		Type   string `json:"type"`
+		ChatId uint   `json:"chat_id"`
+		UserId uint   `json:"user_id"`
+		Online bool   `json:"online"`
+	}
+
+	RateLimitedFrame struct {
+		Type        string `json:"type"`
+		Status      string `json:"status"`
+		ErrorDetail string `json:"error_detail"`
+		Message     string `json:"message"`
+		ChatId      uint   `json:"chat_id"`
+		Reason      string `json:"reason"`
+		// in milliseconds
+		RetryAfter int64 `json:"retry_after"`
+	}
+)
@@ -2,6 +2,9 @@ package messages
 
 import (
 	"api"
+	"api/i18n"
+	"context"
+	"encoding/json"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"interfaces"
@@ -9,121 +12,645 @@ import (
 	"net/http"
 	"plugins/logger"
 	"strconv"
+	"time"
 )
 
 type Handler struct {
-	service  interfaces.Messages
-	upgrader websocket.Upgrader
+	service       interfaces.Messages
+	chatAccessor  interfaces.ChatAccessor
+	directChat    interfaces.DirectChat
+	readReceipts  interfaces.ReadReceipts
+	editor        interfaces.MessagesEditor
+	reactions     interfaces.Reactions
+	attachments   interfaces.Attachments
+	search        interfaces.MessagesSearch
+	notifications interfaces.Notifications
+	floodGuard    floodGuard
+	uploadTimeout time.Duration
+	upgrader      websocket.Upgrader
 }
 
 func InitRequestHandlers(
 	service interfaces.Messages,
+	chatAccessor interfaces.ChatAccessor,
+	directChat interfaces.DirectChat,
+	readReceipts interfaces.ReadReceipts,
+	editor interfaces.MessagesEditor,
+	reactions interfaces.Reactions,
+	attachments interfaces.Attachments,
+	search interfaces.MessagesSearch,
+	notifications interfaces.Notifications,
+	rateLimits models.MessageRateLimits,
+	uploadTimeout time.Duration,
 	middlewares ...mux.MiddlewareFunc,
 ) {
 	handler := Handler{
-		service: service,
+		service:       service,
+		chatAccessor:  chatAccessor,
+		directChat:    directChat,
+		readReceipts:  readReceipts,
+		editor:        editor,
+		reactions:     reactions,
+		attachments:   attachments,
+		search:        search,
+		notifications: notifications,
+		floodGuard:    newFloodGuard(rateLimits),
+		uploadTimeout: uploadTimeout,
 		upgrader: websocket.Upgrader{
 			ReadBufferSize:  1024,
 			WriteBufferSize: 1024,
 		},
 	}
+
 	messagesAPI := api.GetRouter().PathPrefix("/messages").Subrouter()
 	for _, middleware := range middlewares {
 		messagesAPI.Use(middleware)
 	}
 
-	api.GetRouter().HandleFunc("/ws", handler.handleWS)
-	messagesAPI.HandleFunc(
-		"/{chat_id:[0-9]+}/{count:[0-9]+}", handler.getLastMessages).Methods(http.MethodGet)
-	messagesAPI.HandleFunc(
+	api.GetRouter().HandleFunc("/ws", handler.handleWS).Methods(http.MethodGet)
+	messagesAPI.Handle(
+		"/{chat_id:[0-9]+}/{count:[0-9]+}", api.HandlerFunc(handler.getLastMessages)).Methods(http.MethodGet)
+	messagesAPI.Handle(
 		"/{chat_id:[0-9]+}/{message_id:[0-9]+}/{count:[0-9]+}",
-		handler.getLastMessagesAfter,
+		api.HandlerFunc(handler.getLastMessagesAfter),
+	).Methods(http.MethodGet)
+	messagesAPI.Handle(
+		"/thread/{message_id:[0-9]+}/{count:[0-9]+}", api.HandlerFunc(handler.getThread)).Methods(http.MethodGet)
+	messagesAPI.Handle(
+		"/thread/{message_id:[0-9]+}/{after_id:[0-9]+}/{count:[0-9]+}", api.HandlerFunc(handler.getThread),
+	).Methods(http.MethodGet)
+	messagesAPI.Handle("/read", api.HandlerFunc(handler.markAsRead)).Methods(http.MethodPost)
+	messagesAPI.Handle(
+		"/unread/{user_id:[0-9]+}", api.HandlerFunc(handler.getUnreadCounts)).Methods(http.MethodGet)
+	messagesAPI.Handle(
+		"/search/{user_id:[0-9]+}/{count:[0-9]+}", api.HandlerFunc(handler.searchMessages)).Methods(http.MethodGet)
+	messagesAPI.Handle(
+		"/online/{chat_id:[0-9]+}", api.HandlerFunc(handler.getOnlineUsers)).Methods(http.MethodGet)
+	messagesAPI.Handle("/message", api.HandlerFunc(handler.editMessage)).Methods(http.MethodPatch)
+	messagesAPI.Handle("/message", api.HandlerFunc(handler.deleteMessage)).Methods(http.MethodDelete)
+	messagesAPI.Handle(
+		"/edits/{message_id:[0-9]+}", api.HandlerFunc(handler.getMessageEdits)).Methods(http.MethodGet)
+	messagesAPI.Handle("/pin", api.HandlerFunc(handler.pinMessage)).Methods(http.MethodPost)
+	messagesAPI.Handle("/pin", api.HandlerFunc(handler.unpinMessage)).Methods(http.MethodDelete)
+	messagesAPI.Handle(
+		"/pinned/{chat_id:[0-9]+}", api.HandlerFunc(handler.getPinnedMessages)).Methods(http.MethodGet)
+	messagesAPI.Handle(
+		"/notifications/{user_id:[0-9]+}/{count:[0-9]+}", api.HandlerFunc(handler.getNotifications),
+	).Methods(http.MethodGet)
+	messagesAPI.Handle("/reaction", api.HandlerFunc(handler.addReaction)).Methods(http.MethodPost)
+	messagesAPI.Handle("/reaction", api.HandlerFunc(handler.removeReaction)).Methods(http.MethodDelete)
+	messagesAPI.Handle("/attachments", api.HandlerFunc(handler.uploadAttachment)).Methods(http.MethodPost)
+	messagesAPI.Handle(
+		"/attachments/{attachment_id:[0-9]+}", api.HandlerFunc(handler.downloadAttachment),
+	).Methods(http.MethodGet)
+	messagesAPI.Handle(
+		"/attachments/{attachment_id:[0-9]+}/thumbnail", api.HandlerFunc(handler.downloadThumbnail),
 	).Methods(http.MethodGet)
 }
 
-func (h Handler) getLastMessages(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) getLastMessages(w http.ResponseWriter, r *http.Request) error {
 	vars := mux.Vars(r)
 	chatId, _ := strconv.Atoi(vars["chat_id"])
 	count, _ := strconv.Atoi(vars["count"])
+	viewerId := getViewerId(r)
 
-	messages, err := h.service.GetLastMessages(uint(chatId), uint(count))
+	messages, err := h.service.GetLastMessages(r.Context(), uint(chatId), uint(count), viewerId)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.EncodeAndSendResponse(w, messages)
+	return nil
 }
 
-func (h Handler) getLastMessagesAfter(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) getLastMessagesAfter(w http.ResponseWriter, r *http.Request) error {
 	vars := mux.Vars(r)
 	chatId, _ := strconv.Atoi(vars["chat_id"])
 	messageId, _ := strconv.Atoi(vars["message_id"])
 	count, _ := strconv.Atoi(vars["count"])
+	viewerId := getViewerId(r)
 
-	messages, err := h.service.GetLastMessagesAfter(uint(chatId), uint(messageId), uint(count))
+	messages, err := h.service.GetLastMessagesAfter(r.Context(), uint(chatId), uint(messageId), uint(count), viewerId)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.EncodeAndSendResponse(w, messages)
+	return nil
 }
 
-func (h Handler) handleWS(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
+// after id is absent for the first page of thread
+func (h Handler) getThread(w http.ResponseWriter, r *http.Request) error {
+	vars := mux.Vars(r)
+	rootId, _ := strconv.Atoi(vars["message_id"])
+	afterId, _ := strconv.Atoi(vars["after_id"])
+	count, _ := strconv.Atoi(vars["count"])
+	viewerId := getViewerId(r)
+
+	thread, err := h.service.GetThread(r.Context(), uint(rootId), uint(afterId), uint(count), viewerId)
+	if err != nil {
+		return err
+	}
+
+	api.EncodeAndSendResponse(w, thread)
+	return nil
+}
+
+// optional user_id query parameter is used to mark reactions of the user
+func getViewerId(r *http.Request) uint {
+	return getQueryId(r, "user_id")
+}
+
+// returns 0 if optional id query parameter is absent
+func getQueryId(r *http.Request, key string) uint {
+	id, _ := strconv.ParseUint(r.URL.Query().Get(key), 10, 32)
+	return uint(id)
+}
+
+func (h Handler) markAsRead(w http.ResponseWriter, r *http.Request) error {
+	var receipt models.ReadReceipt
+	if err := api.DecodeRequestBody(r, &receipt); err != nil {
+		return err
+	}
+
+	err := h.readReceipts.MarkAsRead(r.Context(), receipt)
+	if err != nil {
+		return err
+	}
+
+	h.sendReadReceiptToChatConnections(receipt, nil)
+	api.SendDefaultResponse(w)
+	return nil
+}
+
+func (h Handler) getUnreadCounts(w http.ResponseWriter, r *http.Request) error {
+	userId, _ := strconv.Atoi(mux.Vars(r)["user_id"])
+	counts, err := h.readReceipts.GetUnreadCounts(r.Context(), uint(userId))
+	if err != nil {
+		return err
+	}
+
+	api.EncodeAndSendResponse(w, counts)
+	return nil
+}
+
+// search text is passed in text query parameter, chat_id and before_id query parameters are optional
+func (h Handler) searchMessages(w http.ResponseWriter, r *http.Request) error {
+	vars := mux.Vars(r)
+	userId, _ := strconv.Atoi(vars["user_id"])
+	count, _ := strconv.Atoi(vars["count"])
+	query := models.SearchQuery{
+		UserId:   uint(userId),
+		Text:     r.URL.Query().Get("text"),
+		ChatId:   getQueryId(r, "chat_id"),
+		BeforeId: getQueryId(r, "before_id"),
+		Count:    uint(count),
+	}
+
+	hits, err := h.search.SearchMessages(r.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	api.EncodeAndSendResponse(w, hits)
+	return nil
+}
+
+// users that are not members of chat (e.g. left it after connecting) are not listed
+func (h Handler) getOnlineUsers(w http.ResponseWriter, r *http.Request) error {
+	chatId, _ := strconv.Atoi(mux.Vars(r)["chat_id"])
+	usersIds := GetOnlineUsers(uint(chatId))
+	if len(usersIds) != 0 {
+		var err error
+		usersIds, err = h.chatAccessor.GetChatMembers(r.Context(), uint(chatId), usersIds)
+		if err != nil {
+			return err
+		}
+	}
+
+	api.EncodeAndSendResponse(w, usersIds)
+	return nil
+}
+
+func (h Handler) editMessage(w http.ResponseWriter, r *http.Request) error {
+	var request models.EditMessageRequest
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
+
+	message, err := h.editor.EditMessage(r.Context(), request)
+	if err != nil {
+		return err
+	}
 
+	h.sendMessageEventToChatConnections(EditedFrameType, message)
+	api.EncodeAndSendResponse(w, message)
+	return nil
+}
+
+func (h Handler) deleteMessage(w http.ResponseWriter, r *http.Request) error {
+	var request models.DeleteMessageRequest
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
+
+	message, err := h.editor.DeleteMessage(r.Context(), request)
+	if err != nil {
+		return err
+	}
+
+	h.sendMessageEventToChatConnections(DeletedFrameType, message)
+	api.EncodeAndSendResponse(w, message)
+	return nil
+}
+
+func (h Handler) getMessageEdits(w http.ResponseWriter, r *http.Request) error {
+	messageId, _ := strconv.Atoi(mux.Vars(r)["message_id"])
+	edits, err := h.editor.GetMessageEdits(r.Context(), uint(messageId))
+	if err != nil {
+		return err
+	}
+
+	api.EncodeAndSendResponse(w, edits)
+	return nil
+}
+
+func (h Handler) pinMessage(w http.ResponseWriter, r *http.Request) error {
+	return h.changeMessagePin(w, r, h.editor.PinMessage, PinnedFrameType)
+}
+
+func (h Handler) unpinMessage(w http.ResponseWriter, r *http.Request) error {
+	return h.changeMessagePin(w, r, h.editor.UnpinMessage, UnpinnedFrameType)
+}
+
+func (h Handler) changeMessagePin(
+	w http.ResponseWriter, r *http.Request, changePin messagePinChanger, eventType string,
+) error {
+	var request models.PinMessageRequest
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
+
+	message, err := changePin(r.Context(), request)
+	if err != nil {
+		return err
+	}
+
+	h.sendMessageEventToChatConnections(eventType, message)
+	api.EncodeAndSendResponse(w, message)
+	return nil
+}
+
+func (h Handler) getPinnedMessages(w http.ResponseWriter, r *http.Request) error {
+	chatId, _ := strconv.Atoi(mux.Vars(r)["chat_id"])
+	messages, err := h.editor.GetPinnedMessages(r.Context(), uint(chatId))
+	if err != nil {
+		return err
+	}
+
+	api.EncodeAndSendResponse(w, messages)
+	return nil
+}
+
+func (h Handler) getNotifications(w http.ResponseWriter, r *http.Request) error {
+	vars := mux.Vars(r)
+	userId, _ := strconv.Atoi(vars["user_id"])
+	count, _ := strconv.Atoi(vars["count"])
+	notifications, err := h.notifications.GetNotifications(r.Context(), uint(userId), uint(count))
+	if err != nil {
+		return err
+	}
+
+	api.EncodeAndSendResponse(w, notifications)
+	return nil
+}
+
+func (h Handler) addReaction(w http.ResponseWriter, r *http.Request) error {
+	return h.changeReaction(w, r, h.reactions.AddReaction, ReactionAddedFrameType)
+}
+
+func (h Handler) removeReaction(w http.ResponseWriter, r *http.Request) error {
+	return h.changeReaction(w, r, h.reactions.RemoveReaction, ReactionRemovedFrameType)
+}
+
+func (h Handler) changeReaction(
+	w http.ResponseWriter, r *http.Request, change reactionChanger, eventType string) error {
+	var reaction models.MessageReaction
+	if err := api.DecodeRequestBody(r, &reaction); err != nil {
+		return err
+	}
+
+	reactionChange, err := change(r.Context(), reaction)
+	if err != nil {
+		return err
+	}
+
+	h.sendReactionToChatConnections(eventType, reactionChange)
+	api.EncodeAndSendResponse(w, reactionChange)
+	return nil
+}
+
+// upgrader sends error response itself, so handler is not wrapped by api.HandlerFunc
+func (h Handler) handleWS(w http.ResponseWriter, r *http.Request) {
 	conn, err := h.upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		logger.ErrorF("Error while upgrading connection: %v", err)
+		logger.WithContext(r.Context()).ErrorF("Error while upgrading connection: %v", err)
+		return
 	}
+	openConnections.Inc()
+	defer openConnections.Dec()
+	language := api.RequestLanguage(r)
+	// context of upgrade request is canceled when handler returns, so it lives as long as connection
+	ctx := r.Context()
 
 	for {
-		var message models.Message
-		err := conn.ReadJSON(&message)
+		_, frame, err := conn.ReadMessage()
 		if err != nil {
-			h.processReadJSONError(conn, err)
+			h.processReadJSONError(ctx, conn, err, language)
 			return
 		}
 
-		AddConnection(message.ChatId, conn)
-		err = h.service.Save(message)
+		var header frameHeader
+		err = json.Unmarshal(frame, &header)
 		if err != nil {
-			h.processServiceError(conn, err)
+			h.processReadJSONError(ctx, conn, err, language)
+			return
+		}
+
+		switch header.Type {
+		case MessageFrameType:
+			err = h.handleMessageFrame(ctx, conn, frame)
+		case ReadFrameType:
+			err = h.handleReadFrame(ctx, conn, frame)
+		case TypingFrameType:
+			err = h.handleTypingFrame(ctx, conn, frame)
+		case PresenceFrameType:
+			err = h.handlePresenceFrame(ctx, conn, frame)
+		case EditFrameType:
+			err = h.handleEditFrame(ctx, conn, frame)
+		case DeleteFrameType:
+			err = h.handleDeleteFrame(ctx, conn, frame)
+		case ReactFrameType:
+			err = h.handleReactionFrame(ctx, conn, frame, h.reactions.AddReaction, ReactionAddedFrameType)
+		case UnreactFrameType:
+			err = h.handleReactionFrame(ctx, conn, frame, h.reactions.RemoveReaction, ReactionRemovedFrameType)
+		default:
+			err = UnknownFrameTypeError
+		}
+		if rateLimitedError, isRateLimited := err.(RateLimitedError); isRateLimited {
+			h.sendRateLimitedFrame(ctx, conn, rateLimitedError, language)
+			continue
+		}
+		if err != nil {
+			h.processServiceError(ctx, conn, err, language)
 			return
 		}
-		h.sendMessageToAllChatConnections(message)
 	}
 }
 
-func (h Handler) processReadJSONError(conn *websocket.Conn, err error) {
-	logger.ErrorF("Error while reading JSON from connection: %v", err)
-	h.trySendErrorMessageToConnection(conn, ReadJSONError)
-	RemoveConnection(conn)
-	_ = conn.Close()
+func (h Handler) handleMessageFrame(ctx context.Context, conn *websocket.Conn, frame []byte) error {
+	var message models.Message
+	err := json.Unmarshal(frame, &message)
+	if err != nil {
+		return ReadJSONError
+	}
+
+	if message.RecipientId != 0 {
+		// direct chat is created lazily on first message
+		chat, err := h.directChat.GetOrCreateDirectChat(ctx, message.SenderId, message.RecipientId)
+		if err != nil {
+			return err
+		}
+
+		message.ChatId = chat.Id
+	}
+
+	h.addConnection(message.ChatId, message.SenderId, conn)
+	if err := h.floodGuard.check(message); err != nil {
+		return err
+	}
+
+	savedMessage, err := h.service.Save(ctx, message)
+	if err != nil {
+		return err
+	}
+	h.floodGuard.remember(savedMessage)
+
+	// sent message finishes typing
+	user := chatUser{savedMessage.ChatId, savedMessage.SenderId}
+	if stopTyping(user) {
+		h.sendTypingToChatConnections(user, false, conn)
+	}
+	sendToChatConnections(savedMessage.ChatId, savedMessage, nil)
+	return nil
+}
+
+func (h Handler) handleReadFrame(ctx context.Context, conn *websocket.Conn, frame []byte) error {
+	var receiptFrame ReadReceiptFrame
+	err := json.Unmarshal(frame, &receiptFrame)
+	if err != nil {
+		return ReadJSONError
+	}
+
+	h.addConnection(receiptFrame.ChatId, receiptFrame.UserId, conn)
+	err = h.readReceipts.MarkAsRead(ctx, receiptFrame.ReadReceipt)
+	if err != nil {
+		return err
+	}
+
+	h.sendReadReceiptToChatConnections(receiptFrame.ReadReceipt, conn)
+	return nil
+}
+
+func (h Handler) handleTypingFrame(ctx context.Context, conn *websocket.Conn, frame []byte) error {
+	var typingFrame TypingFrame
+	err := json.Unmarshal(frame, &typingFrame)
+	if err != nil {
+		return ReadJSONError
+	}
+	if typingFrame.ChatId == 0 || typingFrame.UserId == 0 {
+		return InvalidFrameIdError
+	}
+
+	err = h.addMemberConnection(ctx, typingFrame.ChatId, typingFrame.UserId, conn)
+	if err != nil {
+		return err
+	}
+	user := chatUser{typingFrame.ChatId, typingFrame.UserId}
+	if !typingFrame.Typing {
+		if stopTyping(user) {
+			h.sendTypingToChatConnections(user, false, conn)
+		}
+		return nil
+	}
+
+	typingStarted := startTyping(user, func() {
+		h.sendTypingToChatConnections(user, false, nil)
+	})
+	if typingStarted {
+		h.sendTypingToChatConnections(user, true, conn)
+	}
+	return nil
+}
+
+// presence frame subscribes connection to chat without sending anything
+func (h Handler) handlePresenceFrame(ctx context.Context, conn *websocket.Conn, frame []byte) error {
+	var presenceFrame PresenceFrame
+	err := json.Unmarshal(frame, &presenceFrame)
+	if err != nil {
+		return ReadJSONError
+	}
+	if presenceFrame.ChatId == 0 || presenceFrame.UserId == 0 {
+		return InvalidFrameIdError
+	}
+
+	return h.addMemberConnection(ctx, presenceFrame.ChatId, presenceFrame.UserId, conn)
+}
+
+func (h Handler) handleEditFrame(ctx context.Context, conn *websocket.Conn, frame []byte) error {
+	var editFrame EditFrame
+	err := json.Unmarshal(frame, &editFrame)
+	if err != nil {
+		return ReadJSONError
+	}
+
+	message, err := h.editor.EditMessage(ctx, editFrame.EditMessageRequest)
+	if err != nil {
+		return err
+	}
+
+	h.addConnection(message.ChatId, editFrame.UserId, conn)
+	h.sendMessageEventToChatConnections(EditedFrameType, message)
+	return nil
+}
+
+func (h Handler) handleDeleteFrame(ctx context.Context, conn *websocket.Conn, frame []byte) error {
+	var deleteFrame DeleteFrame
+	err := json.Unmarshal(frame, &deleteFrame)
+	if err != nil {
+		return ReadJSONError
+	}
+
+	message, err := h.editor.DeleteMessage(ctx, deleteFrame.DeleteMessageRequest)
+	if err != nil {
+		return err
+	}
+
+	h.addConnection(message.ChatId, deleteFrame.UserId, conn)
+	h.sendMessageEventToChatConnections(DeletedFrameType, message)
+	return nil
+}
+
+func (h Handler) handleReactionFrame(
+	ctx context.Context, conn *websocket.Conn, frame []byte, change reactionChanger, eventType string) error {
+	var reactionFrame ReactionFrame
+	err := json.Unmarshal(frame, &reactionFrame)
+	if err != nil {
+		return ReadJSONError
+	}
+
+	reactionChange, err := change(ctx, reactionFrame.MessageReaction)
+	if err != nil {
+		return err
+	}
+
+	h.addConnection(reactionChange.ChatId, reactionChange.UserId, conn)
+	h.sendReactionToChatConnections(eventType, reactionChange)
+	return nil
+}
+
+func (h Handler) addConnection(chatId, userId uint, conn *websocket.Conn) {
+	if AddConnection(chatId, userId, conn) {
+		h.sendPresenceToChatConnections(chatUser{chatId, userId}, true, conn)
+	}
 }
 
-func (h Handler) processServiceError(conn *websocket.Conn, err error) {
-	logger.ErrorF("Error while saving message: %v", err)
-	h.trySendErrorMessageToConnection(conn, err)
-	RemoveConnection(conn)
+// frames that change nothing in chat are not checked by services, so membership is checked here
+func (h Handler) addMemberConnection(ctx context.Context, chatId, userId uint, conn *websocket.Conn) error {
+	members, err := h.chatAccessor.GetChatMembers(ctx, chatId, []uint{userId})
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return NotChatMemberError
+	}
+
+	h.addConnection(chatId, userId, conn)
+	return nil
+}
+
+func (h Handler) removeConnection(conn *websocket.Conn) {
+	for _, user := range RemoveConnection(conn) {
+		if stopTyping(user) {
+			h.sendTypingToChatConnections(user, false, nil)
+		}
+		h.sendPresenceToChatConnections(user, false, nil)
+	}
 	_ = conn.Close()
 }
 
-func (h Handler) trySendErrorMessageToConnection(conn *websocket.Conn, err error) {
-	writeError := conn.WriteJSON(models.ErrorResponse{
+// errors are sent in language of upgrade request
+func (h Handler) processReadJSONError(ctx context.Context, conn *websocket.Conn, err error, language string) {
+	logger.WithContext(ctx).ErrorF("Error while reading JSON from connection: %v", err)
+	h.trySendErrorMessageToConnection(ctx, conn, ReadJSONError, language)
+	h.removeConnection(conn)
+}
+
+func (h Handler) processServiceError(ctx context.Context, conn *websocket.Conn, err error, language string) {
+	logger.WithContext(ctx).ErrorF("Error while processing websocket frame: %v", err)
+	h.trySendErrorMessageToConnection(ctx, conn, err, language)
+	h.removeConnection(conn)
+}
+
+func (h Handler) trySendErrorMessageToConnection(ctx context.Context, conn *websocket.Conn, err error, language string) {
+	writeError := WriteJSON(conn, api.NewErrorResponse(err, language))
+	if writeError != nil {
+		logger.WithContext(ctx).ErrorF("Error while sending message to connection: %v", writeError)
+	}
+}
+
+func (h Handler) sendRateLimitedFrame(ctx context.Context, conn *websocket.Conn, err RateLimitedError, language string) {
+	writeError := WriteJSON(conn, RateLimitedFrame{
+		Type:        ErrorFrameType,
 		Status:      api.StatusError,
 		ErrorDetail: err.Error(),
+		Message:     i18n.Translate(language, err.Error(), nil),
+		ChatId:      err.ChatId,
+		Reason:      err.Reason,
+		RetryAfter:  err.RetryAfter.Milliseconds(),
 	})
 	if writeError != nil {
-		logger.ErrorF("Error while sending message to connection: %v", writeError)
+		logger.WithContext(ctx).ErrorF("Error while sending message to connection: %v", writeError)
 	}
 }
 
-func (h Handler) sendMessageToAllChatConnections(message models.Message) {
-	for _, connection := range GetConnections(message.ChatId) {
-		_ = connection.WriteJSON(message)
-	}
+// receipt is not sent back to connection that has read messages
+func (h Handler) sendReadReceiptToChatConnections(receipt models.ReadReceipt, sender *websocket.Conn) {
+	sendToChatConnections(receipt.ChatId, ReadReceiptFrame{Type: ReadFrameType, ReadReceipt: receipt}, sender)
+}
+
+func (h Handler) sendTypingToChatConnections(user chatUser, typing bool, sender *websocket.Conn) {
+	sendToChatConnections(user.chatId, TypingFrame{
+		Type:   TypingFrameType,
+		ChatId: user.chatId,
+		UserId: user.userId,
+		Typing: typing,
+	}, sender)
+}
+
+func (h Handler) sendPresenceToChatConnections(user chatUser, online bool, sender *websocket.Conn) {
+	sendToChatConnections(user.chatId, PresenceFrame{
+		Type:   PresenceFrameType,
+		ChatId: user.chatId,
+		UserId: user.userId,
+		Online: online,
+	}, sender)
+}
+
+func (h Handler) sendMessageEventToChatConnections(eventType string, message models.Message) {
+	sendToChatConnections(message.ChatId, MessageEventFrame{Type: eventType, Message: message}, nil)
+}
+
+func (h Handler) sendReactionToChatConnections(eventType string, change models.ReactionChange) {
+	sendToChatConnections(change.ChatId, ReactionEventFrame{Type: eventType, ReactionChange: change}, nil)
 }
@@ -5,6 +5,7 @@ import (
 	"api/middlewares"
 	"encoding/json"
 	"fmt"
+	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/jmoiron/sqlx"
 	"interfaces"
@@ -12,8 +13,11 @@ import (
 	"log"
 	meetingsAPIMock "mock/api"
 	mock "mock/repositories"
+	servicesMock "mock/services"
 	"models"
+	"net/http/httptest"
 	"os"
+	"plugins/code"
 	"plugins/config"
 	"repositories"
 	"services"
@@ -21,13 +25,18 @@ import (
 	"services/proxies/validation"
 	"strings"
 	"testing"
+	"time"
 	"utils"
 )
 
 var (
-	db             *sqlx.DB
-	sessionService interfaces.SessionAccessorService
-	router         = api.GetRouter()
+	attachmentsDir    string
+	coderKey          string
+	db                *sqlx.DB
+	sessionService    interfaces.SessionAccessorService
+	messagesService   interfaces.Messages
+	directChatService interfaces.DirectChat
+	router            = api.GetRouter()
 )
 
 func init() {
@@ -40,21 +49,45 @@ func init() {
 		os.Exit(1)
 	}
 
-	coderKey, err := config.GetCoderKey()
+	coderKey, err = config.GetCoderKey()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	attachmentsDir, err = ioutil.TempDir("", "attachments")
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
 	sessionService = services.Session(coderKey)
+	messagesRepository := repositories.Messages(db, mock.QueryTimeout)
+	messagesService = services.Messages(messagesRepository, validation.ContentChecker{})
+	directChatService = services.DirectChat(
+		repositories.Chat(db, mock.QueryTimeout), repositories.UsersPrivacy(db, mock.QueryTimeout))
 	InitRequestHandlers(
-		services.Messages(repositories.Messages(db)),
+		messagesService,
+		services.ChatAccessor(repositories.Chat(db, mock.QueryTimeout)),
+		directChatService,
+		services.ReadReceipts(repositories.ReadReceipts(db, mock.QueryTimeout)),
+		services.MessagesEditor(messagesRepository, time.Hour),
+		services.Reactions(messagesRepository),
+		services.Attachments(
+			repositories.Attachments(db, mock.QueryTimeout),
+			repositories.LocalBlobStore(attachmentsDir, mock.QueryTimeout),
+			servicesMock.AttachmentLimits,
+		),
+		services.MessagesSearch(messagesRepository),
+		services.Notifications(messagesRepository),
+		models.MessageRateLimits{},
+		servicesMock.AttachmentLimits.UploadTimeout,
 		middlewares.AuthSession{Service: sessionService}.HasValidSession,
 	)
 }
 
 func getWS(serverURL string) *websocket.Conn {
-	path := "ws" + strings.TrimPrefix(serverURL, "http") + "/ws"
+	path := "ws" + strings.TrimPrefix(serverURL, "http") + api.BasePath + "/ws"
 	ws, res, err := websocket.DefaultDialer.Dial(path, nil)
 	if err != nil {
 		if err == websocket.ErrBadHandshake && res != nil {
@@ -67,10 +100,28 @@ func getWS(serverURL string) *websocket.Conn {
 	return ws
 }
 
+// each server has its own flood protection, so limits are not spent by other tests
+func getRateLimitedServer(limits models.MessageRateLimits) *httptest.Server {
+	handler := Handler{service: messagesService, directChat: directChatService, floodGuard: newFloodGuard(limits)}
+	rateLimitedRouter := mux.NewRouter()
+	rateLimitedRouter.HandleFunc(api.BasePath+"/ws", handler.handleWS)
+
+	return utils.GetTestServer(rateLimitedRouter)
+}
+
+// id and sending time are generated on save
+func assertMessagesEqual(expected, actual models.Message, t *testing.T) {
+	utils.AssertEqual(expected.ChatId, actual.ChatId, t)
+	utils.AssertEqual(expected.SenderId, actual.SenderId, t)
+	utils.AssertEqual(expected.Text, actual.Text, t)
+}
+
 func TestMain(m *testing.M) {
 	mock.DropTables(db)
 	log.SetOutput(ioutil.Discard)
-	os.Exit(m.Run())
+	res := m.Run()
+	_ = os.RemoveAll(attachmentsDir)
+	os.Exit(res)
 }
 
 func TestGetMessages_Success(t *testing.T) {
@@ -173,7 +224,8 @@ func TestSendMessage_OneConnection(t *testing.T) {
 		var message models.Message
 		err = ws.ReadJSON(&message)
 		utils.AssertNil(err, t)
-		utils.AssertEqual(meetingsAPIMock.GetSimpleMessage(), message, t)
+		assertMessagesEqual(meetingsAPIMock.GetSimpleMessage(), message, t)
+		utils.AssertNotEqual(uint(0), message.Id, t)
 	})
 
 	t.Run("Chat not found", func(t *testing.T) {
@@ -246,6 +298,40 @@ func TestSendMessage_OneConnection(t *testing.T) {
 	})
 }
 
+func TestSendMessage_Direct(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+	ws := getWS(testServer.URL)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	t.Run("Direct chat created on first message", func(t *testing.T) {
+		err := ws.WriteJSON(meetingsAPIMock.GetDirectMessage())
+		utils.AssertNil(err, t)
+
+		var message models.Message
+		err = ws.ReadJSON(&message)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(mock.NotExistsChatId, message.ChatId, t)
+		utils.AssertEqual(meetingsAPIMock.GetDirectMessage().Text, message.Text, t)
+	})
+
+	t.Run("Direct messages denied", func(t *testing.T) {
+		err := ws.WriteJSON(meetingsAPIMock.GetDirectMessageToBlockingUser())
+		utils.AssertNil(err, t)
+
+		var message models.ErrorResponse
+		err = ws.ReadJSON(&message)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(api.StatusError, message.Status, t)
+		utils.AssertEqual(errors.DirectMessagesDenied.Error(), message.ErrorDetail, t)
+	})
+}
+
 func TestSendMessage_TwoConnections(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
@@ -276,7 +362,7 @@ func TestSendMessage_TwoConnections(t *testing.T) {
 		_ = ws2.WriteJSON(message2)
 		_ = ws1.ReadJSON(&tmpMessage)
 
-		utils.AssertEqual(message2, tmpMessage, t)
+		assertMessagesEqual(message2, tmpMessage, t)
 	})
 
 	t.Run("Try send message to closed connection", func(t *testing.T) {
@@ -296,7 +382,7 @@ func TestSendMessage_TwoConnections(t *testing.T) {
 
 		err = ws2.ReadJSON(&tmpMessage)
 		utils.AssertNil(err, t)
-		utils.AssertEqual(message2, tmpMessage, t)
+		assertMessagesEqual(message2, tmpMessage, t)
 	})
 }
 
@@ -307,3 +393,729 @@ func TestSendMessage_BadProtocol(t *testing.T) {
 
 	utils.AssertNotNil(err, t)
 }
+
+func TestSendMessage_RateLimited(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	readRateLimitedFrame := func(ws *websocket.Conn, t *testing.T) RateLimitedFrame {
+		var frame RateLimitedFrame
+		err := ws.ReadJSON(&frame)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(ErrorFrameType, frame.Type, t)
+		utils.AssertEqual(RateLimitedErrorDetail, frame.ErrorDetail, t)
+		utils.AssertTrue(frame.RetryAfter > 0, t)
+
+		return frame
+	}
+	sendAndRead := func(ws *websocket.Conn, messages []models.Message, t *testing.T) {
+		for _, message := range messages {
+			err := ws.WriteJSON(message)
+			utils.AssertNil(err, t)
+
+			var savedMessage models.Message
+			err = ws.ReadJSON(&savedMessage)
+			utils.AssertNil(err, t)
+			assertMessagesEqual(message, savedMessage, t)
+		}
+	}
+
+	t.Run("User burst is limited", func(t *testing.T) {
+		testServer := getRateLimitedServer(meetingsAPIMock.MessageRateLimits)
+		defer testServer.Close()
+		ws := getWS(testServer.URL)
+		defer func() {
+			_ = ws.Close()
+		}()
+
+		burst := meetingsAPIMock.GetBurstMessages(1, meetingsAPIMock.MessageRateLimits.UserBurst+1)
+		sendAndRead(ws, burst[:len(burst)-1], t)
+		err := ws.WriteJSON(burst[len(burst)-1])
+		utils.AssertNil(err, t)
+
+		utils.AssertEqual(UserRateLimitReason, readRateLimitedFrame(ws, t).Reason, t)
+	})
+
+	t.Run("Chat burst is limited and connection stays open", func(t *testing.T) {
+		testServer := getRateLimitedServer(meetingsAPIMock.MessageRateLimits)
+		defer testServer.Close()
+		ws := getWS(testServer.URL)
+		defer func() {
+			_ = ws.Close()
+		}()
+
+		limits := meetingsAPIMock.MessageRateLimits
+		sendAndRead(ws, meetingsAPIMock.GetBurstMessages(1, limits.UserBurst), t)
+		sendAndRead(ws, meetingsAPIMock.GetBurstMessages(2, limits.ChatBurst-limits.UserBurst), t)
+
+		message := meetingsAPIMock.GetBurstMessages(3, 1)[0]
+		err := ws.WriteJSON(message)
+		utils.AssertNil(err, t)
+		frame := readRateLimitedFrame(ws, t)
+		utils.AssertEqual(ChatRateLimitReason, frame.Reason, t)
+		utils.AssertEqual(message.ChatId, frame.ChatId, t)
+	})
+
+	t.Run("Duplicate is suppressed", func(t *testing.T) {
+		testServer := getRateLimitedServer(meetingsAPIMock.MessageRateLimits)
+		defer testServer.Close()
+		ws := getWS(testServer.URL)
+		defer func() {
+			_ = ws.Close()
+		}()
+
+		message := meetingsAPIMock.GetSimpleMessage()
+		sendAndRead(ws, []models.Message{message}, t)
+
+		_ = ws.WriteJSON(message)
+		utils.AssertEqual(DuplicateReason, readRateLimitedFrame(ws, t).Reason, t)
+	})
+}
+
+func TestMarkAsRead_Success(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	var response models.DefaultResponse
+	err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.MarkAsReadRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+}
+
+func TestMarkAsRead_MessageNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(meetingsAPIMock.MarkAsReadMessageFromAnotherChatRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(errors.ChatMessageNotFound.Error(), response.ErrorDetail, t)
+}
+
+func TestMarkAsRead_InvalidData(t *testing.T) {
+	var response models.ErrorResponse
+	err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.MarkAsReadInvalidDataRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertTrue(strings.Contains(response.ErrorDetail, validation.InvalidId), t)
+}
+
+func TestGetUnreadCounts_Success(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	var response meetingsAPIMock.UnreadCountsResponse
+	err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.GetUnreadCountsRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+	var total uint
+	for _, count := range response.Data.Chats {
+		total += count.Count
+	}
+	utils.AssertEqual(total, response.Data.Total, t)
+}
+
+func TestGetUnreadCounts_InvalidData(t *testing.T) {
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(meetingsAPIMock.GetUnreadCountsInvalidIdRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertTrue(strings.Contains(response.ErrorDetail, validation.InvalidId), t)
+}
+
+func TestSearchMessages_Success(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	query := mock.GetFirstUserSearchQuery()
+	var response meetingsAPIMock.SearchHitsResponse
+	err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.SearchMessagesRequest(router, query))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+	utils.AssertEqual(1, len(response.Data), t)
+	utils.AssertEqual(query.ChatId, response.Data[0].ChatId, t)
+	highlighted := models.SearchHighlightStart + "world" + models.SearchHighlightStop
+	utils.AssertTrue(strings.Contains(response.Data[0].Context, highlighted), t)
+}
+
+func TestSearchMessages_InvalidData(t *testing.T) {
+	query := mock.GetFirstUserSearchQuery()
+	query.Text = " "
+	var response models.ErrorResponse
+	err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.SearchMessagesRequest(router, query))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertTrue(strings.Contains(response.ErrorDetail, validation.InvalidSearchText), t)
+}
+
+func TestReadReceipt_Broadcast(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws1, ws2 := getWS(testServer.URL), getWS(testServer.URL)
+	defer func() {
+		_ = ws1.Close()
+		_ = ws2.Close()
+	}()
+
+	var message models.Message
+	_ = ws2.WriteJSON(meetingsAPIMock.GetSimpleMessage())
+	_ = ws2.ReadJSON(&message)
+
+	receipt := meetingsAPIMock.GetReadReceiptFrame()
+	err := ws1.WriteJSON(receipt)
+	utils.AssertNil(err, t)
+
+	var receivedReceipt ReadReceiptFrame
+	err = ws2.ReadJSON(&receivedReceipt)
+	utils.AssertNil(err, t)
+	utils.AssertEqual(ReadFrameType, receivedReceipt.Type, t)
+	utils.AssertEqual(receipt.ReadReceipt, receivedReceipt.ReadReceipt, t)
+}
+
+func TestPresence_OnlineUsers(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws1, ws2 := getWS(testServer.URL), getWS(testServer.URL)
+	defer func() {
+		_ = ws1.Close()
+	}()
+
+	var message models.Message
+	_ = ws1.WriteJSON(meetingsAPIMock.GetRequestChatMessage())
+	_ = ws1.ReadJSON(&message)
+
+	t.Run("User goes online", func(t *testing.T) {
+		err := ws2.WriteJSON(meetingsAPIMock.GetPresenceFrame())
+		utils.AssertNil(err, t)
+
+		var presence PresenceFrame
+		err = ws1.ReadJSON(&presence)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(PresenceFrameType, presence.Type, t)
+		utils.AssertTrue(presence.Online, t)
+
+		var response meetingsAPIMock.OnlineUsersResponse
+		err = json.NewDecoder(
+			utils.MakeRequest(meetingsAPIMock.GetOnlineUsersRequest(router, message.ChatId))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(2, len(response.Data), t)
+		utils.AssertEqual(message.SenderId, response.Data[0], t)
+		utils.AssertEqual(presence.UserId, response.Data[1], t)
+	})
+
+	t.Run("User goes offline", func(t *testing.T) {
+		_ = ws2.Close()
+
+		var presence PresenceFrame
+		err := ws1.ReadJSON(&presence)
+		utils.AssertNil(err, t)
+		utils.AssertFalse(presence.Online, t)
+		onlineUsers := GetOnlineUsers(message.ChatId)
+		utils.AssertEqual(1, len(onlineUsers), t)
+		utils.AssertEqual(message.SenderId, onlineUsers[0], t)
+	})
+}
+
+func TestPresence_NotChatMember(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws := getWS(testServer.URL)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	err := ws.WriteJSON(meetingsAPIMock.GetNotMemberPresenceFrame())
+	utils.AssertNil(err, t)
+
+	var response models.ErrorResponse
+	err = ws.ReadJSON(&response)
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(NotChatMemberError.Error(), response.ErrorDetail, t)
+	utils.AssertEqual(0, len(GetOnlineUsers(mock.FirstRequestChatId)), t)
+}
+
+func TestTyping_Expiry(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	defaultTimeout := TypingTimeout
+	TypingTimeout = 100 * time.Millisecond
+	defer func() {
+		TypingTimeout = defaultTimeout
+	}()
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws1, ws2 := getWS(testServer.URL), getWS(testServer.URL)
+	defer func() {
+		_ = ws1.Close()
+		_ = ws2.Close()
+	}()
+
+	var message models.Message
+	_ = ws1.WriteJSON(meetingsAPIMock.GetRequestChatMessage())
+	_ = ws1.ReadJSON(&message)
+
+	err := ws2.WriteJSON(meetingsAPIMock.GetTypingFrame(true))
+	utils.AssertNil(err, t)
+
+	typing, err := readTypingFrame(ws1)
+	utils.AssertNil(err, t)
+	utils.AssertTrue(typing.Typing, t)
+
+	// nothing is sent by client, so typing must expire
+	typing, err = readTypingFrame(ws1)
+	utils.AssertNil(err, t)
+	utils.AssertFalse(typing.Typing, t)
+}
+
+// presence frames can be sent before typing frames
+func readTypingFrame(ws *websocket.Conn) (TypingFrame, error) {
+	for {
+		var typing TypingFrame
+		err := ws.ReadJSON(&typing)
+		if err != nil || typing.Type == TypingFrameType {
+			return typing, err
+		}
+	}
+}
+
+func TestEditMessage(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws := getWS(testServer.URL)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	var message models.Message
+	_ = ws.WriteJSON(meetingsAPIMock.GetSimpleMessage())
+	_ = ws.ReadJSON(&message)
+
+	t.Run("Author edits message", func(t *testing.T) {
+		var response meetingsAPIMock.MessageResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.EditMessageRequest(router, message.Id, message.SenderId))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(api.StatusOk, response.Status, t)
+		utils.AssertEqual("edited", response.Data.Text, t)
+
+		var event meetingsAPIMock.MessageEventFrame
+		err = ws.ReadJSON(&event)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(EditedFrameType, event.Type, t)
+		utils.AssertEqual(message.Id, event.Message.Id, t)
+
+		var editsResponse meetingsAPIMock.MessageEditsResponse
+		err = json.NewDecoder(
+			utils.MakeRequest(meetingsAPIMock.GetMessageEditsRequest(router, message.Id))).Decode(&editsResponse)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(1, len(editsResponse.Data), t)
+		utils.AssertEqual(message.Text, editsResponse.Data[0].Text, t)
+	})
+
+	t.Run("Not author can not edit message", func(t *testing.T) {
+		var response models.ErrorResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.EditMessageRequest(router, message.Id, message.SenderId+1))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(api.StatusError, response.Status, t)
+		utils.AssertEqual(errors.MessageEditForbidden.Error(), response.ErrorDetail, t)
+	})
+
+	t.Run("Edit window expired", func(t *testing.T) {
+		oldMessage := mock.GetAllMessages()[0]
+
+		var response models.ErrorResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.EditMessageRequest(router, oldMessage.Id, oldMessage.SenderId))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(errors.EditWindowExpired.Error(), response.ErrorDetail, t)
+	})
+
+	t.Run("Author deletes message", func(t *testing.T) {
+		var response meetingsAPIMock.MessageResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.DeleteMessageRequest(router, message.Id, message.SenderId))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(api.StatusOk, response.Status, t)
+		utils.AssertNotNil(response.Data.DeletedAt, t)
+
+		var event meetingsAPIMock.MessageEventFrame
+		err = ws.ReadJSON(&event)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(DeletedFrameType, event.Type, t)
+		utils.AssertEqual("", event.Message.Text, t)
+	})
+
+	t.Run("Deleted message can not be edited", func(t *testing.T) {
+		var response models.ErrorResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.EditMessageRequest(router, message.Id, message.SenderId))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(errors.MessageNotFound.Error(), response.ErrorDetail, t)
+	})
+}
+
+func TestDeleteMessage_ByMeetingAdmin(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	oldMessage := mock.GetAllMessages()[0]
+	var response meetingsAPIMock.MessageResponse
+	err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.DeleteMessageRequest(
+		router, oldMessage.Id, mock.GetChatMeetingAdminId(oldMessage.ChatId)))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+}
+
+func TestPinnedMessages(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws := getWS(testServer.URL)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	var message models.Message
+	_ = ws.WriteJSON(meetingsAPIMock.GetSimpleMessage())
+	_ = ws.ReadJSON(&message)
+	adminId := mock.GetChatMeetingAdminId(message.ChatId)
+
+	t.Run("Meeting admin pins message", func(t *testing.T) {
+		var response meetingsAPIMock.MessageResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.PinMessageRequest(router, message.Id, adminId))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(api.StatusOk, response.Status, t)
+		utils.AssertNotNil(response.Data.PinnedAt, t)
+
+		var event meetingsAPIMock.MessageEventFrame
+		err = ws.ReadJSON(&event)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(PinnedFrameType, event.Type, t)
+		utils.AssertEqual(message.Id, event.Message.Id, t)
+
+		var pinnedResponse meetingsAPIMock.MessagesResponse
+		err = json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.GetPinnedMessagesRequest(router, message.ChatId))).Decode(&pinnedResponse)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(1, len(pinnedResponse.Data), t)
+		utils.AssertEqual(message.Id, pinnedResponse.Data[0].Id, t)
+	})
+
+	t.Run("Not admin can not pin message", func(t *testing.T) {
+		var response models.ErrorResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.PinMessageRequest(router, message.Id, adminId+1))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(errors.MessagePinForbidden.Error(), response.ErrorDetail, t)
+	})
+
+	t.Run("Meeting admin unpins message", func(t *testing.T) {
+		var response meetingsAPIMock.MessageResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.UnpinMessageRequest(router, message.Id, adminId))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(api.StatusOk, response.Status, t)
+
+		var event meetingsAPIMock.MessageEventFrame
+		err = ws.ReadJSON(&event)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(UnpinnedFrameType, event.Type, t)
+
+		var pinnedResponse meetingsAPIMock.MessagesResponse
+		err = json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.GetPinnedMessagesRequest(router, message.ChatId))).Decode(&pinnedResponse)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(0, len(pinnedResponse.Data), t)
+	})
+}
+
+func TestAnnouncement(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws := getWS(testServer.URL)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	announcement := meetingsAPIMock.GetAnnouncementMessage()
+
+	t.Run("Not admin can not send announcement", func(t *testing.T) {
+		notAdminAnnouncement := announcement
+		notAdminAnnouncement.SenderId = 4
+		_ = ws.WriteJSON(notAdminAnnouncement)
+
+		var response models.ErrorResponse
+		err := ws.ReadJSON(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(errors.AnnouncementDenied.Error(), response.ErrorDetail, t)
+	})
+
+	t.Run("Announcement notifies chat members", func(t *testing.T) {
+		var message models.Message
+		_ = ws.WriteJSON(announcement)
+		err := ws.ReadJSON(&message)
+		utils.AssertNil(err, t)
+		utils.AssertTrue(message.Announcement, t)
+
+		var response meetingsAPIMock.NotificationsResponse
+		err = json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.GetNotificationsRequest(router, 4))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(1, len(response.Data), t)
+		utils.AssertEqual(message.Id, response.Data[0].MessageId, t)
+
+		err = json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.GetNotificationsRequest(router, announcement.SenderId))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(0, len(response.Data), t)
+	})
+}
+
+func TestReactions(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws := getWS(testServer.URL)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	var message models.Message
+	_ = ws.WriteJSON(meetingsAPIMock.GetSimpleMessage())
+	_ = ws.ReadJSON(&message)
+
+	t.Run("Reaction is added through websocket", func(t *testing.T) {
+		err := ws.WriteJSON(meetingsAPIMock.GetReactionFrame(ReactFrameType, message.Id))
+		utils.AssertNil(err, t)
+
+		var event meetingsAPIMock.ReactionEventFrame
+		err = ws.ReadJSON(&event)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(ReactionAddedFrameType, event.Type, t)
+		utils.AssertEqual(message.Id, event.MessageId, t)
+		utils.AssertEqual(uint(1), event.Count, t)
+	})
+
+	t.Run("Reaction is added with REST and counted in history", func(t *testing.T) {
+		var response meetingsAPIMock.ReactionResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.AddReactionRequest(router, message.Id, 1, "👍"))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(api.StatusOk, response.Status, t)
+		utils.AssertEqual(uint(2), response.Data.Count, t)
+
+		var event meetingsAPIMock.ReactionEventFrame
+		err = ws.ReadJSON(&event)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(uint(2), event.Count, t)
+
+		var messagesResponse meetingsAPIMock.MessagesResponse
+		err = json.NewDecoder(
+			utils.MakeRequest(meetingsAPIMock.GetMessagesOfViewerRequest(router, 1))).Decode(&messagesResponse)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(message.Id, messagesResponse.Data[0].Id, t)
+		utils.AssertEqual(1, len(messagesResponse.Data[0].Reactions), t)
+		utils.AssertEqual(
+			models.Reaction{Emoji: "👍", Count: 2, ReactedByMe: true}, messagesResponse.Data[0].Reactions[0], t)
+	})
+
+	t.Run("Reaction is removed", func(t *testing.T) {
+		var response meetingsAPIMock.ReactionResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.RemoveReactionRequest(router, message.Id, 1, "👍"))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(uint(1), response.Data.Count, t)
+
+		var event meetingsAPIMock.ReactionEventFrame
+		err = ws.ReadJSON(&event)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(ReactionRemovedFrameType, event.Type, t)
+	})
+
+	t.Run("Invalid emoji", func(t *testing.T) {
+		var response models.ErrorResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.AddReactionRequest(router, message.Id, 1, "like"))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(validation.InvalidEmoji, response.ErrorDetail, t)
+	})
+}
+
+func TestThread(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws := getWS(testServer.URL)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	var root, reply, replyToReply models.Message
+	_ = ws.WriteJSON(meetingsAPIMock.GetSimpleMessage())
+	_ = ws.ReadJSON(&root)
+
+	replyMessage := meetingsAPIMock.GetAnotherSimpleMessage()
+	replyMessage.ReplyTo = &root.Id
+	_ = ws.WriteJSON(replyMessage)
+	_ = ws.ReadJSON(&reply)
+	utils.AssertNotNil(reply.ThreadRootId, t)
+	utils.AssertEqual(root.Id, *reply.ThreadRootId, t)
+
+	replyMessage.ReplyTo = &reply.Id
+	_ = ws.WriteJSON(replyMessage)
+	_ = ws.ReadJSON(&replyToReply)
+
+	t.Run("Thread contains all replies", func(t *testing.T) {
+		var response meetingsAPIMock.ThreadResponse
+		err := json.NewDecoder(
+			utils.MakeRequest(meetingsAPIMock.GetThreadRequest(router, root.Id, 0))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(api.StatusOk, response.Status, t)
+		utils.AssertEqual(root.Id, response.Data.Root.Id, t)
+		utils.AssertEqual(2, len(response.Data.Replies), t)
+		utils.AssertEqual(reply.Id, response.Data.Replies[0].Id, t)
+		utils.AssertEqual(reply.Id, response.Data.Replies[1].Parent.Id, t)
+		utils.AssertEqual(reply.Text, response.Data.Replies[1].Parent.Text, t)
+	})
+
+	t.Run("Thread pagination", func(t *testing.T) {
+		var response meetingsAPIMock.ThreadResponse
+		err := json.NewDecoder(
+			utils.MakeRequest(meetingsAPIMock.GetThreadRequest(router, root.Id, reply.Id))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(1, len(response.Data.Replies), t)
+		utils.AssertEqual(replyToReply.Id, response.Data.Replies[0].Id, t)
+	})
+
+	t.Run("Thread of not exists message", func(t *testing.T) {
+		var response models.ErrorResponse
+		err := json.NewDecoder(utils.MakeRequest(
+			meetingsAPIMock.GetThreadRequest(router, mock.GetNotExistsMessageId()+10, 0))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(errors.MessageNotFound.Error(), response.ErrorDetail, t)
+	})
+}
+
+func TestAttachments(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	testServer := utils.GetTestServer(router)
+	defer testServer.Close()
+
+	ws := getWS(testServer.URL)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	simpleMessage := meetingsAPIMock.GetSimpleMessage()
+	image := servicesMock.GetPNGContent()
+	var attachment models.Attachment
+
+	t.Run("Image is uploaded with thumbnail", func(t *testing.T) {
+		var response meetingsAPIMock.AttachmentResponse
+		err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.UploadAttachmentRequest(
+			router, simpleMessage.SenderId, "image.png", image))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(api.StatusOk, response.Status, t)
+		utils.AssertEqual("image/png", response.Data.MimeType, t)
+		utils.AssertEqual(int64(len(image)), response.Data.Size, t)
+		utils.AssertTrue(response.Data.HasThumbnail, t)
+		attachment = response.Data
+
+		content, _ := ioutil.ReadAll(utils.MakeRequest(
+			meetingsAPIMock.DownloadAttachmentRequest(router, attachment.Id, meetingsAPIMock.TestToken, false)))
+		utils.AssertEqual(string(image), string(content), t)
+	})
+
+	t.Run("Message is sent with attachment", func(t *testing.T) {
+		message := simpleMessage
+		message.Text = ""
+		message.AttachmentIds = []uint{attachment.Id}
+
+		var savedMessage models.Message
+		_ = ws.WriteJSON(message)
+		err := ws.ReadJSON(&savedMessage)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(1, len(savedMessage.Attachments), t)
+		utils.AssertEqual(attachment.Id, savedMessage.Attachments[0].Id, t)
+
+		var response meetingsAPIMock.MessagesResponse
+		err = json.NewDecoder(utils.MakeRequest(meetingsAPIMock.GetMessagesRequest(router))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(1, len(response.Data[0].Attachments), t)
+	})
+
+	t.Run("Attachment is not available outside of chat", func(t *testing.T) {
+		token, _ := code.NewCoder(coderKey).Encrypt(map[string]interface{}{"id": mock.UserIdNotInFirstChat})
+
+		var response models.ErrorResponse
+		err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.DownloadAttachmentRequest(
+			router, attachment.Id, token, false))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(errors.AttachmentNotFound.Error(), response.ErrorDetail, t)
+	})
+
+	t.Run("Not allowed type", func(t *testing.T) {
+		var response models.ErrorResponse
+		err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.UploadAttachmentRequest(
+			router, simpleMessage.SenderId, "doc.pdf", []byte("%PDF-1.4")))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(errors.AttachmentNotAllowed.Error(), response.ErrorDetail, t)
+	})
+
+	t.Run("Too large attachment", func(t *testing.T) {
+		var response models.ErrorResponse
+		err := json.NewDecoder(utils.MakeRequest(meetingsAPIMock.UploadAttachmentRequest(
+			router, simpleMessage.SenderId, "big.txt",
+			[]byte(strings.Repeat("a", int(servicesMock.AttachmentLimits.MaxSize)+1))))).Decode(&response)
+		utils.AssertNil(err, t)
+		utils.AssertEqual(errors.AttachmentTooLarge.Error(), response.ErrorDetail, t)
+	})
+}
@@ -0,0 +1,20 @@
+package messages
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	openConnections = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "websocket_connections",
+		Help: "Count of open websocket connections",
+	})
+
+	// one connection can be subscribed to several chats
+	chatSubscriptions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "websocket_chat_subscriptions",
+		Help: "Count of subscriptions of websocket connections to chats",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(openConnections, chatSubscriptions)
+}
@@ -0,0 +1,67 @@
+package messages
+
+import (
+	"sync"
+	"time"
+)
+
+// typing indicator is dropped if client does not renew it during this time
+var TypingTimeout = 5 * time.Second
+
+type typingState struct {
+	timer *time.Timer
+}
+
+var (
+	typingMutex  sync.Mutex
+	typingStates = map[chatUser]*typingState{}
+)
+
+// returns true if user was not typing before
+func startTyping(user chatUser, onExpired func()) bool {
+	typingMutex.Lock()
+	defer typingMutex.Unlock()
+
+	state, found := typingStates[user]
+	if found && state.timer.Stop() {
+		state.timer.Reset(TypingTimeout)
+		return false
+	}
+
+	// timer of previous state could already fire, its callback will see that state was replaced
+	newState := &typingState{}
+	newState.timer = time.AfterFunc(TypingTimeout, func() {
+		if expireTyping(user, newState) {
+			onExpired()
+		}
+	})
+	typingStates[user] = newState
+
+	return !found
+}
+
+// returns true if user was typing
+func stopTyping(user chatUser) bool {
+	typingMutex.Lock()
+	defer typingMutex.Unlock()
+
+	state, found := typingStates[user]
+	if found {
+		state.timer.Stop()
+		delete(typingStates, user)
+	}
+
+	return found
+}
+
+func expireTyping(user chatUser, state *typingState) bool {
+	typingMutex.Lock()
+	defer typingMutex.Unlock()
+
+	if typingStates[user] != state {
+		return false
+	}
+
+	delete(typingStates, user)
+	return true
+}
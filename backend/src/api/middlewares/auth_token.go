@@ -6,19 +6,22 @@ import (
 	"net/http"
 )
 
+// user id is kept in session as JSON number
+const sessionUserIdField = "id"
+
 type AuthSession struct {
 	Service interfaces.SessionAccessorService
 }
 
 func (a AuthSession) HasValidSession(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		defer api.SendErrorIfPanicked(w)
-
-		_, err := a.Service.GetSession(r)
-		if err == nil {
-			next.ServeHTTP(w, r)
-		} else {
-			panic(NoSession)
+	return api.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		session, err := a.Service.GetSession(r)
+		if err != nil {
+			return NoSession
 		}
+
+		userId, _ := session[sessionUserIdField].(float64)
+		next.ServeHTTP(w, r.WithContext(api.ContextWithSessionUserId(r.Context(), uint(userId))))
+		return nil
 	})
 }
@@ -0,0 +1,50 @@
+package middlewares
+
+import (
+	"api"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"utils"
+)
+
+type sessionServiceMock struct {
+	session map[string]interface{}
+}
+
+func (m sessionServiceMock) GetSession(*http.Request) (map[string]interface{}, error) {
+	if m.session == nil {
+		return nil, errors.New("no session")
+	}
+
+	return m.session, nil
+}
+
+// returns status of response and user id from context of request passed to handler
+func serveWithSession(session map[string]interface{}) (int, uint) {
+	var userId uint
+	handler := AuthSession{Service: sessionServiceMock{session}}.HasValidSession(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userId = api.SessionUserId(r.Context())
+		}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	return w.Code, userId
+}
+
+func TestAuthSession_PassesUserIdOfSession(t *testing.T) {
+	status, userId := serveWithSession(map[string]interface{}{sessionUserIdField: float64(3)})
+
+	utils.AssertEqual(http.StatusOK, status, t)
+	utils.AssertEqual(uint(3), userId, t)
+}
+
+func TestAuthSession_RejectsRequestWithoutSession(t *testing.T) {
+	status, userId := serveWithSession(nil)
+
+	utils.AssertNotEqual(http.StatusOK, status, t)
+	utils.AssertEqual(uint(0), userId, t)
+}
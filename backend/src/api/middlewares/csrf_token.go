@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"plugins/code"
+	"plugins/logger"
 	"regexp"
 	"time"
 	"utils"
@@ -25,34 +26,38 @@ type CsrfToken struct {
 }
 
 func (c CsrfToken) Check(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		defer api.SendErrorIfPanicked(w)
-
+	return api.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
 		if isGetSessionRequest(r) {
-			c.setCSRFToken(w)
+			if err := c.setCSRFToken(w); err != nil {
+				return err
+			}
 		}
 
 		if !needToCheckRequest(r.Method) {
 			next.ServeHTTP(w, r)
-			return
+			return nil
 		}
 
 		cookieToken, err := c.getTokenFromCookie(r)
 		if err != nil {
-			panic(err)
+			return err
 		}
 
 		headerToken, err := c.getTokenFromHeader(r)
 		if err != nil {
-			panic(err)
+			return err
 		}
 
-		if headerToken == cookieToken {
-			next.ServeHTTP(w, r)
-			c.setCSRFToken(w)
-		} else {
-			panic(InvalidCSRFToken)
+		if headerToken != cookieToken {
+			return InvalidCSRFToken
+		}
+
+		next.ServeHTTP(w, r)
+		// response is already sent by next handler, so error can only be logged
+		if err := c.setCSRFToken(w); err != nil {
+			logger.WithContext(r.Context()).ErrorF("Error while setting CSRF token: %v", err)
 		}
+		return nil
 	})
 }
 
@@ -103,7 +108,7 @@ func (c CsrfToken) getTokenFromHeader(r *http.Request) (string, error) {
 	return string(token), nil
 }
 
-func (c CsrfToken) setCSRFToken(w http.ResponseWriter) {
+func (c CsrfToken) setCSRFToken(w http.ResponseWriter) error {
 	publicKey := fmt.Sprintf("%v", time.Now().Unix())
 	encodedPublicKey := base64.StdEncoding.EncodeToString([]byte(publicKey))
 	tokenData := map[string]interface{}{
@@ -111,7 +116,7 @@ func (c CsrfToken) setCSRFToken(w http.ResponseWriter) {
 	}
 	token, err := code.NewCoder(c.PrivateKey).Encrypt(tokenData)
 	if err != nil {
-		panic(CSRFInternalError)
+		return CSRFInternalError
 	}
 
 	w.Header().Add(csrfKey, encodedPublicKey)
@@ -122,4 +127,5 @@ func (c CsrfToken) setCSRFToken(w http.ResponseWriter) {
 		HttpOnly: true,
 		MaxAge:   3600,
 	})
+	return nil
 }
@@ -2,15 +2,21 @@ package middlewares
 
 import (
 	"api"
+	"api/codes"
 	"errors"
+	"net/http"
 )
 
 var (
-	NoSession         = api.ApplicationError{OriginalError: errors.New("no-session")}
-	NoCSRFCookie      = api.ApplicationError{OriginalError: errors.New("no-csrf-cookie")}
-	InvalidCSRFCookie = api.ApplicationError{OriginalError: errors.New("invalid-csrf-cookie")}
-	NoCSRFHeader      = api.ApplicationError{OriginalError: errors.New("no-csrf-header")}
-	InvalidCSRFHeader = api.ApplicationError{OriginalError: errors.New("invalid-csrf-header")}
-	InvalidCSRFToken  = api.ApplicationError{OriginalError: errors.New("invalid-csrf-token")}
-	CSRFInternalError = api.ApplicationError{OriginalError: errors.New("csrf-internal-error")}
+	NoSession         = newError(codes.NoSession, http.StatusUnauthorized)
+	NoCSRFCookie      = newError(codes.NoCSRFCookie, http.StatusForbidden)
+	InvalidCSRFCookie = newError(codes.InvalidCSRFCookie, http.StatusForbidden)
+	NoCSRFHeader      = newError(codes.NoCSRFHeader, http.StatusForbidden)
+	InvalidCSRFHeader = newError(codes.InvalidCSRFHeader, http.StatusForbidden)
+	InvalidCSRFToken  = newError(codes.InvalidCSRFToken, http.StatusForbidden)
+	CSRFInternalError = newError(codes.CSRFInternalError, http.StatusInternalServerError)
 )
+
+func newError(detail string, status int) api.ApplicationError {
+	return api.ApplicationError{OriginalError: errors.New(detail), Status: status}
+}
@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"bufio"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "http_request_duration_seconds",
+	Help:    "Duration of HTTP requests by route, method and status",
+	Buckets: prometheus.DefBuckets,
+}, []string{"route", "method", "status"})
+
+var notHijacker = errors.New("response writer does not support hijacking")
+
+func init() {
+	prometheus.MustRegister(requestDuration)
+}
+
+// Metrics measures requests by route template, so requests to one endpoint with different ids are counted together.
+// Websocket upgrade is skipped, its handler returns only when connection is closed (see websocket_connections gauge)
+func Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if websocket.IsWebSocketUpgrade(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		requestDuration.WithLabelValues(
+			routeTemplate(r), r.Method, strconv.Itoa(recorder.status),
+		).Observe(time.Since(start).Seconds())
+	})
+}
+
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+
+	return "unknown"
+}
+
+// statusRecorder remembers status of response, it supports hijacking for websocket upgrade
+// and unwrapping for handlers that set own deadlines
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, isHijacker := r.ResponseWriter.(http.Hijacker)
+	if !isHijacker {
+		return nil, nil, notHijacker
+	}
+
+	r.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
@@ -0,0 +1,94 @@
+package middlewares
+
+import (
+	"api"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"utils"
+)
+
+const itemRoute = "/items/{id:[0-9]+}"
+
+func sampleCount(observer prometheus.Observer, t *testing.T) uint64 {
+	var metric dto.Metric
+	err := observer.(prometheus.Metric).Write(&metric)
+	utils.AssertNil(err, t)
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func serveItem(path string, handler api.HandlerFunc) int {
+	return serveItemRequest(httptest.NewRequest(http.MethodGet, path, nil), handler)
+}
+
+func serveItemRequest(r *http.Request, handler api.HandlerFunc) int {
+	router := mux.NewRouter()
+	router.Use(Metrics)
+	router.Handle(itemRoute, handler).Methods(http.MethodGet)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestMetrics_ObservesRouteAndStatus(t *testing.T) {
+	okDuration := requestDuration.WithLabelValues(itemRoute, http.MethodGet, "200")
+	okBefore := sampleCount(okDuration, t)
+
+	serveItem("/items/1", func(w http.ResponseWriter, r *http.Request) error {
+		api.SendDefaultResponse(w)
+		return nil
+	})
+	serveItem("/items/2", func(w http.ResponseWriter, r *http.Request) error {
+		api.SendDefaultResponse(w)
+		return nil
+	})
+
+	utils.AssertEqual(okBefore+2, sampleCount(okDuration, t), t)
+}
+
+func TestMetrics_ObservesErrorStatus(t *testing.T) {
+	unauthorizedDuration := requestDuration.WithLabelValues(itemRoute, http.MethodGet, "401")
+	unauthorizedBefore := sampleCount(unauthorizedDuration, t)
+
+	status := serveItem("/items/1", func(w http.ResponseWriter, r *http.Request) error {
+		return NoSession
+	})
+
+	utils.AssertEqual(http.StatusUnauthorized, status, t)
+	utils.AssertEqual(unauthorizedBefore+1, sampleCount(unauthorizedDuration, t), t)
+}
+
+func TestMetrics_SkipsWebsocketUpgrade(t *testing.T) {
+	okDuration := requestDuration.WithLabelValues(itemRoute, http.MethodGet, "200")
+	okBefore := sampleCount(okDuration, t)
+
+	r := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+	r.Header.Set("Connection", "Upgrade")
+	r.Header.Set("Upgrade", "websocket")
+	serveItemRequest(r, func(w http.ResponseWriter, r *http.Request) error {
+		api.SendDefaultResponse(w)
+		return nil
+	})
+
+	utils.AssertEqual(okBefore, sampleCount(okDuration, t), t)
+}
+
+func TestMetrics_LetsHandlerSetDeadlines(t *testing.T) {
+	var deadlinesErr error
+	server := httptest.NewServer(Metrics(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadline := time.Now().Add(time.Minute)
+		deadlinesErr = api.SetDeadlines(w, deadline, deadline)
+	})))
+	defer server.Close()
+
+	response, err := http.Get(server.URL)
+	utils.AssertNil(err, t)
+	_ = response.Body.Close()
+
+	utils.AssertNil(deadlinesErr, t)
+}
@@ -0,0 +1,41 @@
+package middlewares
+
+import (
+	"api"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"plugins/logger"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const requestIdLength = 16
+
+// id from client is kept only if it can be safely written to logs
+var requestIdReg = regexp.MustCompile(`^[\w.-]{1,64}$`)
+
+// RequestId takes id of request from header or generates new one,
+// id is sent back in response and is added to context for logs
+func RequestId(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestId := r.Header.Get(api.RequestIdHeader)
+		if !requestIdReg.MatchString(requestId) {
+			requestId = newRequestId()
+		}
+
+		w.Header().Set(api.RequestIdHeader, requestId)
+		next.ServeHTTP(w, r.WithContext(logger.ContextWithRequestId(r.Context(), requestId)))
+	})
+}
+
+// time is used if random generator fails, so request is not rejected because of id
+func newRequestId() string {
+	id := make([]byte, requestIdLength)
+	if _, err := rand.Read(id); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+
+	return hex.EncodeToString(id)
+}
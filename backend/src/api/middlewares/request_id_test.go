@@ -0,0 +1,49 @@
+package middlewares
+
+import (
+	"api"
+	"net/http"
+	"net/http/httptest"
+	"plugins/logger"
+	"testing"
+	"utils"
+)
+
+// returns id from response header and id from context of request passed to handler
+func serveWithRequestId(requestId string) (string, string) {
+	var contextId string
+	handler := RequestId(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contextId = logger.RequestId(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if requestId != "" {
+		r.Header.Set(api.RequestIdHeader, requestId)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	return w.Header().Get(api.RequestIdHeader), contextId
+}
+
+func TestRequestId_Generated(t *testing.T) {
+	headerId, contextId := serveWithRequestId("")
+
+	utils.AssertEqual(2*requestIdLength, len(headerId), t)
+	utils.AssertEqual(headerId, contextId, t)
+}
+
+func TestRequestId_Propagated(t *testing.T) {
+	headerId, contextId := serveWithRequestId("frontend-42.a")
+
+	utils.AssertEqual("frontend-42.a", headerId, t)
+	utils.AssertEqual("frontend-42.a", contextId, t)
+}
+
+func TestRequestId_InvalidReplaced(t *testing.T) {
+	headerId, contextId := serveWithRequestId("id with spaces\n")
+
+	utils.AssertNotEqual("id with spaces\n", headerId, t)
+	utils.AssertEqual(2*requestIdLength, len(headerId), t)
+	utils.AssertEqual(headerId, contextId, t)
+}
@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"mock/plugins"
+	"net/http"
+	"net/http/httptest"
+	"plugins/tracer"
+	"testing"
+	"utils"
+)
+
+// serves request through router with traced handler, handler starts span from context of request
+func serveTraced(status int) tracetest.SpanStubs {
+	spans := plugins.RecordSpans()
+	router := mux.NewRouter()
+	router.Use(Tracing)
+	router.HandleFunc("/items/{id:[0-9]+}", func(w http.ResponseWriter, r *http.Request) {
+		_, span := tracer.Start(r.Context(), "handler")
+		span.End()
+		w.WriteHeader(status)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/42", nil))
+	return spans.GetSpans()
+}
+
+func TestTracing_StartsSpanOfRoute(t *testing.T) {
+	ended := serveTraced(http.StatusNotFound)
+
+	utils.AssertEqual(2, len(ended), t)
+	request := ended[1]
+	utils.AssertEqual("GET /items/{id:[0-9]+}", request.Name, t)
+	utils.AssertEqual(trace.SpanKindServer, request.SpanKind, t)
+	utils.AssertTrue(hasAttribute(request, semconv.HTTPResponseStatusCode(http.StatusNotFound)), t)
+	utils.AssertEqual(codes.Unset, request.Status.Code, t)
+	utils.AssertEqual(request.SpanContext.SpanID(), ended[0].Parent.SpanID(), t)
+}
+
+func TestTracing_ServerErrorFailsSpan(t *testing.T) {
+	ended := serveTraced(http.StatusInternalServerError)
+
+	utils.AssertEqual(2, len(ended), t)
+	utils.AssertEqual(codes.Error, ended[1].Status.Code, t)
+}
+
+func hasAttribute(span tracetest.SpanStub, expected attribute.KeyValue) bool {
+	for _, actual := range span.Attributes {
+		if actual == expected {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,22 @@
+package openapi
+
+import (
+	"api"
+	"encoding/json"
+	"net/http"
+	"plugins/logger"
+)
+
+// spec is encoded once, because routes and models do not change while application is running,
+// it is sent as is, without status of default responses
+func InitRequestHandlers() {
+	output, _ := json.Marshal(Spec())
+	api.GetRouter().Handle("/openapi.json", api.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		w.Header().Set("content-type", "application/json")
+		// headers are already sent, so error can only be logged
+		if _, err := w.Write(output); err != nil {
+			logger.WithContext(r.Context()).ErrorF("Error while sending OpenAPI specification: %v", err)
+		}
+		return nil
+	})).Methods(http.MethodGet)
+}
@@ -0,0 +1,136 @@
+package openapi
+
+import (
+	"api"
+	"api/chats"
+	"api/meetings"
+	"api/messages"
+	"api/session"
+	"api/users"
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"models"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"utils"
+)
+
+var routeVarReg = regexp.MustCompile(`{([a-z_]+):[^}]*}`)
+
+// handlers are not called, so services are not needed to register routes
+func init() {
+	chats.InitRequestHandlers(nil, nil, nil, nil)
+	meetings.InitRequestHandlers(nil, nil, nil)
+	messages.InitRequestHandlers(nil, nil, nil, nil, nil, nil, nil, nil, nil, models.MessageRateLimits{}, 0)
+	session.InitRequestHandlers(nil, nil)
+	users.InitRequestHandlers(nil, nil)
+	InitRequestHandlers()
+}
+
+// returns registered routes as "method path" with path relative to base path and variables without patterns
+func registeredRoutes(t *testing.T) map[string]bool {
+	registered := map[string]bool{}
+	err := api.GetRouter().Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		// subrouters have no handlers
+		if route.GetHandler() == nil {
+			return nil
+		}
+
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		path := routeVarReg.ReplaceAllString(strings.TrimPrefix(template, api.BasePath), "{$1}")
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			t.Errorf("route %s has no methods", path)
+		}
+		for _, method := range methods {
+			registered[method+" "+path] = true
+		}
+		return nil
+	})
+
+	utils.AssertNil(err, t)
+	return registered
+}
+
+func TestSpec_DescribesAllRoutes(t *testing.T) {
+	spec := Spec()
+	for route := range registeredRoutes(t) {
+		parts := strings.SplitN(route, " ", 2)
+		if _, found := spec.Paths[parts[1]][strings.ToLower(parts[0])]; !found {
+			t.Errorf("route %s is missing from specification", route)
+		}
+	}
+}
+
+func TestSpec_AllRoutesAreRegistered(t *testing.T) {
+	registered := registeredRoutes(t)
+	for path, operations := range Spec().Paths {
+		for method := range operations {
+			route := strings.ToUpper(method) + " " + path
+			if !registered[route] {
+				t.Errorf("route %s of specification is not registered", route)
+			}
+		}
+	}
+}
+
+func TestSpec_DescribesHTTPModels(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "../../models/http.go", nil, 0)
+	utils.AssertNil(err, t)
+
+	schemas := Spec().Components.Schemas
+	ast.Inspect(file, func(node ast.Node) bool {
+		if typeSpec, isTypeSpec := node.(*ast.TypeSpec); isTypeSpec {
+			if schemas[typeSpec.Name.Name] == nil {
+				t.Errorf("model %s is missing from specification", typeSpec.Name.Name)
+			}
+		}
+		return true
+	})
+}
+
+func TestSpec_EmbeddedFieldsAreFlattened(t *testing.T) {
+	schema := Spec().Components.Schemas["ExtendedMeeting"]
+	if schema == nil {
+		t.Fatal("ExtendedMeeting is missing from specification")
+	}
+
+	for _, property := range []string{"Id", "Title", "Tags", "DateTime", "Latitude", "CurrentUserStatus"} {
+		if schema.Properties[property] == nil {
+			t.Errorf("property %s of ExtendedMeeting is missing", property)
+		}
+	}
+	utils.AssertEqual("date-time", schema.Properties["DateTime"].Format, t)
+}
+
+func TestSpec_Served(t *testing.T) {
+	w := httptest.NewRecorder()
+	api.GetRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, api.BasePath+"/openapi.json", nil))
+
+	var spec Document
+	err := json.NewDecoder(w.Body).Decode(&spec)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(http.StatusOK, w.Code, t)
+	utils.AssertEqual("3.0.3", spec.OpenAPI, t)
+	utils.AssertEqual(api.BasePath, spec.Servers[0].URL, t)
+	utils.AssertEqual(len(routes), countOperations(spec), t)
+}
+
+func countOperations(spec Document) int {
+	count := 0
+	for _, operations := range spec.Paths {
+		count += len(operations)
+	}
+	return count
+}
@@ -0,0 +1,290 @@
+package openapi
+
+import (
+	"api"
+	"models"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const (
+	jsonContentType = "application/json"
+	fileContentType = "application/octet-stream"
+)
+
+type (
+	Document struct {
+		OpenAPI    string                          `json:"openapi"`
+		Info       Info                            `json:"info"`
+		Servers    []Server                        `json:"servers"`
+		Paths      map[string]map[string]Operation `json:"paths"`
+		Components Components                      `json:"components"`
+	}
+
+	Info struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	}
+
+	Server struct {
+		URL string `json:"url"`
+	}
+
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	}
+
+	Operation struct {
+		Summary     string              `json:"summary"`
+		Parameters  []Parameter         `json:"parameters,omitempty"`
+		RequestBody *RequestBody        `json:"requestBody,omitempty"`
+		Responses   map[string]Response `json:"responses"`
+	}
+
+	Parameter struct {
+		Name     string  `json:"name"`
+		In       string  `json:"in"`
+		Required bool    `json:"required"`
+		Schema   *Schema `json:"schema"`
+	}
+
+	RequestBody struct {
+		Required bool                 `json:"required"`
+		Content  map[string]MediaType `json:"content"`
+	}
+
+	Response struct {
+		Description string               `json:"description"`
+		Content     map[string]MediaType `json:"content,omitempty"`
+	}
+
+	MediaType struct {
+		Schema *Schema `json:"schema"`
+	}
+)
+
+// request and response are samples of models, nil response means default success response
+type route struct {
+	method   string
+	path     string
+	summary  string
+	query    []string
+	request  interface{}
+	response interface{}
+	// response is a file instead of JSON
+	file bool
+	// request is a multipart form with file
+	upload bool
+}
+
+var routes = []route{
+	{method: http.MethodGet, path: "/openapi.json", summary: "OpenAPI specification of API"},
+
+	{method: http.MethodGet, path: "/session/", summary: "Returns session by token in cookie",
+		response: map[string]interface{}{}},
+	{method: http.MethodPost, path: "/session/register", summary: "Registers user",
+		request: models.UserCredentials{}},
+	{method: http.MethodPost, path: "/session/login", summary: "Logs user in",
+		request: models.UserCredentials{}},
+	{method: http.MethodPatch, path: "/session/user/password", summary: "Changes password of user",
+		request: models.ChangePasswordRequest{}},
+	{method: http.MethodPost, path: "/session/logout", summary: "Logs user out"},
+
+	{method: http.MethodGet, path: "/meetings", summary: "Returns public meetings",
+		response: []models.PublicMeeting{}},
+	{method: http.MethodGet, path: "/meetings/{id}", summary: "Returns meetings for registered user",
+		response: []models.ExtendedMeeting{}},
+	{method: http.MethodPost, path: "/meeting/", summary: "Creates meeting",
+		request: models.CreateMeetingRequest{}},
+	{method: http.MethodDelete, path: "/meeting/", summary: "Deletes meeting",
+		request: models.GeneralMeetingRequest{}},
+	{method: http.MethodPatch, path: "/meeting/settings", summary: "Updates settings of meeting",
+		request: models.UpdateMeetingSettingsRequest{}},
+	{method: http.MethodPost, path: "/meeting/request-participation", summary: "Requests participation in meeting",
+		request: models.ParticipationRequest{}, response: models.RejectInfo{}},
+	{method: http.MethodDelete, path: "/meeting/request-participation", summary: "Declines participation request",
+		request: models.MeetingUserRequest{}},
+	{method: http.MethodPost, path: "/meeting/user", summary: "Invites user to meeting",
+		request: models.MeetingUserRequest{}},
+	{method: http.MethodDelete, path: "/meeting/user", summary: "Kicks user out of meeting",
+		request: models.MeetingUserRequest{}},
+
+	{method: http.MethodGet, path: "/user/settings/{id}", summary: "Returns settings of user",
+		response: models.FullUserInfo{}},
+	{method: http.MethodPatch, path: "/user/settings", summary: "Changes settings of user",
+		request: models.UpdateUserSettingsRequest{}},
+	{method: http.MethodPatch, path: "/user/privacy", summary: "Changes privacy settings of user",
+		request: models.UpdatePrivacyRequest{}},
+	{method: http.MethodPost, path: "/user/block", summary: "Blocks user",
+		request: models.BlockUserRequest{}},
+	{method: http.MethodDelete, path: "/user/block", summary: "Unblocks user",
+		request: models.BlockUserRequest{}},
+
+	{method: http.MethodGet, path: "/chat/meeting/{id}", summary: "Returns chat of meeting",
+		response: models.Chat{}},
+	{method: http.MethodGet, path: "/chat/user/{id}", summary: "Returns chats of user sorted by recent activity",
+		response: []models.UserChat{}},
+	{method: http.MethodGet, path: "/chat/direct/{user_id}/{interlocutor_id}",
+		summary: "Returns direct chat between two users", response: models.Chat{}},
+	{method: http.MethodGet, path: "/chat/export/{chat_id}/{admin_id}", summary: "Exports full history of chat",
+		query: []string{"format"}, file: true},
+	{method: http.MethodPost, path: "/chat/meeting", summary: "Creates chat of meeting",
+		request: models.GeneralMeetingRequest{}},
+	{method: http.MethodPost, path: "/chat/meeting/request", summary: "Creates chat of participation request",
+		request: models.MeetingUserRequest{}},
+	{method: http.MethodDelete, path: "/chat/meeting", summary: "Closes chat of meeting",
+		request: models.CloseChatRequest{}},
+	{method: http.MethodDelete, path: "/chat/meeting/request", summary: "Closes chat of participation request",
+		request: models.CloseChatRequest{}},
+	{method: http.MethodPost, path: "/chat/reopen", summary: "Reopens archived chat",
+		request: models.ReopenChatRequest{}},
+
+	{method: http.MethodGet, path: "/ws", summary: "Upgrades connection to websocket for messages frames"},
+	{method: http.MethodGet, path: "/messages/{chat_id}/{count}", summary: "Returns last messages of chat",
+		query: []string{"user_id"}, response: []models.Message{}},
+	{method: http.MethodGet, path: "/messages/{chat_id}/{message_id}/{count}",
+		summary: "Returns messages of chat sent before message", query: []string{"user_id"},
+		response: []models.Message{}},
+	{method: http.MethodGet, path: "/messages/thread/{message_id}/{count}", summary: "Returns first replies of thread",
+		query: []string{"user_id"}, response: models.Thread{}},
+	{method: http.MethodGet, path: "/messages/thread/{message_id}/{after_id}/{count}",
+		summary: "Returns replies of thread sent after reply", query: []string{"user_id"}, response: models.Thread{}},
+	{method: http.MethodPost, path: "/messages/read", summary: "Marks messages of chat as read up to message",
+		request: models.ReadReceipt{}},
+	{method: http.MethodGet, path: "/messages/unread/{user_id}", summary: "Returns counts of unread messages",
+		response: models.UnreadCounts{}},
+	{method: http.MethodGet, path: "/messages/search/{user_id}/{count}", summary: "Searches messages in chats of user",
+		query: []string{"text", "chat_id", "before_id"}, response: []models.SearchHit{}},
+	{method: http.MethodGet, path: "/messages/online/{chat_id}", summary: "Returns ids of online chat members",
+		response: []uint{}},
+	{method: http.MethodPatch, path: "/messages/message", summary: "Edits message",
+		request: models.EditMessageRequest{}, response: models.Message{}},
+	{method: http.MethodDelete, path: "/messages/message", summary: "Deletes message",
+		request: models.DeleteMessageRequest{}, response: models.Message{}},
+	{method: http.MethodGet, path: "/messages/edits/{message_id}", summary: "Returns previous versions of message",
+		response: []models.MessageEdit{}},
+	{method: http.MethodPost, path: "/messages/pin", summary: "Pins message",
+		request: models.PinMessageRequest{}, response: models.Message{}},
+	{method: http.MethodDelete, path: "/messages/pin", summary: "Unpins message",
+		request: models.PinMessageRequest{}, response: models.Message{}},
+	{method: http.MethodGet, path: "/messages/pinned/{chat_id}", summary: "Returns pinned messages of chat",
+		response: []models.Message{}},
+	{method: http.MethodGet, path: "/messages/notifications/{user_id}/{count}",
+		summary: "Returns last notifications of user", response: []models.Notification{}},
+	{method: http.MethodPost, path: "/messages/reaction", summary: "Adds reaction to message",
+		request: models.MessageReaction{}, response: models.ReactionChange{}},
+	{method: http.MethodDelete, path: "/messages/reaction", summary: "Removes reaction from message",
+		request: models.MessageReaction{}, response: models.ReactionChange{}},
+	{method: http.MethodPost, path: "/messages/attachments", summary: "Uploads attachment",
+		upload: true, response: models.Attachment{}},
+	{method: http.MethodGet, path: "/messages/attachments/{attachment_id}",
+		summary: "Downloads attachment available for user of session", file: true},
+	{method: http.MethodGet, path: "/messages/attachments/{attachment_id}/thumbnail",
+		summary: "Downloads thumbnail of image attachment available for user of session", file: true},
+}
+
+// models which are not used by routes directly, but are part of API
+var extraModels = []interface{}{
+	models.DefaultResponse{},
+	models.SuccessResponse{},
+	models.ErrorResponse{},
+	models.MessagesRequest{},
+	models.MessagesAfterMessageRequest{},
+}
+
+var pathParamReg = regexp.MustCompile(`{([a-z_]+)}`)
+
+// user id field must be sent before file, because file is streamed to storage
+var uploadSchema = &Schema{
+	Type: "object",
+	Properties: map[string]*Schema{
+		"user_id": {Type: "integer", Format: "uint"},
+		"file":    {Type: "string", Format: "binary"},
+	},
+}
+
+func Spec() Document {
+	components := schemas{}
+	for _, model := range extraModels {
+		components.of(model)
+	}
+
+	paths := map[string]map[string]Operation{}
+	for _, route := range routes {
+		if paths[route.path] == nil {
+			paths[route.path] = map[string]Operation{}
+		}
+		paths[route.path][strings.ToLower(route.method)] = route.operation(components)
+	}
+
+	return Document{
+		OpenAPI: "3.0.3",
+		Info:    Info{Title: "Meetings API", Version: "1"},
+		Servers: []Server{{URL: api.BasePath}},
+		Paths:   paths,
+		Components: Components{
+			Schemas: components,
+		},
+	}
+}
+
+func (r route) operation(components schemas) Operation {
+	operation := Operation{
+		Summary: r.summary,
+		Responses: map[string]Response{
+			"200":     r.successResponse(components),
+			"default": jsonResponse("Error", components.of(models.ErrorResponse{})),
+		},
+	}
+
+	for _, match := range pathParamReg.FindAllStringSubmatch(r.path, -1) {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name: match[1], In: "path", Required: true, Schema: &Schema{Type: "string"},
+		})
+	}
+	for _, name := range r.query {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name: name, In: "query", Schema: &Schema{Type: "string"},
+		})
+	}
+
+	switch {
+	case r.upload:
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{"multipart/form-data": {Schema: uploadSchema}},
+		}
+	case r.request != nil:
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{jsonContentType: {Schema: components.of(r.request)}},
+		}
+	}
+
+	return operation
+}
+
+func (r route) successResponse(components schemas) Response {
+	switch {
+	case r.file:
+		return Response{
+			Description: "File",
+			Content:     map[string]MediaType{fileContentType: {Schema: &Schema{Type: "string", Format: "binary"}}},
+		}
+	case r.response == nil:
+		return jsonResponse("Default success response", components.of(models.DefaultResponse{}))
+	default:
+		return jsonResponse("Success response", &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"status": {Type: "string"},
+				"data":   components.of(r.response),
+			},
+		})
+	}
+}
+
+func jsonResponse(description string, schema *Schema) Response {
+	return Response{Description: description, Content: map[string]MediaType{jsonContentType: {Schema: schema}}}
+}
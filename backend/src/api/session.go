@@ -0,0 +1,17 @@
+package api
+
+import "context"
+
+type sessionUserIdKey struct{}
+
+// ContextWithSessionUserId is used by session middleware, so handlers can rely on user of session
+// instead of user id sent by client
+func ContextWithSessionUserId(ctx context.Context, userId uint) context.Context {
+	return context.WithValue(ctx, sessionUserIdKey{}, userId)
+}
+
+// returns 0 if request has not passed session middleware
+func SessionUserId(ctx context.Context) uint {
+	userId, _ := ctx.Value(sessionUserIdKey{}).(uint)
+	return userId
+}
@@ -25,73 +25,75 @@ func InitRequestHandlers(
 		privateSessionAPI.Use(middleware)
 	}
 
-	publicSessionAPI.HandleFunc("/", handler.getSession).Methods(http.MethodGet)
-	publicSessionAPI.HandleFunc("/register", handler.registerUser).Methods(http.MethodPost)
-	publicSessionAPI.HandleFunc("/login", handler.loginUser).Methods(http.MethodPost)
-	privateSessionAPI.HandleFunc("/user/password", handler.changeUserPassword).Methods(http.MethodPatch)
-	privateSessionAPI.HandleFunc("/logout", handler.logoutUser).Methods(http.MethodPost)
+	publicSessionAPI.Handle("/", api.HandlerFunc(handler.getSession)).Methods(http.MethodGet)
+	publicSessionAPI.Handle("/register", api.HandlerFunc(handler.registerUser)).Methods(http.MethodPost)
+	publicSessionAPI.Handle("/login", api.HandlerFunc(handler.loginUser)).Methods(http.MethodPost)
+	privateSessionAPI.Handle("/user/password", api.HandlerFunc(handler.changeUserPassword)).Methods(http.MethodPatch)
+	privateSessionAPI.Handle("/logout", api.HandlerFunc(handler.logoutUser)).Methods(http.MethodPost)
 }
 
-func (h Handler) getSession(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) getSession(w http.ResponseWriter, r *http.Request) error {
 	s, err := h.sessionService.GetSession(r)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.EncodeAndSendResponse(w, s)
+	return nil
 }
 
-func (h Handler) registerUser(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) registerUser(w http.ResponseWriter, r *http.Request) error {
 	var registration models.UserCredentials
-	api.DecodeRequestBody(r, &registration)
+	if err := api.DecodeRequestBody(r, &registration); err != nil {
+		return err
+	}
 
-	err := h.authService.RegisterUser(registration)
+	err := h.authService.RegisterUser(r.Context(), registration)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
 
-func (h Handler) loginUser(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) loginUser(w http.ResponseWriter, r *http.Request) error {
 	var credentials models.UserCredentials
-	api.DecodeRequestBody(r, &credentials)
+	if err := api.DecodeRequestBody(r, &credentials); err != nil {
+		return err
+	}
 
-	userSession, err := h.authService.Login(credentials)
+	userSession, err := h.authService.Login(r.Context(), credentials)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	err = h.sessionService.SetSession(r, userSession)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
 
-func (h Handler) changeUserPassword(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) changeUserPassword(w http.ResponseWriter, r *http.Request) error {
 	var changePasswordRequest models.ChangePasswordRequest
-	api.DecodeRequestBody(r, &changePasswordRequest)
+	if err := api.DecodeRequestBody(r, &changePasswordRequest); err != nil {
+		return err
+	}
 
-	err := h.authService.ChangePassword(changePasswordRequest.UserId, changePasswordRequest.Password)
+	err := h.authService.ChangePassword(r.Context(), changePasswordRequest.UserId, changePasswordRequest.Password)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
 
-func (h Handler) logoutUser(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
+func (h Handler) logoutUser(w http.ResponseWriter, r *http.Request) error {
 	h.sessionService.InvalidateSession(r)
 	api.SendDefaultResponse(w)
+	return nil
 }
@@ -43,7 +43,7 @@ func init() {
 
 	sessionService := services.Session(coderKey)
 	InitRequestHandlers(
-		services.Authentication(repositories.Credentials(db)),
+		services.Authentication(repositories.Credentials(db, repositoriesMock.QueryTimeout)),
 		sessionService,
 		middlewares.AuthSession{Service: sessionService}.HasValidSession,
 	)
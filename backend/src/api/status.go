@@ -0,0 +1,59 @@
+package api
+
+import (
+	"net/http"
+	"services/errors"
+)
+
+// errors that are not listed are internal
+var serviceErrorStatuses = map[error]int{
+	errors.InternalError: http.StatusInternalServerError,
+
+	errors.AttachmentTooLarge:   http.StatusBadRequest,
+	errors.AttachmentNotAllowed: http.StatusBadRequest,
+
+	errors.NoAuthCookie:        http.StatusUnauthorized,
+	errors.InvalidAuthCookie:   http.StatusUnauthorized,
+	errors.CredentialsNotFound: http.StatusUnauthorized,
+
+	errors.ChatArchived:         http.StatusForbidden,
+	errors.ChatReopenForbidden:  http.StatusForbidden,
+	errors.DirectMessagesDenied: http.StatusForbidden,
+	errors.MessageEditForbidden: http.StatusForbidden,
+	errors.ChatExportForbidden:  http.StatusForbidden,
+	errors.MessagePinForbidden:  http.StatusForbidden,
+	errors.AnnouncementDenied:   http.StatusForbidden,
+	errors.EditWindowExpired:    http.StatusForbidden,
+
+	errors.UserIdNotFound:       http.StatusNotFound,
+	errors.RequestNotFound:      http.StatusNotFound,
+	errors.MeetingIdNotFound:    http.StatusNotFound,
+	errors.ChatIdNotFound:       http.StatusNotFound,
+	errors.DirectChatNotFound:   http.StatusNotFound,
+	errors.ChatMessageNotFound:  http.StatusNotFound,
+	errors.MessageNotFound:      http.StatusNotFound,
+	errors.ReplyMessageNotFound: http.StatusNotFound,
+	errors.AttachmentNotFound:   http.StatusNotFound,
+
+	errors.EmailExists:          http.StatusConflict,
+	errors.UserAlreadyInMeeting: http.StatusConflict,
+	errors.UserNotInMeeting:     http.StatusConflict,
+	errors.RequestChatExists:    http.StatusConflict,
+}
+
+// validation errors are bad requests
+func (a ApplicationError) StatusCode() int {
+	if a.Status != 0 {
+		return a.Status
+	}
+
+	if _, isValidationError := a.OriginalError.(fieldsError); isValidationError {
+		return http.StatusBadRequest
+	}
+
+	if status, found := serviceErrorStatuses[a.OriginalError]; found {
+		return status
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"models"
+	"net/http"
+	"net/http/httptest"
+	serviceErrors "services/errors"
+	"services/proxies/validation"
+	"testing"
+	"utils"
+)
+
+func TestApplicationError_StatusCode(t *testing.T) {
+	_, validationError := validation.NewMessagesProxy(nil, validation.ContentChecker{}).Save(
+		context.Background(), models.Message{})
+
+	for _, testCase := range []struct {
+		err    error
+		status int
+	}{
+		{validationError, http.StatusBadRequest},
+		{serviceErrors.NoAuthCookie, http.StatusUnauthorized},
+		{serviceErrors.MessageEditForbidden, http.StatusForbidden},
+		{serviceErrors.MeetingIdNotFound, http.StatusNotFound},
+		{serviceErrors.EmailExists, http.StatusConflict},
+		{serviceErrors.UserAlreadyInMeeting, http.StatusConflict},
+		{serviceErrors.InternalError, http.StatusInternalServerError},
+		{errors.New("unknown-error"), http.StatusInternalServerError},
+	} {
+		utils.AssertEqual(testCase.status, ApplicationError{OriginalError: testCase.err}.StatusCode(), t)
+	}
+}
+
+func TestApplicationError_ExplicitStatusCode(t *testing.T) {
+	utils.AssertEqual(http.StatusBadRequest, CannotDecodeRequestBody.StatusCode(), t)
+	utils.AssertEqual(http.StatusBadRequest, ReadRequestBodyError.StatusCode(), t)
+}
+
+func TestSendError_StatusAndBody(t *testing.T) {
+	w := httptest.NewRecorder()
+	SendError(w, httptest.NewRequest(http.MethodGet, "/", nil), ApplicationError{
+		OriginalError: serviceErrors.ChatIdNotFound,
+	})
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(w.Body).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(http.StatusNotFound, w.Code, t)
+	utils.AssertEqual("application/json", w.Header().Get("content-type"), t)
+	utils.AssertEqual(StatusError, response.Status, t)
+	utils.AssertEqual(serviceErrors.ChatIdNotFound.Error(), response.ErrorDetail, t)
+}
@@ -10,47 +10,97 @@ import (
 )
 
 type Handler struct {
-	usersService interfaces.UsersSettings
+	usersService   interfaces.UsersSettings
+	privacyService interfaces.UsersPrivacy
 }
 
 func InitRequestHandlers(
 	usersService interfaces.UsersSettings,
+	privacyService interfaces.UsersPrivacy,
 	middlewares ...mux.MiddlewareFunc,
 ) {
-	handler := Handler{usersService}
+	handler := Handler{usersService, privacyService}
 	usersAPI := api.GetRouter().PathPrefix("/user").Subrouter()
 	for _, middleware := range middlewares {
 		usersAPI.Use(middleware)
 	}
 
-	usersAPI.HandleFunc("/settings/{id:[0-9]+}", handler.getUserSettings).Methods(http.MethodGet)
-	usersAPI.HandleFunc("/settings", handler.updateUserSettings).Methods(http.MethodPatch)
+	usersAPI.Handle("/settings/{id:[0-9]+}", api.HandlerFunc(handler.getUserSettings)).Methods(http.MethodGet)
+	usersAPI.Handle("/settings", api.HandlerFunc(handler.updateUserSettings)).Methods(http.MethodPatch)
+	usersAPI.Handle("/privacy", api.HandlerFunc(handler.updatePrivacy)).Methods(http.MethodPatch)
+	usersAPI.Handle("/block", api.HandlerFunc(handler.blockUser)).Methods(http.MethodPost)
+	usersAPI.Handle("/block", api.HandlerFunc(handler.unblockUser)).Methods(http.MethodDelete)
 }
 
-func (h Handler) getUserSettings(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) getUserSettings(w http.ResponseWriter, r *http.Request) error {
 	vars := mux.Vars(r)
 	// checking of this parameter will be performed in validation proxy
 	userId, _ := strconv.Atoi(vars["id"])
-	info, err := h.usersService.GetUserSettings(uint(userId))
+	info, err := h.usersService.GetUserSettings(r.Context(), uint(userId))
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.EncodeAndSendResponse(w, info)
+	return nil
 }
 
-func (h Handler) updateUserSettings(w http.ResponseWriter, r *http.Request) {
-	defer api.SendErrorIfPanicked(w)
-
+func (h Handler) updateUserSettings(w http.ResponseWriter, r *http.Request) error {
 	var updateSettingsRequest models.UpdateUserSettingsRequest
-	api.DecodeRequestBody(r, &updateSettingsRequest)
+	if err := api.DecodeRequestBody(r, &updateSettingsRequest); err != nil {
+		return err
+	}
+
+	err := h.usersService.UpdateUserSettings(r.Context(), updateSettingsRequest.UserId, updateSettingsRequest.Settings)
+	if err != nil {
+		return err
+	}
+
+	api.SendDefaultResponse(w)
+	return nil
+}
+
+func (h Handler) updatePrivacy(w http.ResponseWriter, r *http.Request) error {
+	var request models.UpdatePrivacyRequest
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
+
+	err := h.privacyService.SetDirectMessagesAllowed(r.Context(), request.UserId, request.DirectMessagesAllowed)
+	if err != nil {
+		return err
+	}
+
+	api.SendDefaultResponse(w)
+	return nil
+}
+
+func (h Handler) blockUser(w http.ResponseWriter, r *http.Request) error {
+	var request models.BlockUserRequest
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
+
+	err := h.privacyService.BlockUser(r.Context(), request.UserId, request.BlockedUserId)
+	if err != nil {
+		return err
+	}
+
+	api.SendDefaultResponse(w)
+	return nil
+}
+
+func (h Handler) unblockUser(w http.ResponseWriter, r *http.Request) error {
+	var request models.BlockUserRequest
+	if err := api.DecodeRequestBody(r, &request); err != nil {
+		return err
+	}
 
-	err := h.usersService.UpdateUserSettings(updateSettingsRequest.UserId, updateSettingsRequest.Settings)
+	err := h.privacyService.UnblockUser(r.Context(), request.UserId, request.BlockedUserId)
 	if err != nil {
-		panic(api.ApplicationError{OriginalError: err})
+		return err
 	}
 
 	api.SendDefaultResponse(w)
+	return nil
 }
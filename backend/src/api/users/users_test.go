@@ -47,7 +47,9 @@ func init() {
 
 	sessionService = services.Session(coderKey)
 	InitRequestHandlers(
-		services.UserSettings(repositories.UserSettings(db)),
+		services.UserSettings(
+			repositories.UserSettings(db, repositoriesMock.QueryTimeout), validation.ContentChecker{}),
+		services.UsersPrivacy(repositories.UsersPrivacy(db, repositoriesMock.QueryTimeout)),
 		middlewares.AuthSession{Service: sessionService}.HasValidSession,
 	)
 }
@@ -184,6 +186,38 @@ func TestUserSettingsPatch_InvalidAllSettingsUserIdError(t *testing.T) {
 	}
 }
 
+func TestUserSettingsPatch_InvalidSettingsFieldErrors(t *testing.T) {
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(usersAPIMock.InvalidAllSettingsUserSettingsRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	fields := map[string]string{}
+	for _, fieldError := range response.Errors {
+		fields[fieldError.Field] = fieldError.Code
+	}
+	utils.AssertEqual(validation.InvalidUserName, fields["name"], t)
+	utils.AssertEqual(validation.InvalidUserNickname, fields["nickname"], t)
+	utils.AssertEqual(validation.InvalidUserAge, fields["age"], t)
+	utils.AssertEqual(validation.InvalidUserAvatarURL, fields["avatar_url"], t)
+}
+
+func TestUserSettingsPatch_LocalizedFieldErrors(t *testing.T) {
+	request := usersAPIMock.InvalidAllSettingsUserSettingsRequest(router)
+	request.Language = "ru-RU,ru;q=0.9,en;q=0.8"
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(utils.MakeRequest(request)).Decode(&response)
+
+	utils.AssertNil(err, t)
+	for _, fieldError := range response.Errors {
+		if fieldError.Field == "age" {
+			utils.AssertEqual("Возраст должен быть положительным числом", fieldError.Message, t)
+		}
+	}
+	utils.AssertTrue(strings.Contains(response.Message, "Возраст должен быть положительным числом"), t)
+}
+
 func TestUserSettingsPatch_InternalError(t *testing.T) {
 	repositoriesMock.DropTables(db)
 
@@ -195,3 +229,75 @@ func TestUserSettingsPatch_InternalError(t *testing.T) {
 	utils.AssertEqual(api.StatusError, response.Status, t)
 	utils.AssertEqual(errors.InternalError.Error(), response.ErrorDetail, t)
 }
+
+func TestUserPrivacyPatch_Success(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var response models.DefaultResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(usersAPIMock.PatchFirstUserPrivacyRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+}
+
+func TestUserPrivacyPatch_UserIdNotFoundError(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(usersAPIMock.PatchNotExistsUserPrivacyRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(errors.UserIdNotFound.Error(), response.ErrorDetail, t)
+}
+
+func TestBlockUser_Success(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var response models.DefaultResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(usersAPIMock.BlockUserRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+}
+
+func TestBlockUser_UserIdNotFoundError(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(usersAPIMock.BlockNotExistsUserRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(errors.UserIdNotFound.Error(), response.ErrorDetail, t)
+}
+
+func TestBlockUser_InvalidIdError(t *testing.T) {
+	var response models.ErrorResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(usersAPIMock.BlockUserInvalidIdRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusError, response.Status, t)
+	utils.AssertEqual(validation.InvalidId, response.ErrorDetail, t)
+}
+
+func TestUnblockUser_Success(t *testing.T) {
+	repositoriesMock.InitTables(db)
+	defer repositoriesMock.DropTables(db)
+
+	var response models.DefaultResponse
+	err := json.NewDecoder(
+		utils.MakeRequest(usersAPIMock.UnblockUserRequest(router))).Decode(&response)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(api.StatusOk, response.Status, t)
+}
@@ -1,36 +1,112 @@
 package interfaces
 
 import (
+	"context"
+	"io"
 	"models"
+	"time"
 )
 
 type (
 	MeetingsAccessorRepository interface {
-		GetFullMeetingInfo(meetingId uint) (models.PrivateMeeting, error)
-		GetPublicMeetings() ([]models.PublicMeeting, error)
-		GetExtendedMeetings(userStatusesData models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error)
+		GetFullMeetingInfo(ctx context.Context, meetingId uint) (models.PrivateMeeting, error)
+		GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error)
+		GetExtendedMeetings(
+			ctx context.Context, userStatusesData models.UserMeetingStatusesData,
+		) ([]models.ExtendedMeeting, error)
 	}
 
 	MeetingsSettingsRepository interface {
-		GetMeetingSettings(meetingId uint) (models.ParticipationMeetingSettings, error)
-		GetNearMeetings(data models.UserTimeCheckData) ([]models.TimeMeetingParameters, error)
+		GetMeetingSettings(ctx context.Context, meetingId uint) (models.ParticipationMeetingSettings, error)
+		GetNearMeetings(ctx context.Context, data models.UserTimeCheckData) ([]models.TimeMeetingParameters, error)
 	}
 
 	CredentialsRepository interface {
-		CreateUser(user models.UserCredentials) error
-		GetUserIdByCredentials(user models.UserCredentials) (uint, error)
-		UpdateUserPassword(user models.UserCredentials) error
-		GetUserEmail(userId uint) (string, error)
+		CreateUser(ctx context.Context, user models.UserCredentials) error
+		GetUserIdByCredentials(ctx context.Context, user models.UserCredentials) (uint, error)
+		UpdateUserPassword(ctx context.Context, user models.UserCredentials) error
+		GetUserEmail(ctx context.Context, userId uint) (string, error)
+	}
+
+	ChatAdminRepository interface {
+		// returns 0 if chat does not belong to meeting
+		GetChatMeetingAdminId(ctx context.Context, chatId uint) (uint, error)
 	}
 
 	ChatRepository interface {
-		CreateChat(meetingId uint, chatType string) error
-		SetChatStatus(chatId uint, status string) error
+		ChatAdminRepository
+		CreateChat(ctx context.Context, meetingId uint, chatType string) error
+		SetChatStatus(ctx context.Context, chatId uint, status string) error
+	}
+
+	ChatTranscriptRepository interface {
+		// handler is called for each message in sending order, its error stops export
+		ExportMessages(ctx context.Context, chatId uint, handle func(message models.TranscriptMessage) error) error
+	}
+
+	// meeting request chat is private between applicant and meeting admin while request is not decided
+	MeetingRequestChatRepository interface {
+		CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error
+		CloseMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error
+	}
+
+	DirectChatRepository interface {
+		GetDirectChat(ctx context.Context, firstUserId, secondUserId uint) (models.Chat, error)
+		CreateDirectChat(ctx context.Context, firstUserId, secondUserId uint) (models.Chat, error)
 	}
 
 	FullChatsRepository interface {
 		ChatAccessor
 		ChatRepository
+		MeetingRequestChatRepository
+		DirectChatRepository
+		ChatTranscriptRepository
+	}
+
+	UsersPrivacyRepository interface {
+		UsersPrivacy
+		DirectMessagesAllowed(ctx context.Context, senderId, recipientId uint) (bool, error)
+	}
+
+	MessagesEditorRepository interface {
+		GetManagedMessage(ctx context.Context, messageId uint) (models.ManagedMessage, error)
+		EditMessage(ctx context.Context, messageId uint, text string) (models.Message, error)
+		DeleteMessage(ctx context.Context, messageId uint) (models.Message, error)
+		GetMessageEdits(ctx context.Context, messageId uint) ([]models.MessageEdit, error)
+		// deleted message can not be pinned
+		SetMessagePinned(ctx context.Context, messageId uint, pinned bool) (models.Message, error)
+		GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error)
+	}
+
+	FullMessagesRepository interface {
+		Messages
+		MessagesEditorRepository
+		Reactions
+		MessagesSearch
+		Notifications
+	}
+
+	AttachmentsRepository interface {
+		SaveAttachment(ctx context.Context, attachment models.Attachment) (models.Attachment, error)
+		GetAttachment(ctx context.Context, attachmentId uint) (models.Attachment, error)
+		IsChatMember(ctx context.Context, chatId, userId uint) (bool, error)
+		GetOrphanAttachments(ctx context.Context, olderThan time.Duration) ([]models.Attachment, error)
+		DeleteAttachments(ctx context.Context, attachmentIds []uint) error
+	}
+
+	BlobStore interface {
+		Save(ctx context.Context, blobId string, content io.Reader) error
+		Open(ctx context.Context, blobId string) (io.ReadCloser, error)
+		Delete(ctx context.Context, blobId string) error
+	}
+
+	ReadReceiptsRepository interface {
+		MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error
+		GetUnreadCounts(ctx context.Context, userId uint) ([]models.ChatUnreadCount, error)
+	}
+
+	ModerationRepository interface {
+		FlagContent(ctx context.Context, flag models.ContentFlag) error
 	}
 
 	FullMeetingsRepository interface {
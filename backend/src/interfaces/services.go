@@ -1,15 +1,18 @@
 package interfaces
 
 import (
+	"context"
+	"io"
 	"models"
 	"net/http"
+	"time"
 )
 
 type (
 	AuthenticationService interface {
-		RegisterUser(credentials models.UserCredentials) error
-		Login(credentials models.UserCredentials) (models.UserSession, error)
-		ChangePassword(userId uint, password string) error
+		RegisterUser(ctx context.Context, credentials models.UserCredentials) error
+		Login(ctx context.Context, credentials models.UserCredentials) (models.UserSession, error)
+		ChangePassword(ctx context.Context, userId uint, password string) error
 	}
 
 	SessionAccessorService interface {
@@ -23,42 +26,105 @@ type (
 	}
 
 	MeetingsAccessorService interface {
-		GetFullMeetingInfo(meetingId uint) (models.PrivateMeeting, error)
-		GetPublicMeetings() ([]models.PublicMeeting, error)
-		GetExtendedMeetings(userId uint) ([]models.ExtendedMeeting, error)
+		GetFullMeetingInfo(ctx context.Context, meetingId uint) (models.PrivateMeeting, error)
+		GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error)
+		GetExtendedMeetings(ctx context.Context, userId uint) ([]models.ExtendedMeeting, error)
 	}
 
 	Meetings interface {
-		CreateMeeting(adminId uint, settings models.AllSettings) error
-		DeleteMeeting(meetingId uint) error
-		UpdateSettings(meetingId uint, settings models.AllSettings) error
-		AddUserToMeeting(meetingId, userId uint) error
-		KickUserFromMeeting(meetingId, userId uint) error
+		CreateMeeting(ctx context.Context, adminId uint, settings models.AllSettings) error
+		DeleteMeeting(ctx context.Context, meetingId uint) error
+		UpdateSettings(ctx context.Context, meetingId uint, settings models.AllSettings) error
+		AddUserToMeeting(ctx context.Context, meetingId, userId uint) error
+		KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error
 	}
 
 	ParticipationService interface {
-		HandleParticipationRequest(request models.ParticipationRequest) (models.RejectInfo, error)
+		// submitted request opens meeting request chat between applicant and meeting admin
+		HandleParticipationRequest(ctx context.Context, request models.ParticipationRequest) (models.RejectInfo, error)
+		DeclineParticipationRequest(ctx context.Context, meetingId, userId uint) error
 	}
 
 	UsersSettings interface {
-		GetUserSettings(userId uint) (models.FullUserInfo, error)
-		UpdateUserSettings(userId uint, info models.UserSettings) error
+		GetUserSettings(ctx context.Context, userId uint) (models.FullUserInfo, error)
+		UpdateUserSettings(ctx context.Context, userId uint, info models.UserSettings) error
+	}
+
+	UsersPrivacy interface {
+		BlockUser(ctx context.Context, userId, blockedUserId uint) error
+		UnblockUser(ctx context.Context, userId, blockedUserId uint) error
+		SetDirectMessagesAllowed(ctx context.Context, userId uint, allowed bool) error
 	}
 
 	ChatAccessor interface {
-		GetMeetingChat(meetingId uint) (models.Chat, error)
-		GetUserChats(userId uint) ([]models.Chat, error)
+		GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error)
+		GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error)
+		// returns those of users that are members of chat, in ascending order
+		GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error)
 	}
 
 	Chat interface {
-		CreateMeetingChat(meetingId uint) error
-		CreateMeetingRequestChat(meetingId uint) error
-		CloseChat(chatId uint) error
+		CreateMeetingChat(ctx context.Context, meetingId uint) error
+		CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error
+		CloseChat(ctx context.Context, chatId uint) error
+		// only meeting admin can reopen archived chat
+		ReopenChat(ctx context.Context, chatId, adminId uint) error
+	}
+
+	// transcript is written to writer while messages are read, so it is never fully loaded into memory
+	ChatTranscript interface {
+		ExportChat(ctx context.Context, request models.ChatExportRequest, w io.Writer) error
+	}
+
+	DirectChat interface {
+		GetDirectChat(ctx context.Context, userId, interlocutorId uint) (models.Chat, error)
+		GetOrCreateDirectChat(ctx context.Context, senderId, recipientId uint) (models.Chat, error)
 	}
 
 	Messages interface {
-		Save(message models.Message) error
-		GetLastMessages(chatId, count uint) ([]models.Message, error)
-		GetLastMessagesAfter(chatId, messageId, count uint) ([]models.Message, error)
+		Save(ctx context.Context, message models.Message) (models.Message, error)
+		// viewer id is used to mark reactions of viewer, it can be 0
+		GetLastMessages(ctx context.Context, chatId, count, viewerId uint) ([]models.Message, error)
+		GetLastMessagesAfter(ctx context.Context, chatId, messageId, count, viewerId uint) ([]models.Message, error)
+		// replies are returned in sending order starting after message with after id (0 for first page)
+		GetThread(ctx context.Context, rootId, afterId, count, viewerId uint) (models.Thread, error)
+	}
+
+	// search results are ordered from the newest message
+	MessagesSearch interface {
+		SearchMessages(ctx context.Context, query models.SearchQuery) ([]models.SearchHit, error)
+	}
+
+	// notifications are generated for chat members by announcements, the latest notification is the first
+	Notifications interface {
+		GetNotifications(ctx context.Context, userId, count uint) ([]models.Notification, error)
+	}
+
+	Attachments interface {
+		Upload(ctx context.Context, upload models.AttachmentUpload) (models.Attachment, error)
+		// attachment is available for its uploader and for members of chat where it is sent
+		Download(ctx context.Context, attachmentId, userId uint, thumbnail bool) (models.AttachmentContent, error)
+		// deletes attachments that were not sent or belong to deleted messages
+		DeleteOrphans(ctx context.Context, olderThan time.Duration) (uint, error)
+	}
+
+	Reactions interface {
+		AddReaction(ctx context.Context, reaction models.MessageReaction) (models.ReactionChange, error)
+		RemoveReaction(ctx context.Context, reaction models.MessageReaction) (models.ReactionChange, error)
+	}
+
+	MessagesEditor interface {
+		EditMessage(ctx context.Context, request models.EditMessageRequest) (models.Message, error)
+		DeleteMessage(ctx context.Context, request models.DeleteMessageRequest) (models.Message, error)
+		GetMessageEdits(ctx context.Context, messageId uint) ([]models.MessageEdit, error)
+		PinMessage(ctx context.Context, request models.PinMessageRequest) (models.Message, error)
+		UnpinMessage(ctx context.Context, request models.PinMessageRequest) (models.Message, error)
+		// the last pinned message is the first
+		GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error)
+	}
+
+	ReadReceipts interface {
+		MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error
+		GetUnreadCounts(ctx context.Context, userId uint) (models.UnreadCounts, error)
 	}
 )
@@ -12,5 +12,17 @@ var (
 	UserNotInMeeting                   = errors.New("user not in meeting")
 	UnableToFindChatByMeetingId        = errors.New("unable to find chat by meeting id")
 	UnableToFindChatById               = errors.New("unable to find chat by id")
+	ChatIsArchived                     = errors.New("chat is archived")
+	AnnouncementNotAllowed             = errors.New("announcement can be sent only by meeting admin")
 	MeetingChatAlreadyExists           = errors.New("meeting chat already exists")
+	MeetingRequestChatAlreadyExists    = errors.New("meeting request chat already exists")
+	UnableToFindMeetingRequestChat     = errors.New("unable to find meeting request chat")
+	UnableToFindDirectChat             = errors.New("unable to find direct chat by users ids")
+	DirectChatAlreadyExists            = errors.New("direct chat already exists")
+	DirectMessagesNotAllowed           = errors.New("recipient does not accept direct messages of sender")
+	UnableToFindChatMessage            = errors.New("unable to find message in user chat")
+	UnableToFindMessageById            = errors.New("unable to find message by id")
+	UnableToFindReplyMessage           = errors.New("unable to find replied message in chat")
+	UnableToFindAttachment             = errors.New("unable to find attachment")
+	UnableToFindBlob                   = errors.New("unable to find blob")
 )
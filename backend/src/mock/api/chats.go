@@ -16,8 +16,8 @@ type (
 	}
 
 	UserChatsResponse struct {
-		Status string        `json:"status"`
-		Data   []models.Chat `json:"data"`
+		Status string            `json:"status"`
+		Data   []models.UserChat `json:"data"`
 	}
 )
 
@@ -33,7 +33,7 @@ func GetMeetingChatRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "chat/meeting/1",
+		Endpoint: "api/v1/chat/meeting/1",
 		Cookie:   cookie,
 	}
 }
@@ -42,7 +42,7 @@ func GetMeetingChatRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "chat/meeting/1",
+		Endpoint: "api/v1/chat/meeting/1",
 		Cookie:   emptyCookie,
 	}
 }
@@ -51,7 +51,7 @@ func GetMeetingChatByMeetingIfWithoutChatRequest(r *mux.Router) utils.RequestDat
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: fmt.Sprintf("chat/meeting/%d", repositories.MeetingIdWithoutMeetingChat),
+		Endpoint: fmt.Sprintf("api/v1/chat/meeting/%d", repositories.MeetingIdWithoutMeetingChat),
 		Cookie:   cookie,
 	}
 }
@@ -60,7 +60,7 @@ func GetMeetingChatInvalidIdRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "chat/meeting/0",
+		Endpoint: "api/v1/chat/meeting/0",
 		Cookie:   cookie,
 	}
 }
@@ -69,7 +69,7 @@ func GetUserChatsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "chat/user/1",
+		Endpoint: "api/v1/chat/user/1",
 		Cookie:   cookie,
 	}
 }
@@ -78,7 +78,7 @@ func GetUserChatsWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "chat/user/1",
+		Endpoint: "api/v1/chat/user/1",
 		Cookie:   emptyCookie,
 	}
 }
@@ -87,7 +87,7 @@ func GetUserChatsInvalidIdRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "chat/user/0",
+		Endpoint: "api/v1/chat/user/0",
 		Cookie:   cookie,
 	}
 }
@@ -96,7 +96,7 @@ func CreateMeetingChatRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "chat/meeting",
+		Endpoint: "api/v1/chat/meeting",
 		Cookie:   cookie,
 		Data:     fmt.Sprintf(`{"meeting_id": %d}`, repositories.MeetingIdWithoutMeetingChat),
 	}
@@ -106,7 +106,7 @@ func CreateMeetingChatRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "chat/meeting",
+		Endpoint: "api/v1/chat/meeting",
 		Cookie:   emptyCookie,
 		Data:     fmt.Sprintf(`{"meeting_id": %d}`, repositories.MeetingIdWithoutMeetingChat),
 	}
@@ -116,7 +116,7 @@ func CreateMeetingChatInvalidIdRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "chat/meeting",
+		Endpoint: "api/v1/chat/meeting",
 		Cookie:   cookie,
 		Data:     `{"meeting_id": 0}`,
 	}
@@ -126,9 +126,19 @@ func CreateMeetingRequestChatRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "chat/meeting/request",
+		Endpoint: "api/v1/chat/meeting/request",
 		Cookie:   cookie,
-		Data:     `{"meeting_id": 1}`,
+		Data:     fmt.Sprintf(`{"meeting_id": 1, "user_id": %d}`, repositories.UserIdWithoutFirstRequestChat),
+	}
+}
+
+func CreateExistingMeetingRequestChatRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPost,
+		Endpoint: "api/v1/chat/meeting/request",
+		Cookie:   cookie,
+		Data:     fmt.Sprintf(`{"meeting_id": 1, "user_id": %d}`, repositories.FirstMeetingApplicantId),
 	}
 }
 
@@ -136,9 +146,9 @@ func CreateMeetingRequestChatRequestWithoutSession(r *mux.Router) utils.RequestD
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "chat/meeting/request",
+		Endpoint: "api/v1/chat/meeting/request",
 		Cookie:   emptyCookie,
-		Data:     `{"meeting_id": 1}`,
+		Data:     fmt.Sprintf(`{"meeting_id": 1, "user_id": %d}`, repositories.UserIdWithoutFirstRequestChat),
 	}
 }
 
@@ -146,9 +156,9 @@ func CreateMeetingRequestChatInvalidIdRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "chat/meeting/request",
+		Endpoint: "api/v1/chat/meeting/request",
 		Cookie:   cookie,
-		Data:     `{"meeting_id": 0}`,
+		Data:     `{"meeting_id": 0, "user_id": 1}`,
 	}
 }
 
@@ -156,7 +166,7 @@ func CloseMeetingChatRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "chat/meeting",
+		Endpoint: "api/v1/chat/meeting",
 		Cookie:   cookie,
 		Data:     `{"chat_id": 1}`,
 	}
@@ -166,7 +176,7 @@ func CloseMeetingChatRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "chat/meeting",
+		Endpoint: "api/v1/chat/meeting",
 		Cookie:   emptyCookie,
 		Data:     `{"chat_id": 1}`,
 	}
@@ -176,7 +186,7 @@ func CloseMeetingChatIdNotFoundRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "chat/meeting",
+		Endpoint: "api/v1/chat/meeting",
 		Cookie:   cookie,
 		Data:     fmt.Sprintf(`{"chat_id": %d}`, repositories.NotExistsChatId),
 	}
@@ -186,7 +196,7 @@ func CloseMeetingChatInvalidIdRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "chat/meeting",
+		Endpoint: "api/v1/chat/meeting",
 		Cookie:   cookie,
 		Data:     `{"chat_id": 0}`,
 	}
@@ -196,7 +206,7 @@ func CloseMeetingRequestChatRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "chat/meeting/request",
+		Endpoint: "api/v1/chat/meeting/request",
 		Cookie:   cookie,
 		Data:     `{"chat_id": 1}`,
 	}
@@ -206,7 +216,7 @@ func CloseMeetingRequestChatRequestWithoutSession(r *mux.Router) utils.RequestDa
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "chat/meeting/request",
+		Endpoint: "api/v1/chat/meeting/request",
 		Cookie:   emptyCookie,
 		Data:     `{"chat_id": 1}`,
 	}
@@ -216,7 +226,7 @@ func CloseMeetingRequestChatIdNotFoundRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "chat/meeting/request",
+		Endpoint: "api/v1/chat/meeting/request",
 		Cookie:   cookie,
 		Data:     fmt.Sprintf(`{"chat_id": %d}`, repositories.NotExistsChatId),
 	}
@@ -226,8 +236,55 @@ func CloseMeetingRequestChatInvalidIdRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "chat/meeting/request",
+		Endpoint: "api/v1/chat/meeting/request",
 		Cookie:   cookie,
 		Data:     `{"chat_id": 0}`,
 	}
 }
+
+func GetDirectChatRequest(r *mux.Router) utils.RequestData {
+	firstUserId, secondUserId := repositories.GetFirstDirectChatUsers()
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("api/v1/chat/direct/%d/%d", firstUserId, secondUserId),
+		Cookie:   cookie,
+	}
+}
+
+func GetNotExistsDirectChatRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("api/v1/chat/direct/1/%d", repositories.UserIdWithoutDirectChats),
+		Cookie:   cookie,
+	}
+}
+
+func GetDirectChatInvalidIdRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: "api/v1/chat/direct/1/1",
+		Cookie:   cookie,
+	}
+}
+
+func ExportChatRequest(r *mux.Router, request models.ChatExportRequest) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("api/v1/chat/export/%d/%d?format=%s", request.ChatId, request.AdminId, request.Format),
+		Cookie:   cookie,
+	}
+}
+
+func ReopenChatRequest(r *mux.Router, chatId, adminId uint) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPost,
+		Endpoint: "api/v1/chat/reopen",
+		Cookie:   cookie,
+		Data:     fmt.Sprintf(`{"chat_id": %d, "admin_id": %d}`, chatId, adminId),
+	}
+}
@@ -45,7 +45,7 @@ func GetPublicMeetingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "meetings",
+		Endpoint: "api/v1/meetings",
 		Cookie:   emptyCookie,
 	}
 }
@@ -54,7 +54,7 @@ func GetExtendedMeetingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "meetings/1",
+		Endpoint: "api/v1/meetings/1",
 		Cookie:   cookie,
 	}
 }
@@ -63,7 +63,7 @@ func GetExtendedMeetingsRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "meetings/1",
+		Endpoint: "api/v1/meetings/1",
 		Cookie:   emptyCookie,
 	}
 }
@@ -72,7 +72,7 @@ func CreateMeetingRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/",
+		Endpoint: "api/v1/meeting/",
 		Cookie:   cookie,
 		Data:     getNewMeetingSettings(1),
 	}
@@ -82,7 +82,7 @@ func CreateMeetingRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/",
+		Endpoint: "api/v1/meeting/",
 		Cookie:   emptyCookie,
 		Data:     getNewMeetingSettings(1),
 	}
@@ -99,7 +99,7 @@ func CreateMeetingInvalidAdminIdRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/",
+		Endpoint: "api/v1/meeting/",
 		Cookie:   cookie,
 		Data:     getNewMeetingSettings(0),
 	}
@@ -109,7 +109,7 @@ func CreateMeetingNotExistsAdminIdRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/",
+		Endpoint: "api/v1/meeting/",
 		Cookie:   cookie,
 		Data:     getNewMeetingSettings(uint(len(repositories.UsersCredentials) + 1)),
 	}
@@ -119,7 +119,7 @@ func CreateMeetingWithInvalidSettingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/",
+		Endpoint: "api/v1/meeting/",
 		Cookie:   cookie,
 		Data:     `{"admin_id": 1, "settings": {"latitude": 91, "longitude": -181}}`,
 	}
@@ -129,7 +129,7 @@ func DeleteMeetingRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "meeting/",
+		Endpoint: "api/v1/meeting/",
 		Cookie:   cookie,
 		Data:     `{"meeting_id": 1}`,
 	}
@@ -139,7 +139,7 @@ func DeleteMeetingRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "meeting/",
+		Endpoint: "api/v1/meeting/",
 		Cookie:   emptyCookie,
 		Data:     `{"meeting_id": 1}`,
 	}
@@ -149,7 +149,7 @@ func DeleteMeetingIdNotFoundRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "meeting/",
+		Endpoint: "api/v1/meeting/",
 		Cookie:   cookie,
 		Data:     fmt.Sprintf(`{"meeting_id": %d}`, len(repositories.Meetings)+1),
 	}
@@ -159,7 +159,7 @@ func DeleteMeetingByInvalidIdRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "meeting/",
+		Endpoint: "api/v1/meeting/",
 		Cookie:   cookie,
 		Data:     `{"meeting_id": 0}`,
 	}
@@ -169,7 +169,7 @@ func UpdateMeetingSettingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "meeting/settings",
+		Endpoint: "api/v1/meeting/settings",
 		Cookie:   cookie,
 		Data:     getUpdateMeetingSettingsRequestData(1),
 	}
@@ -179,7 +179,7 @@ func UpdateMeetingSettingsRequestWithoutSession(r *mux.Router) utils.RequestData
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "meeting/settings",
+		Endpoint: "api/v1/meeting/settings",
 		Cookie:   emptyCookie,
 		Data:     getUpdateMeetingSettingsRequestData(1),
 	}
@@ -193,7 +193,7 @@ func UpdateMeetingSettingsMeetingIdNotFoundRequest(r *mux.Router) utils.RequestD
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "meeting/settings",
+		Endpoint: "api/v1/meeting/settings",
 		Cookie:   cookie,
 		Data:     getUpdateMeetingSettingsRequestData(uint(len(repositories.Meetings) + 1)),
 	}
@@ -203,7 +203,7 @@ func UpdateMeetingSettingsByInvalidMeetingIdRequest(r *mux.Router) utils.Request
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "meeting/settings",
+		Endpoint: "api/v1/meeting/settings",
 		Cookie:   cookie,
 		Data:     getUpdateMeetingSettingsRequestData(0),
 	}
@@ -213,7 +213,7 @@ func ParticipationRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/request-participation",
+		Endpoint: "api/v1/meeting/request-participation",
 		Cookie:   cookie,
 		Data:     getMeetingUserRequestData(2, 1),
 	}
@@ -223,7 +223,7 @@ func ParticipationRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/request-participation",
+		Endpoint: "api/v1/meeting/request-participation",
 		Cookie:   emptyCookie,
 		Data:     getMeetingUserRequestData(2, 1),
 	}
@@ -233,7 +233,7 @@ func UserIdNotFoundParticipationRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/request-participation",
+		Endpoint: "api/v1/meeting/request-participation",
 		Cookie:   cookie,
 		Data:     getMeetingUserRequestData(2, uint(len(repositories.UsersCredentials)+1)),
 	}
@@ -243,7 +243,7 @@ func MeetingIdNotFoundParticipationRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/request-participation",
+		Endpoint: "api/v1/meeting/request-participation",
 		Cookie:   cookie,
 		Data:     getMeetingIdNotFoundUserRequestData(),
 	}
@@ -253,7 +253,47 @@ func InvalidIdsParticipationRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/request-participation",
+		Endpoint: "api/v1/meeting/request-participation",
+		Cookie:   cookie,
+		Data:     getMeetingUserInvalidIdsRequestData(),
+	}
+}
+
+func DeclineParticipationRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodDelete,
+		Endpoint: "api/v1/meeting/request-participation",
+		Cookie:   cookie,
+		Data:     getMeetingUserRequestData(1, repositories.FirstMeetingApplicantId),
+	}
+}
+
+func DeclineParticipationRequestWithoutSession(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodDelete,
+		Endpoint: "api/v1/meeting/request-participation",
+		Cookie:   emptyCookie,
+		Data:     getMeetingUserRequestData(1, repositories.FirstMeetingApplicantId),
+	}
+}
+
+func DeclineNotExistsParticipationRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodDelete,
+		Endpoint: "api/v1/meeting/request-participation",
+		Cookie:   cookie,
+		Data:     getMeetingUserRequestData(1, repositories.UserIdWithoutFirstRequestChat),
+	}
+}
+
+func DeclineParticipationInvalidIdsRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodDelete,
+		Endpoint: "api/v1/meeting/request-participation",
 		Cookie:   cookie,
 		Data:     getMeetingUserInvalidIdsRequestData(),
 	}
@@ -263,7 +303,7 @@ func InviteUserRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/user",
+		Endpoint: "api/v1/meeting/user",
 		Cookie:   cookie,
 		Data:     getMeetingUserRequestData(1, 2),
 	}
@@ -273,7 +313,7 @@ func InviteUserRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/user",
+		Endpoint: "api/v1/meeting/user",
 		Cookie:   emptyCookie,
 		Data:     getMeetingUserRequestData(1, 2),
 	}
@@ -283,7 +323,7 @@ func InviteAlreadyInMeetingUserRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/user",
+		Endpoint: "api/v1/meeting/user",
 		Cookie:   cookie,
 		Data:     getMeetingUserRequestData(1, 1),
 	}
@@ -293,7 +333,7 @@ func InviteUserMeetingIdNotFoundRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/user",
+		Endpoint: "api/v1/meeting/user",
 		Cookie:   cookie,
 		Data:     getMeetingIdNotFoundUserRequestData(),
 	}
@@ -303,7 +343,7 @@ func InviteUserMeetingInvalidIdsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "meeting/user",
+		Endpoint: "api/v1/meeting/user",
 		Cookie:   cookie,
 		Data:     getMeetingUserInvalidIdsRequestData(),
 	}
@@ -313,7 +353,7 @@ func KickUserRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "meeting/user",
+		Endpoint: "api/v1/meeting/user",
 		Cookie:   cookie,
 		Data:     getMeetingUserRequestData(1, 1),
 	}
@@ -323,7 +363,7 @@ func KickUserRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "meeting/user",
+		Endpoint: "api/v1/meeting/user",
 		Cookie:   emptyCookie,
 		Data:     getMeetingUserRequestData(1, 1),
 	}
@@ -333,7 +373,7 @@ func KickNotInMeetingUserRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "meeting/user",
+		Endpoint: "api/v1/meeting/user",
 		Cookie:   cookie,
 		Data:     getMeetingUserRequestData(1, 2),
 	}
@@ -343,7 +383,7 @@ func KickUserInvalidIdsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodDelete,
-		Endpoint: "meeting/user",
+		Endpoint: "api/v1/meeting/user",
 		Cookie:   cookie,
 		Data:     getMeetingUserInvalidIdsRequestData(),
 	}
@@ -1,11 +1,15 @@
 package api
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/gorilla/mux"
+	"mime/multipart"
 	"mock/repositories"
 	"models"
 	"net/http"
+	"net/url"
+	"time"
 	"utils"
 )
 
@@ -14,10 +18,78 @@ type (
 		Status string           `json:"status"`
 		Data   []models.Message `json:"data"`
 	}
+
+	UnreadCountsResponse struct {
+		Status string              `json:"status"`
+		Data   models.UnreadCounts `json:"data"`
+	}
+
+	SearchHitsResponse struct {
+		Status string             `json:"status"`
+		Data   []models.SearchHit `json:"data"`
+	}
+
+	ReadReceiptFrame struct {
+		Type string `json:"type"`
+		models.ReadReceipt
+	}
+
+	MessageResponse struct {
+		Status string         `json:"status"`
+		Data   models.Message `json:"data"`
+	}
+
+	MessageEditsResponse struct {
+		Status string               `json:"status"`
+		Data   []models.MessageEdit `json:"data"`
+	}
+
+	MessageEventFrame struct {
+		Type    string         `json:"type"`
+		Message models.Message `json:"message"`
+	}
+
+	ThreadResponse struct {
+		Status string        `json:"status"`
+		Data   models.Thread `json:"data"`
+	}
+
+	AttachmentResponse struct {
+		Status string            `json:"status"`
+		Data   models.Attachment `json:"data"`
+	}
+
+	ReactionResponse struct {
+		Status string                `json:"status"`
+		Data   models.ReactionChange `json:"data"`
+	}
+
+	ReactionEventFrame struct {
+		Type string `json:"type"`
+		models.ReactionChange
+	}
+
+	NotificationsResponse struct {
+		Status string                `json:"status"`
+		Data   []models.Notification `json:"data"`
+	}
+
+	OnlineUsersResponse struct {
+		Status string `json:"status"`
+		Data   []uint `json:"data"`
+	}
 )
 
 var (
 	DefaultMessagesCount = 2
+	// limits are low enough to be exceeded by burst of test messages
+	MessageRateLimits = models.MessageRateLimits{
+		UserRate:        0.01,
+		UserBurst:       3,
+		ChatRate:        0.01,
+		ChatBurst:       4,
+		DuplicateWindow: time.Minute,
+	}
 )
 
 func InvalidProtocolRequest(r *mux.Router) utils.RequestData {
@@ -25,7 +97,7 @@ func InvalidProtocolRequest(r *mux.Router) utils.RequestData {
 		Router:   r,
 		Method:   http.MethodGet,
 		Cookie:   cookie,
-		Endpoint: "ws",
+		Endpoint: "api/v1/ws",
 	}
 }
 
@@ -33,7 +105,7 @@ func GetMessagesRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: fmt.Sprintf("messages/1/%d", DefaultMessagesCount),
+		Endpoint: fmt.Sprintf("api/v1/messages/1/%d", DefaultMessagesCount),
 		Cookie:   cookie,
 	}
 }
@@ -42,7 +114,7 @@ func GetMessagesInvalidDataRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "messages/0/0",
+		Endpoint: "api/v1/messages/0/0",
 		Cookie:   cookie,
 	}
 }
@@ -51,7 +123,7 @@ func GetMessagesAfterMessageRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: fmt.Sprintf("messages/1/1/%d", DefaultMessagesCount),
+		Endpoint: fmt.Sprintf("api/v1/messages/1/1/%d", DefaultMessagesCount),
 		Cookie:   cookie,
 	}
 }
@@ -60,11 +132,257 @@ func GetMessagesAfterMessageInvalidDataRequest(r *mux.Router) utils.RequestData
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "messages/0/0/0",
+		Endpoint: "api/v1/messages/0/0/0",
+		Cookie:   cookie,
+	}
+}
+
+func MarkAsReadRequest(r *mux.Router) utils.RequestData {
+	receipt := repositories.GetFirstChatReadReceipt()
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPost,
+		Endpoint: "api/v1/messages/read",
+		Cookie:   cookie,
+		Data: fmt.Sprintf(
+			`{"chat_id": %d, "user_id": %d, "message_id": %d}`, receipt.ChatId, receipt.UserId, receipt.MessageId),
+	}
+}
+
+func MarkAsReadMessageFromAnotherChatRequest(r *mux.Router) utils.RequestData {
+	receipt := repositories.GetReadReceiptWithMessageFromAnotherChat()
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPost,
+		Endpoint: "api/v1/messages/read",
+		Cookie:   cookie,
+		Data: fmt.Sprintf(
+			`{"chat_id": %d, "user_id": %d, "message_id": %d}`, receipt.ChatId, receipt.UserId, receipt.MessageId),
+	}
+}
+
+func MarkAsReadInvalidDataRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPost,
+		Endpoint: "api/v1/messages/read",
+		Cookie:   cookie,
+		Data:     `{"chat_id": 0, "user_id": 1, "message_id": 1}`,
+	}
+}
+
+func GetUnreadCountsRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: "api/v1/messages/unread/1",
+		Cookie:   cookie,
+	}
+}
+
+func GetUnreadCountsInvalidIdRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: "api/v1/messages/unread/0",
+		Cookie:   cookie,
+	}
+}
+
+func SearchMessagesRequest(r *mux.Router, query models.SearchQuery) utils.RequestData {
+	return utils.RequestData{
+		Router: r,
+		Method: http.MethodGet,
+		Endpoint: fmt.Sprintf(
+			"api/v1/messages/search/%d/%d?%s", query.UserId, query.Count, url.Values{
+				"text":      {query.Text},
+				"chat_id":   {fmt.Sprint(query.ChatId)},
+				"before_id": {fmt.Sprint(query.BeforeId)},
+			}.Encode(),
+		),
+		Cookie: cookie,
+	}
+}
+
+func GetReadReceiptFrame() ReadReceiptFrame {
+	return ReadReceiptFrame{Type: "read", ReadReceipt: repositories.GetFirstChatReadReceipt()}
+}
+
+func GetOnlineUsersRequest(r *mux.Router, chatId uint) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("api/v1/messages/online/%d", chatId),
+		Cookie:   cookie,
+	}
+}
+
+func EditMessageRequest(r *mux.Router, messageId, userId uint) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPatch,
+		Endpoint: "api/v1/messages/message",
+		Cookie:   cookie,
+		Data:     fmt.Sprintf(`{"message_id": %d, "user_id": %d, "text": "edited"}`, messageId, userId),
+	}
+}
+
+func DeleteMessageRequest(r *mux.Router, messageId, userId uint) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodDelete,
+		Endpoint: "api/v1/messages/message",
+		Cookie:   cookie,
+		Data:     fmt.Sprintf(`{"message_id": %d, "user_id": %d}`, messageId, userId),
+	}
+}
+
+func GetMessageEditsRequest(r *mux.Router, messageId uint) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("api/v1/messages/edits/%d", messageId),
 		Cookie:   cookie,
 	}
 }
 
+func PinMessageRequest(r *mux.Router, messageId, userId uint) utils.RequestData {
+	return getPinRequest(r, http.MethodPost, messageId, userId)
+}
+
+func UnpinMessageRequest(r *mux.Router, messageId, userId uint) utils.RequestData {
+	return getPinRequest(r, http.MethodDelete, messageId, userId)
+}
+
+func getPinRequest(r *mux.Router, method string, messageId, userId uint) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   method,
+		Endpoint: "api/v1/messages/pin",
+		Cookie:   cookie,
+		Data:     fmt.Sprintf(`{"message_id": %d, "user_id": %d}`, messageId, userId),
+	}
+}
+
+func GetPinnedMessagesRequest(r *mux.Router, chatId uint) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("api/v1/messages/pinned/%d", chatId),
+		Cookie:   cookie,
+	}
+}
+
+func GetNotificationsRequest(r *mux.Router, userId uint) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("api/v1/messages/notifications/%d/%d", userId, DefaultMessagesCount),
+		Cookie:   cookie,
+	}
+}
+
+func AddReactionRequest(r *mux.Router, messageId, userId uint, emoji string) utils.RequestData {
+	return getReactionRequest(r, http.MethodPost, messageId, userId, emoji)
+}
+
+func RemoveReactionRequest(r *mux.Router, messageId, userId uint, emoji string) utils.RequestData {
+	return getReactionRequest(r, http.MethodDelete, messageId, userId, emoji)
+}
+
+func getReactionRequest(r *mux.Router, method string, messageId, userId uint, emoji string) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   method,
+		Endpoint: "api/v1/messages/reaction",
+		Cookie:   cookie,
+		Data:     fmt.Sprintf(`{"message_id": %d, "user_id": %d, "emoji": %q}`, messageId, userId, emoji),
+	}
+}
+
+func GetMessagesOfViewerRequest(r *mux.Router, viewerId uint) utils.RequestData {
+	request := GetMessagesRequest(r)
+	request.Endpoint += fmt.Sprintf("?user_id=%d", viewerId)
+	return request
+}
+
+func GetThreadRequest(r *mux.Router, rootId, afterId uint) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("api/v1/messages/thread/%d/%d/%d", rootId, afterId, DefaultMessagesCount),
+		Cookie:   cookie,
+	}
+}
+
+// user id field is sent before file as required by upload endpoint
+func UploadAttachmentRequest(r *mux.Router, userId uint, fileName string, content []byte) utils.RequestData {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	_ = writer.WriteField("user_id", fmt.Sprintf("%d", userId))
+	file, _ := writer.CreateFormFile("file", fileName)
+	_, _ = file.Write(content)
+	_ = writer.Close()
+
+	return utils.RequestData{
+		Router:      r,
+		Method:      http.MethodPost,
+		Endpoint:    "api/v1/messages/attachments",
+		Cookie:      cookie,
+		Data:        body.String(),
+		ContentType: writer.FormDataContentType(),
+	}
+}
+
+// attachment is downloaded by user of session token
+func DownloadAttachmentRequest(r *mux.Router, attachmentId uint, sessionToken string, thumbnail bool) utils.RequestData {
+	endpoint := fmt.Sprintf("api/v1/messages/attachments/%d", attachmentId)
+	if thumbnail {
+		endpoint += "/thumbnail"
+	}
+
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodGet,
+		Endpoint: endpoint,
+		Cookie:   &http.Cookie{Name: cookie.Name, Value: sessionToken},
+	}
+}
+
+func GetReactionFrame(frameType string, messageId uint) map[string]interface{} {
+	return map[string]interface{}{"type": frameType, "message_id": messageId, "user_id": 2, "emoji": "👍"}
+}
+
+// typing and presence frames are sent by applicant to request chat, so they are received by meeting admin
+func GetTypingFrame(typing bool) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "typing", "chat_id": repositories.FirstRequestChatId,
+		"user_id": repositories.FirstMeetingApplicantId, "typing": typing,
+	}
+}
+
+func GetPresenceFrame() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "presence", "chat_id": repositories.FirstRequestChatId, "user_id": repositories.FirstMeetingApplicantId,
+	}
+}
+
+func GetNotMemberPresenceFrame() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "presence", "chat_id": repositories.FirstRequestChatId,
+		"user_id": repositories.UserIdWithoutFirstRequestChat,
+	}
+}
+
+// message of meeting admin to request chat
+func GetRequestChatMessage() models.Message {
+	return models.Message{
+		ChatId:   repositories.FirstRequestChatId,
+		Text:     "Hello",
+		SenderId: 1,
+	}
+}
+
 func GetSimpleMessage() models.Message {
 	return models.Message{
 		ChatId:   1,
@@ -81,6 +399,29 @@ func GetAnotherSimpleMessage() models.Message {
 	}
 }
 
+// every message of burst has its own text to not be treated as duplicate
+func GetBurstMessages(senderId uint, count int) []models.Message {
+	var messages []models.Message
+	for i := 0; i < count; i++ {
+		message := GetSimpleMessage()
+		message.SenderId = senderId
+		message.Text = fmt.Sprintf("Burst %d from %d", i, senderId)
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+// chat of second meeting, its admin is the second user and the fourth user is a member
+func GetAnnouncementMessage() models.Message {
+	return models.Message{
+		ChatId:       3,
+		Text:         "Announcement",
+		SenderId:     2,
+		Announcement: true,
+	}
+}
+
 func GetMessageWithNotExistsChatId() models.Message {
 	message := GetSimpleMessage()
 	message.ChatId = repositories.NotExistsChatId
@@ -100,3 +441,17 @@ func GetMessageWithInvalidData() models.Message {
 		SenderId: 0,
 	}
 }
+
+func GetDirectMessage() models.Message {
+	return models.Message{
+		Text:        "Hello",
+		SenderId:    1,
+		RecipientId: repositories.UserIdWithoutDirectChats,
+	}
+}
+
+func GetDirectMessageToBlockingUser() models.Message {
+	message := GetDirectMessage()
+	message.RecipientId = repositories.UserIdThatBlockedFirstUser
+	return message
+}
@@ -25,7 +25,7 @@ func RequestWithSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "session/",
+		Endpoint: "api/v1/session/",
 		Cookie: &http.Cookie{
 			Name:  "GT-Session-Token",
 			Value: TestToken,
@@ -37,7 +37,7 @@ func RequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "session/",
+		Endpoint: "api/v1/session/",
 		Cookie:   &http.Cookie{},
 	}
 }
@@ -46,7 +46,7 @@ func RequestWithInvalidToken(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "session/",
+		Endpoint: "api/v1/session/",
 		Cookie: &http.Cookie{
 			Name:  "GT-Session-Token",
 			Value: "bad",
@@ -58,7 +58,7 @@ func SuccessRegistrationRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/register",
+		Endpoint: "api/v1/session/register",
 		Data:     `{"email": "mather.fucker@gmail.com", "password": "mYStRoNg*PwD12"}`,
 		Cookie:   cookie,
 	}
@@ -68,7 +68,7 @@ func EmailExistsRegistrationRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/register",
+		Endpoint: "api/v1/session/register",
 		Data: fmt.Sprintf(
 			`{"email": "%s", "password": "mYStRoNg*PwD12"}`, repositories.UsersCredentials[0]["email"]),
 		Cookie: &http.Cookie{},
@@ -79,7 +79,7 @@ func InvalidEmailRegistrationRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/register",
+		Endpoint: "api/v1/session/register",
 		Data:     `{"email": "hello@world", "password": "mYStRoNg*PwD12"}`,
 		Cookie:   cookie,
 	}
@@ -89,7 +89,7 @@ func InvalidPasswordRegistrationRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/register",
+		Endpoint: "api/v1/session/register",
 		Data: fmt.Sprintf(
 			`{"email": "%s", "password": "hey"}`, repositories.UsersCredentials[0]["email"]),
 		Cookie: &http.Cookie{},
@@ -100,7 +100,7 @@ func SuccessLoginRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/login",
+		Endpoint: "api/v1/session/login",
 		Data: fmt.Sprintf(
 			`{"email": "%s", "password": "mYStRoNg*PwD12"}`, repositories.UsersCredentials[0]["email"]),
 		Cookie: &http.Cookie{},
@@ -111,7 +111,7 @@ func NoCredentialsLoginRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/login",
+		Endpoint: "api/v1/session/login",
 		Data: fmt.Sprintf(
 			`{"email": "%s", "password": "not_exists"}`, repositories.UsersCredentials[0]["email"]),
 		Cookie: &http.Cookie{},
@@ -122,7 +122,7 @@ func InvalidEmailLoginRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/login",
+		Endpoint: "api/v1/session/login",
 		Data:     `{"email": "invalid@mail", "password": "not_exists"}`,
 		Cookie:   cookie,
 	}
@@ -132,7 +132,7 @@ func InvalidPasswordLoginRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/login",
+		Endpoint: "api/v1/session/login",
 		Data: fmt.Sprintf(
 			`{"email": "%s", "password": "hey"}`, repositories.UsersCredentials[0]["email"]),
 		Cookie: &http.Cookie{},
@@ -143,7 +143,7 @@ func SuccessChangePasswordRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "session/user/password",
+		Endpoint: "api/v1/session/user/password",
 		Data:     `{"user_id": 1, "password": "new_password"}`,
 		Cookie:   cookie,
 	}
@@ -153,7 +153,7 @@ func SuccessChangePasswordRequestWithoutSession(r *mux.Router) utils.RequestData
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "session/user/password",
+		Endpoint: "api/v1/session/user/password",
 		Data:     `{"user_id": 1, "password": "new_password"}`,
 		Cookie:   emptyCookie,
 	}
@@ -163,7 +163,7 @@ func UserIdNotFoundChangePasswordRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "session/user/password",
+		Endpoint: "api/v1/session/user/password",
 		Data:     fmt.Sprintf(`{"user_id": %d, "password": "new_password"}`, repositories.GetNextUserId()),
 		Cookie:   cookie,
 	}
@@ -173,7 +173,7 @@ func InvalidUserIdChangePasswordRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "session/user/password",
+		Endpoint: "api/v1/session/user/password",
 		Data:     `{"user_id": 0, "password": "new_password"}`,
 		Cookie:   cookie,
 	}
@@ -183,7 +183,7 @@ func InvalidPasswordChangePasswordRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "session/user/password",
+		Endpoint: "api/v1/session/user/password",
 		Data:     `{"user_id": 1, "password": "bad"}`,
 		Cookie:   cookie,
 	}
@@ -193,7 +193,7 @@ func SuccessLogoutRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/logout",
+		Endpoint: "api/v1/session/logout",
 		Cookie:   cookie,
 	}
 }
@@ -202,7 +202,7 @@ func SuccessLogoutRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPost,
-		Endpoint: "session/logout",
+		Endpoint: "api/v1/session/logout",
 		Cookie:   emptyCookie,
 	}
 }
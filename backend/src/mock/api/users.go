@@ -20,7 +20,7 @@ func FirstUserSettingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "user/settings/1",
+		Endpoint: "api/v1/user/settings/1",
 		Cookie:   cookie,
 	}
 }
@@ -29,7 +29,7 @@ func FirstUserSettingsRequestWithoutSession(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: "user/settings/1",
+		Endpoint: "api/v1/user/settings/1",
 		Cookie:   emptyCookie,
 	}
 }
@@ -38,7 +38,7 @@ func NotExistsUserSettingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodGet,
-		Endpoint: fmt.Sprintf("user/settings/%d", len(repositories.UsersCredentials)+1),
+		Endpoint: fmt.Sprintf("api/v1/user/settings/%d", len(repositories.UsersCredentials)+1),
 		Cookie:   cookie,
 	}
 }
@@ -48,7 +48,7 @@ func PatchFirstUserSettingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "user/settings",
+		Endpoint: "api/v1/user/settings",
 		Cookie:   cookie,
 		Data: fmt.Sprintf(
 			`{
@@ -63,7 +63,7 @@ func PatchFirstUserSettingsRequestWithoutSession(r *mux.Router) utils.RequestDat
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "user/settings",
+		Endpoint: "api/v1/user/settings",
 		Cookie:   emptyCookie,
 		Data: fmt.Sprintf(
 			`{
@@ -78,7 +78,7 @@ func PatchNotExistsUserSettingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "user/settings",
+		Endpoint: "api/v1/user/settings",
 		Cookie:   cookie,
 		Data: fmt.Sprintf(
 			`{
@@ -93,7 +93,7 @@ func InvalidIdUserSettingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "user/settings",
+		Endpoint: "api/v1/user/settings",
 		Cookie:   cookie,
 		Data: fmt.Sprintf(
 			`{
@@ -106,7 +106,7 @@ func InvalidAllSettingsUserSettingsRequest(r *mux.Router) utils.RequestData {
 	return utils.RequestData{
 		Router:   r,
 		Method:   http.MethodPatch,
-		Endpoint: "user/settings",
+		Endpoint: "api/v1/user/settings",
 		Cookie:   cookie,
 		Data: `{
 			"user_id": 1, "settings": {
@@ -114,3 +114,63 @@ func InvalidAllSettingsUserSettingsRequest(r *mux.Router) utils.RequestData {
 			}}`,
 	}
 }
+
+func PatchFirstUserPrivacyRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPatch,
+		Endpoint: "api/v1/user/privacy",
+		Cookie:   cookie,
+		Data:     `{"user_id": 1, "direct_messages_allowed": false}`,
+	}
+}
+
+func PatchNotExistsUserPrivacyRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPatch,
+		Endpoint: "api/v1/user/privacy",
+		Cookie:   cookie,
+		Data:     fmt.Sprintf(`{"user_id": %d, "direct_messages_allowed": false}`, repositories.GetNotExistsUserId()),
+	}
+}
+
+func BlockUserRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPost,
+		Endpoint: "api/v1/user/block",
+		Cookie:   cookie,
+		Data:     `{"user_id": 1, "blocked_user_id": 2}`,
+	}
+}
+
+func BlockNotExistsUserRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPost,
+		Endpoint: "api/v1/user/block",
+		Cookie:   cookie,
+		Data:     fmt.Sprintf(`{"user_id": 1, "blocked_user_id": %d}`, repositories.GetNotExistsUserId()),
+	}
+}
+
+func BlockUserInvalidIdRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodPost,
+		Endpoint: "api/v1/user/block",
+		Cookie:   cookie,
+		Data:     `{"user_id": 1, "blocked_user_id": 1}`,
+	}
+}
+
+func UnblockUserRequest(r *mux.Router) utils.RequestData {
+	return utils.RequestData{
+		Router:   r,
+		Method:   http.MethodDelete,
+		Endpoint: "api/v1/user/block",
+		Cookie:   cookie,
+		Data:     fmt.Sprintf(`{"user_id": %d, "blocked_user_id": 1}`, repositories.UserIdThatBlockedFirstUser),
+	}
+}
@@ -0,0 +1,18 @@
+package plugins
+
+import "models"
+
+var (
+	ContentFilterConfig = models.ContentFilterConfig{
+		Mode: "reject",
+		Words: map[string][]string{
+			"en": {"badword", "scam*"},
+			"ru": {"плохослово"},
+		},
+		MaxLinks:         1,
+		MaxRepeatedChars: 4,
+	}
+	CleanTexts = []string{
+		"Hello, world", "See https://example.com", "Cool!!!!", "Привет, мир", "mad words are ok",
+	}
+)
@@ -12,10 +12,11 @@ var (
 		"wtf@gmail.", "",
 	}
 	ValidNicknames = []string{
-		"mather_fucker", "hello-world", "valid$nick", "killer228",
+		"mather_fucker", "hello-world", "valid$nick", "killer228", "ёжик_2020",
+		"дядяваня-1234567",
 	}
 	InValidNicknames = []string{
-		"hello*world", "   ", "too_long_nickname", "nea<", "invalid^too",
+		"hello*world", "   ", "too_long_nickname", "ab", "ник🎉", "nea<", "invalid^too",
 		"!!", "hello.", "it's me", "how r u?", "u&me",
 	}
 	ValidLongitudes = []float64{ // -180 <= longitude <= 180
@@ -47,27 +48,53 @@ var (
 	ValidTitles = []string{
 		"This is the title", "Заголовок встречи", "Привет мир 21",
 		"Встреча 42", "28 friends", "title_with_underscore", "what_$",
-		"Вы готовы, дети?", "Саша - п*др (шутка)",
+		"Вы готовы, дети?", "Саша - п*др (шутка)", "hello/world", "bad^title",
+		"плохой&заголовок", "Встреча! 🎉", "日本語のタイトル", "Ёжик",
 	}
 	InvalidTitles = []string{
-		"", "        ", "1", "bad_<<", "hello/world", "bad^title",
-		"плохой&заголовок",
+		"", "        ", "1", "bad_<<", "two\nlines", "ab", "🎉",
+		strings.Repeat("д", 256),
 	}
 	ValidDescriptions = []string{
 		"Some simple text. Can contain underscores _ (for i.e.) or numbers 1, 2",
 		"Большое описание встречи. Содержит, по мимо всего прочего, интересные символы вроде: *()?$%",
+		"First line of description\nSecond line of description",
+		strings.Repeat("д", 1024),
 	}
 	InvalidDescriptions = []string{
 		"too short", strings.Repeat("very long", 200), "here we are<",
+		"\n\n\n\n\n\n\n\n\n\n\n\n\n\n\n\n", strings.Repeat("д", 1025),
 	}
 	ValidMessages = []string{
-		".", "hello", "привет, мир", "тест", "how r u?",
+		".", "hello", "привет, мир", "тест", "how r u?", "hi!\nhow are you?",
+		"👍🏽", "🇷🇺 ура", strings.Repeat("д", 1024),
 	}
 	InvalidMessages = []string{
-		"", strings.Repeat("very long", 200), "here we are<",
+		"", "  \n  ", strings.Repeat("very long", 200), "here we are<",
+		strings.Repeat("д", 1025), "bell\a",
+	}
+	ValidEmojis = []string{
+		"👍", "❤️", "😂", "👩‍💻", "🇷🇺", "👍🏽",
+	}
+	InvalidEmojis = []string{
+		"", "a", "1", " ", "👍 ", "like", strings.Repeat("👍", 10), "\u200d",
+	}
+	ValidFileNames = []string{
+		"photo.jpg", "отчёт 2020.pdf", "notes", ".hidden", "file (1).txt",
+	}
+	InvalidFileNames = []string{
+		"", "   ", ".", "..", "../passwd", "dir/file.txt", "c:\\file.txt",
+		"new\nline.txt", strings.Repeat("long", 64),
+	}
+	ValidSearchTexts = []string{
+		"meet", "где встретимся", `"where did we agree" -tomorrow`, "<b>",
+	}
+	InvalidSearchTexts = []string{
+		"", "   ", strings.Repeat("long", 64),
 	}
 	ValidNames = []string{
 		"Иван Иванов", "John Doe", "Илья М.", "tag1", "тег встречи",
+		"Zoë O'Neil", "José", "山田太郎",
 	}
 	InvalidNames = []string{
 		"", "     ", "1", "bad_<<", "hello/world", "bad^name",
@@ -87,6 +114,12 @@ var (
 	InvalidGenders = []string{
 		"bad", "мальчик", "Саша",
 	}
+	ValidTranscriptFormats = []string{
+		"json", "csv", "text",
+	}
+	InvalidTranscriptFormats = []string{
+		"", "JSON", "xml", "txt",
+	}
 	ValidURLs = []string{
 		"vk.com", "https://www.google.com", "http://yandex.ru",
 		"localhost:80/api", "https://some-site.com/path?agr1=2&arg2=12",
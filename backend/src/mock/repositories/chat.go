@@ -1,11 +1,82 @@
 package repositories
 
+import "models"
+
 const (
 	MeetingIdWithoutMeetingChat = 3
 	MeetingType                 = "meeting"
+	DirectType                  = "direct"
 	ArchivedStatus              = "archived"
 )
 
 var (
-	NotExistsChatId = uint(len(MeetingChats) + 1)
+	NotExistsChatId            = uint(len(MeetingChats) + len(DirectChats) + 1)
+	FirstDirectChatId          = uint(len(MeetingChats) + 1)
+	UserIdWithoutDirectChats   = uint(4)
+	UserIdThatBlockedFirstUser = uint(3)
+	UserIdNotInFirstChat       = uint(4)
+	// applicant has opened request chat in first meeting, other user has not requested participation in it
+	FirstMeetingApplicantId       = uint(2)
+	UserIdWithoutFirstRequestChat = uint(3)
+	FirstRequestChatId            = uint(2)
 )
+
+func GetFirstDirectChatUsers() (uint, uint) {
+	return uint(DirectChats[0]["first_user_id"].(int)), uint(DirectChats[0]["second_user_id"].(int))
+}
+
+// returns text of the latest message in chat and count of messages that were sent by other users
+func GetChatLastMessageAndUnreadCount(chatId, userId uint) (string, uint) {
+	var (
+		lastText, lastTime string
+		unreadCount        uint
+	)
+	for _, message := range ChatsMessages {
+		if uint(message["chat_id"].(int)) != chatId {
+			continue
+		}
+
+		if sendingTime := message["sending_time"].(string); sendingTime >= lastTime {
+			lastText, lastTime = message["text"].(string), sendingTime
+		}
+		if uint(message["sender_id"].(int)) != userId {
+			unreadCount++
+		}
+	}
+
+	return lastText, unreadCount
+}
+
+// returns 0 if chat is not meeting chat
+func GetChatMeetingAdminId(chatId uint) uint {
+	if chatId == 0 || int(chatId) > len(MeetingChats) {
+		return 0
+	}
+
+	chat := MeetingChats[chatId-1]
+	if chat["type"].(string) != MeetingType {
+		return 0
+	}
+	for _, meeting := range Meetings {
+		if meeting["meeting_id"] == chat["meeting_id"] {
+			return uint(meeting["admin_id"].(int))
+		}
+	}
+
+	return 0
+}
+
+func GetFirstChatExportRequest(format string) models.ChatExportRequest {
+	return models.ChatExportRequest{ChatId: 1, AdminId: GetChatMeetingAdminId(1), Format: format}
+}
+
+func GetChatMessagesCount(chatId uint) int {
+	count := 0
+	for _, message := range ChatsMessages {
+		if uint(message["chat_id"].(int)) == chatId {
+			count++
+		}
+	}
+
+	return count
+}
@@ -26,10 +26,11 @@ func GetMessageWithNotExistsUserId() models.Message {
 
 func GetAllMessages() []models.Message {
 	var messages []models.Message
-	for _, message := range ChatsMessages {
+	for i, message := range ChatsMessages {
 		sendingTime, _ := time.Parse(validation.DateFormat, message["sending_time"].(string))
 
 		messages = append(messages, models.Message{
+			Id:          uint(i + 1),
 			ChatId:      uint(message["chat_id"].(int)),
 			Text:        message["text"].(string),
 			SenderId:    uint(message["sender_id"].(int)),
@@ -39,3 +40,55 @@ func GetAllMessages() []models.Message {
 
 	return messages
 }
+
+// first user reads all messages in first chat
+func GetFirstChatReadReceipt() models.ReadReceipt {
+	var lastMessageId uint
+	for _, message := range GetAllMessages() {
+		if message.ChatId == 1 {
+			lastMessageId = message.Id
+		}
+	}
+
+	return models.ReadReceipt{ChatId: 1, UserId: 1, MessageId: lastMessageId}
+}
+
+func GetReadReceiptWithMessageFromAnotherChat() models.ReadReceipt {
+	receipt := GetFirstChatReadReceipt()
+	for _, message := range GetAllMessages() {
+		if message.ChatId != receipt.ChatId {
+			receipt.MessageId = message.Id
+			break
+		}
+	}
+
+	return receipt
+}
+
+func GetReadReceiptOfNotChatMember() models.ReadReceipt {
+	receipt := GetFirstChatReadReceipt()
+	receipt.UserId = UserIdNotInFirstChat
+	return receipt
+}
+
+// reply to the first message of first chat
+func GetReplyMessage() models.Message {
+	parent := GetAllMessages()[0]
+	return models.Message{ChatId: parent.ChatId, SenderId: 2, Text: "reply", ReplyTo: &parent.Id}
+}
+
+func GetReplyToMessageFromAnotherChat() models.Message {
+	message := GetReplyMessage()
+	readReceipt := GetReadReceiptWithMessageFromAnotherChat()
+	message.ReplyTo = &readReceipt.MessageId
+	return message
+}
+
+func GetNotExistsMessageId() uint {
+	return uint(len(ChatsMessages) + 1)
+}
+
+// first user is the only member of first chat, where only his message contains searched text
+func GetFirstUserSearchQuery() models.SearchQuery {
+	return models.SearchQuery{UserId: 1, Text: "world 1", ChatId: 1, Count: 10}
+}
@@ -48,3 +48,14 @@ func GetFirstUserSettings() models.FullUserInfo {
 func GetNotExistsUserId() uint {
 	return uint(len(UsersCredentials) + 1)
 }
+
+// returns empty string for user without info
+func GetUserNickname(userId uint) string {
+	for _, info := range UsersInfo {
+		if uint(info["user_id"].(int)) == userId {
+			return info["nickname"].(string)
+		}
+	}
+
+	return ""
+}
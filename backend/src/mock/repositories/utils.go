@@ -3,20 +3,32 @@ package repositories
 import (
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
+	"time"
 	"utils"
 )
 
+// timeout of queries to test DB
+const QueryTimeout = 5 * time.Second
+
 const (
 	DropTablesQuery = `
   DROP TABLE IF EXISTS users CASCADE;
 	DROP TABLE IF EXISTS users_credentials;
   DROP TABLE IF EXISTS users_info;
   DROP TABLE IF EXISTS users_rating;
+  DROP TABLE IF EXISTS users_blocks;
   DROP TABLE IF EXISTS meetings CASCADE;
   DROP TABLE IF EXISTS meetings_settings;
   DROP TABLE IF EXISTS meetings_places;
   DROP TABLE IF EXISTS chats CASCADE;
+  DROP TABLE IF EXISTS direct_chats;
+  DROP TABLE IF EXISTS chats_members;
+  DROP TABLE IF EXISTS attachments;
+  DROP TABLE IF EXISTS notifications;
+  DROP TABLE IF EXISTS messages_reactions;
+  DROP TABLE IF EXISTS messages_edits;
   DROP TABLE IF EXISTS messages;
+  DROP TABLE IF EXISTS moderation_flags;
   DROP TYPE IF EXISTS GENDER;
   DROP TYPE IF EXISTS MEETING_STATUS;
   DROP TYPE IF EXISTS CHAT_TYPE;
@@ -24,7 +36,7 @@ const (
 	CreateTablesQuery = `
   CREATE TYPE GENDER AS ENUM('male', 'female', '');
 	CREATE TYPE MEETING_STATUS AS ENUM('pending', 'archived');
-	CREATE TYPE CHAT_TYPE AS ENUM('meeting', 'meeting_request');
+	CREATE TYPE CHAT_TYPE AS ENUM('meeting', 'meeting_request', 'direct');
 	CREATE TYPE CHAT_STATUS AS ENUM('chatting', 'archived');
 
 	CREATE TABLE IF NOT EXISTS users(
@@ -46,7 +58,8 @@ const (
 		nickname VARCHAR(255) NOT NULL,
 		gender GENDER DEFAULT '',
 		age INTEGER DEFAULT 0,
-		avatar_url VARCHAR DEFAULT ''
+		avatar_url VARCHAR DEFAULT '',
+		direct_messages_allowed BOOLEAN DEFAULT TRUE
 	);
 
 	CREATE TABLE IF NOT EXISTS users_rating(
@@ -57,6 +70,13 @@ const (
 		UNIQUE (user_id, tag)
 	);
 
+	CREATE TABLE IF NOT EXISTS users_blocks(
+		id SERIAL PRIMARY KEY,
+		user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		blocked_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		UNIQUE (user_id, blocked_user_id)
+	);
+
 	CREATE TABLE IF NOT EXISTS meetings(
 		id SERIAL PRIMARY KEY,
 		admin_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
@@ -90,19 +110,98 @@ const (
 
 	CREATE TABLE IF NOT EXISTS chats(
 		id SERIAL PRIMARY KEY,
-		meeting_id INTEGER NOT NULL REFERENCES meetings(id) ON DELETE CASCADE,
+		meeting_id INTEGER DEFAULT NULL REFERENCES meetings(id) ON DELETE CASCADE,
+		applicant_id INTEGER DEFAULT NULL REFERENCES users(id) ON DELETE CASCADE,
 		type CHAT_TYPE NOT NULL,
 		status CHAT_STATUS DEFAULT 'chatting',
 		archived_at TIMESTAMP DEFAULT NULL,
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 	);
 
+	CREATE UNIQUE INDEX IF NOT EXISTS chats_meeting_request_idx ON chats(meeting_id, applicant_id)
+	WHERE type = 'meeting_request' AND status != 'archived';
+
+	CREATE TABLE IF NOT EXISTS direct_chats(
+		id SERIAL PRIMARY KEY,
+		chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+		first_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		second_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		CHECK (first_user_id < second_user_id),
+		UNIQUE (first_user_id, second_user_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS chats_members(
+		id SERIAL PRIMARY KEY,
+		chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+		user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		last_read_message_id INTEGER DEFAULT NULL,
+		joined_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (chat_id, user_id)
+	);
+
 	CREATE TABLE IF NOT EXISTS messages(
 		id SERIAL PRIMARY KEY,
 		chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
 		sender_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
 		text TEXT NOT NULL,
-		sending_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		sending_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		edited_at TIMESTAMP DEFAULT NULL,
+		deleted_at TIMESTAMP DEFAULT NULL,
+		reply_to INTEGER DEFAULT NULL REFERENCES messages(id) ON DELETE SET NULL,
+		thread_root_id INTEGER DEFAULT NULL REFERENCES messages(id) ON DELETE SET NULL,
+		announcement BOOLEAN NOT NULL DEFAULT FALSE,
+		pinned_at TIMESTAMP DEFAULT NULL
+	);
+
+	CREATE INDEX IF NOT EXISTS messages_thread_root_id_idx ON messages(thread_root_id);
+	CREATE INDEX IF NOT EXISTS messages_text_search_idx ON messages USING GIN (to_tsvector('russian', text));
+	CREATE INDEX IF NOT EXISTS messages_pinned_idx ON messages(chat_id, pinned_at) WHERE pinned_at IS NOT NULL;
+
+	CREATE TABLE IF NOT EXISTS notifications(
+		id SERIAL PRIMARY KEY,
+		user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (user_id, message_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS attachments(
+		id SERIAL PRIMARY KEY,
+		uploader_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		message_id INTEGER DEFAULT NULL REFERENCES messages(id) ON DELETE SET NULL,
+		file_name VARCHAR(255) NOT NULL,
+		mime_type VARCHAR(127) NOT NULL,
+		size BIGINT NOT NULL,
+		blob_id VARCHAR(64) NOT NULL UNIQUE,
+		has_thumbnail BOOLEAN NOT NULL DEFAULT FALSE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS attachments_message_id_idx ON attachments(message_id);
+
+	CREATE TABLE IF NOT EXISTS messages_reactions(
+		id SERIAL PRIMARY KEY,
+		message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+		user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		emoji VARCHAR(32) NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (message_id, user_id, emoji)
+	);
+
+	CREATE TABLE IF NOT EXISTS messages_edits(
+		id SERIAL PRIMARY KEY,
+		message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+		text TEXT NOT NULL,
+		edited_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS moderation_flags(
+		id SERIAL PRIMARY KEY,
+		kind VARCHAR(16) NOT NULL,
+		source_id INTEGER NOT NULL,
+		text TEXT NOT NULL,
+		reasons TEXT[] NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 	);`
 	CreateUserQuery            = `INSERT INTO users DEFAULT VALUES;`
 	CreateUserCredentialsQuery = `
@@ -119,8 +218,23 @@ const (
 	CreateMeetingPlaceQuery = `
   INSERT INTO meetings_places(meeting_id, label, latitude, longitude)
   VALUES(:meeting_id, :label, :latitude, :longitude);`
-	CreateChatQuery    = `INSERT INTO chats(meeting_id, type) VALUES(:meeting_id, :type)`
-	CreateMessageQuery = `
+	CreateChatQuery       = `INSERT INTO chats(meeting_id, applicant_id, type) VALUES(:meeting_id, :applicant_id, :type)`
+	CreateDirectChatQuery = `
+	WITH chat AS (INSERT INTO chats(type) VALUES('direct') RETURNING id)
+	INSERT INTO direct_chats(chat_id, first_user_id, second_user_id)
+	SELECT id, :first_user_id, :second_user_id FROM chat`
+	// chats members are derived from meetings users (or meeting admin and applicant for request chats)
+	// and direct chats users
+	CreateChatsMembersQuery = `
+	INSERT INTO chats_members(chat_id, user_id)
+	SELECT c.id, UNNEST(CASE WHEN c.type = 'meeting' THEN m.user_ids ELSE ARRAY[m.admin_id, c.applicant_id] END)
+	FROM chats c JOIN meetings m ON m.id = c.meeting_id;
+	INSERT INTO chats_members(chat_id, user_id)
+	SELECT chat_id, first_user_id FROM direct_chats
+	UNION ALL
+	SELECT chat_id, second_user_id FROM direct_chats;`
+	CreateUserBlockQuery = `INSERT INTO users_blocks(user_id, blocked_user_id) VALUES(:user_id, :blocked_user_id)`
+	CreateMessageQuery   = `
 	INSERT INTO messages(chat_id, sender_id, text, sending_time)
 	VALUES(:chat_id, :sender_id, :text, :sending_time)`
 )
@@ -196,9 +310,17 @@ var (
 		{"meeting_id": 3, "label": "221b baker street", "latitude": 51.5207, "longitude": -0.1550},
 	}
 	MeetingChats = []map[string]interface{}{
-		{"meeting_id": 1, "type": "meeting"}, {"meeting_id": 1, "type": "meeting_request"},
-		{"meeting_id": 2, "type": "meeting"}, {"meeting_id": 2, "type": "meeting_request"},
-		{"meeting_id": 3, "type": "meeting_request"},
+		{"meeting_id": 1, "applicant_id": nil, "type": "meeting"},
+		{"meeting_id": 1, "applicant_id": 2, "type": "meeting_request"},
+		{"meeting_id": 2, "applicant_id": nil, "type": "meeting"},
+		{"meeting_id": 2, "applicant_id": 3, "type": "meeting_request"},
+		{"meeting_id": 3, "applicant_id": 2, "type": "meeting_request"},
+	}
+	DirectChats = []map[string]interface{}{
+		{"first_user_id": 1, "second_user_id": 2},
+	}
+	UsersBlocks = []map[string]interface{}{
+		{"user_id": 3, "blocked_user_id": 1},
 	}
 	ChatsMessages = []map[string]interface{}{
 		{"chat_id": 1, "sender_id": 1, "text": "hello world 1", "sending_time": "02-15-2020 12:58:44"},
@@ -212,6 +334,7 @@ var (
 		CreateUserCredentialsQuery: UsersCredentials,
 		CreateUserInfoQuery:        UsersInfo,
 		CreateUserRatingQuery:      UsersRating,
+		CreateUserBlockQuery:       UsersBlocks,
 		CreateMeetingSettingsQuery: MeetingsSettings,
 		CreateMeetingPlaceQuery:    MeetingsPlaces,
 		CreateMessageQuery:         ChatsMessages,
@@ -254,6 +377,8 @@ func insertData(db *sqlx.DB) {
 	addDataFromSource(tx, CreateUserQuery, Users)
 	addDataFromSource(tx, CreateMeetingQuery, meetings)
 	addDataFromSource(tx, CreateChatQuery, MeetingChats)
+	addDataFromSource(tx, CreateDirectChatQuery, DirectChats)
+	tx.MustExec(CreateChatsMembersQuery)
 	if err := tx.Commit(); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"internal_errors"
+	"io"
+	"io/ioutil"
+	"mock/repositories"
+	"models"
+	"time"
+)
+
+type (
+	AttachmentsRepositoryMock struct {
+		attachments map[uint]models.Attachment
+	}
+
+	// stores blobs in memory
+	BlobStoreMock struct {
+		blobs map[string][]byte
+	}
+)
+
+var (
+	NotExistsAttachmentId uint = 100
+
+	AttachmentLimits = models.AttachmentLimits{
+		MaxSize:       1024,
+		AllowedTypes:  []string{"image/png", "text/plain"},
+		UploadTimeout: time.Minute,
+	}
+
+	AttachmentsRepository = AttachmentsRepositoryMock{
+		attachments: map[uint]models.Attachment{},
+	}
+	BlobStore = BlobStoreMock{
+		blobs: map[string][]byte{},
+	}
+)
+
+func (m *AttachmentsRepositoryMock) ResetState() {
+	m.attachments = map[uint]models.Attachment{}
+}
+
+func (m *AttachmentsRepositoryMock) SaveAttachment(
+	ctx context.Context, attachment models.Attachment,
+) (models.Attachment, error) {
+	if attachment.UploaderId == BadUserId {
+		return models.Attachment{}, someInternalError
+	} else if attachment.UploaderId == repositories.GetNotExistsUserId() {
+		return models.Attachment{}, internal_errors.UnableToFindUserById
+	}
+
+	attachment.Id = uint(len(m.attachments) + 1)
+	attachment.CreatedAt = time.Now()
+	m.attachments[attachment.Id] = attachment
+
+	return attachment, nil
+}
+
+func (m *AttachmentsRepositoryMock) GetAttachment(ctx context.Context, attachmentId uint) (models.Attachment, error) {
+	attachment, found := m.attachments[attachmentId]
+	if !found {
+		return models.Attachment{}, internal_errors.UnableToFindAttachment
+	}
+
+	return attachment, nil
+}
+
+// only user that is not in first chat is not member of chats in mock
+func (m *AttachmentsRepositoryMock) IsChatMember(ctx context.Context, chatId, userId uint) (bool, error) {
+	if userId == BadUserId {
+		return false, someInternalError
+	}
+
+	return userId != repositories.UserIdNotInFirstChat, nil
+}
+
+// marks attachment as sent with message of chat
+func (m *AttachmentsRepositoryMock) Send(attachmentId, messageId, chatId uint) {
+	attachment := m.attachments[attachmentId]
+	attachment.MessageId, attachment.ChatId = &messageId, &chatId
+	m.attachments[attachmentId] = attachment
+}
+
+// all not sent attachments are orphans in mock
+func (m *AttachmentsRepositoryMock) GetOrphanAttachments(
+	ctx context.Context, _ time.Duration,
+) ([]models.Attachment, error) {
+	var attachments []models.Attachment
+	for _, attachment := range m.attachments {
+		if attachment.MessageId == nil {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	return attachments, nil
+}
+
+func (m *AttachmentsRepositoryMock) DeleteAttachments(ctx context.Context, attachmentIds []uint) error {
+	for _, id := range attachmentIds {
+		delete(m.attachments, id)
+	}
+
+	return nil
+}
+
+func (m *BlobStoreMock) ResetState() {
+	m.blobs = map[string][]byte{}
+}
+
+func (m *BlobStoreMock) Save(ctx context.Context, blobId string, content io.Reader) error {
+	blob, err := ioutil.ReadAll(content)
+	if err != nil {
+		return err
+	}
+
+	m.blobs[blobId] = blob
+	return nil
+}
+
+func (m *BlobStoreMock) Open(ctx context.Context, blobId string) (io.ReadCloser, error) {
+	blob, found := m.blobs[blobId]
+	if !found {
+		return nil, internal_errors.UnableToFindBlob
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(blob)), nil
+}
+
+func (m *BlobStoreMock) Delete(ctx context.Context, blobId string) error {
+	delete(m.blobs, blobId)
+	return nil
+}
+
+func (m *BlobStoreMock) Count() int {
+	return len(m.blobs)
+}
+
+func GetPNGContent() []byte {
+	var content bytes.Buffer
+	_ = png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 8, 8)))
+	return content.Bytes()
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"internal_errors"
 	"mock/repositories"
@@ -33,7 +34,7 @@ func (c *CredentialsMock) ResetState() {
 	c.Users = allUsersCredentials()
 }
 
-func (c *CredentialsMock) CreateUser(user models.UserCredentials) error {
+func (c *CredentialsMock) CreateUser(ctx context.Context, user models.UserCredentials) error {
 	if c.HasEmail(user.Email) {
 		return internal_errors.UnableToRegisterUserEmailExists
 	} else if user.Email == BadUser.Email {
@@ -54,7 +55,7 @@ func (c *CredentialsMock) HasEmail(email string) bool {
 	return false
 }
 
-func (c *CredentialsMock) GetUserIdByCredentials(user models.UserCredentials) (uint, error) {
+func (c *CredentialsMock) GetUserIdByCredentials(ctx context.Context, user models.UserCredentials) (uint, error) {
 	if user.Email == BadUser.Email {
 		return 0, someInternalError
 	}
@@ -68,7 +69,7 @@ func (c *CredentialsMock) GetUserIdByCredentials(user models.UserCredentials) (u
 	return 0, internal_errors.UnableToLoginUserNotFound
 }
 
-func (c *CredentialsMock) UpdateUserPassword(user models.UserCredentials) error {
+func (c *CredentialsMock) UpdateUserPassword(ctx context.Context, user models.UserCredentials) error {
 	if user.Email == BadUser.Email {
 		return someInternalError
 	} else if user.Password == utils.GetHash(user.Email+BadUser.Password) {
@@ -85,7 +86,7 @@ func (c *CredentialsMock) UpdateUserPassword(user models.UserCredentials) error
 	return internal_errors.UnableToChangePasswordUserNotFound
 }
 
-func (c *CredentialsMock) GetUserEmail(userId uint) (string, error) {
+func (c *CredentialsMock) GetUserEmail(ctx context.Context, userId uint) (string, error) {
 	if userId == BadUserId {
 		return "", someInternalError
 	}
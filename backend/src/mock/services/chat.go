@@ -1,29 +1,46 @@
 package services
 
 import (
+	"context"
 	"internal_errors"
 	"mock/repositories"
 	"models"
+	"sort"
 )
 
 type ChatRepositoryMock struct {
 	meetingIdToChat map[uint]models.Chat
-	userIdToChats   map[uint][]models.Chat
+	userIdToChats   map[uint][]models.UserChat
+	usersToChat     map[[2]uint]models.Chat
+	requestChats    map[[2]uint]bool
+	chatsMembers    map[[2]uint]bool
 }
 
 const BadChatId uint = 0
 
+var (
+	// archived chat and its message are known only to mocks of repositories
+	ArchivedChatId        = repositories.NotExistsChatId + 1
+	ArchivedChatMessageId = repositories.GetNotExistsMessageId() + 1
+)
+
 var ChatRepository = ChatRepositoryMock{
 	meetingIdToChat: getMeetingIdToChat(),
 	userIdToChats:   getUserIdToChats(),
+	usersToChat:     getUsersToDirectChat(),
+	requestChats:    getOpenedRequestChats(),
+	chatsMembers:    getChatsMembers(),
 }
 
 func (m *ChatRepositoryMock) ResetState() {
 	m.meetingIdToChat = getMeetingIdToChat()
 	m.userIdToChats = getUserIdToChats()
+	m.usersToChat = getUsersToDirectChat()
+	m.requestChats = getOpenedRequestChats()
+	m.chatsMembers = getChatsMembers()
 }
 
-func (m *ChatRepositoryMock) GetMeetingChat(meetingId uint) (models.Chat, error) {
+func (m *ChatRepositoryMock) GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error) {
 	if meetingId == repositories.GetNotExistsMeetingId() {
 		return models.Chat{}, internal_errors.UnableToFindChatByMeetingId
 	} else if meetingId == BadMeetingId {
@@ -33,7 +50,7 @@ func (m *ChatRepositoryMock) GetMeetingChat(meetingId uint) (models.Chat, error)
 	return m.meetingIdToChat[meetingId], nil
 }
 
-func (m *ChatRepositoryMock) GetUserChats(userId uint) ([]models.Chat, error) {
+func (m *ChatRepositoryMock) GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error) {
 	if userId == BadUserId {
 		return nil, someInternalError
 	}
@@ -41,7 +58,25 @@ func (m *ChatRepositoryMock) GetUserChats(userId uint) ([]models.Chat, error) {
 	return m.userIdToChats[userId], nil
 }
 
-func (m *ChatRepositoryMock) CreateChat(meetingId uint, chatType string) error {
+func (m *ChatRepositoryMock) GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error) {
+	if chatId == BadChatId {
+		return nil, someInternalError
+	}
+
+	members := []uint{}
+	for _, userId := range usersIds {
+		if m.chatsMembers[[2]uint{chatId, userId}] {
+			members = append(members, userId)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i] < members[j]
+	})
+
+	return members, nil
+}
+
+func (m *ChatRepositoryMock) CreateChat(ctx context.Context, meetingId uint, chatType string) error {
 	if meetingId == BadMeetingId {
 		return someInternalError
 	}
@@ -55,7 +90,43 @@ func (m *ChatRepositoryMock) CreateChat(meetingId uint, chatType string) error {
 	return nil
 }
 
-func (m *ChatRepositoryMock) SetChatStatus(chatId uint, status string) error {
+func (m *ChatRepositoryMock) CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	if meetingId == BadMeetingId {
+		return someInternalError
+	} else if meetingId == repositories.GetNotExistsMeetingId() {
+		return internal_errors.UnableToFindMeetingById
+	} else if applicantId == repositories.GetNotExistsUserId() {
+		return internal_errors.UnableToFindUserById
+	}
+
+	key := [2]uint{meetingId, applicantId}
+	if m.requestChats[key] {
+		return internal_errors.MeetingRequestChatAlreadyExists
+	}
+
+	m.requestChats[key] = true
+	return nil
+}
+
+func (m *ChatRepositoryMock) CloseMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	if meetingId == BadMeetingId {
+		return someInternalError
+	}
+
+	key := [2]uint{meetingId, applicantId}
+	if !m.requestChats[key] {
+		return internal_errors.UnableToFindMeetingRequestChat
+	}
+
+	delete(m.requestChats, key)
+	return nil
+}
+
+func (m *ChatRepositoryMock) HasOpenedRequestChat(meetingId, applicantId uint) bool {
+	return m.requestChats[[2]uint{meetingId, applicantId}]
+}
+
+func (m *ChatRepositoryMock) SetChatStatus(ctx context.Context, chatId uint, status string) error {
 	if chatId == repositories.NotExistsChatId {
 		return internal_errors.UnableToFindChatById
 	} else if chatId == BadChatId {
@@ -75,6 +146,94 @@ func (m *ChatRepositoryMock) SetChatStatus(chatId uint, status string) error {
 	return nil
 }
 
+func (m *ChatRepositoryMock) GetDirectChat(ctx context.Context, firstUserId, secondUserId uint) (models.Chat, error) {
+	if firstUserId == BadUserId || secondUserId == BadUserId {
+		return models.Chat{}, someInternalError
+	}
+
+	chat, found := m.usersToChat[directChatKey(firstUserId, secondUserId)]
+	if !found {
+		return models.Chat{}, internal_errors.UnableToFindDirectChat
+	}
+
+	return chat, nil
+}
+
+func (m *ChatRepositoryMock) CreateDirectChat(
+	ctx context.Context, firstUserId, secondUserId uint,
+) (models.Chat, error) {
+	if firstUserId == BadUserId || secondUserId == BadUserId {
+		return models.Chat{}, someInternalError
+	} else if firstUserId == repositories.GetNotExistsUserId() || secondUserId == repositories.GetNotExistsUserId() {
+		return models.Chat{}, internal_errors.UnableToFindUserById
+	}
+
+	key := directChatKey(firstUserId, secondUserId)
+	if _, found := m.usersToChat[key]; found {
+		return models.Chat{}, internal_errors.DirectChatAlreadyExists
+	}
+
+	chat := models.Chat{
+		Id:   repositories.NotExistsChatId,
+		Type: repositories.DirectType,
+	}
+	m.usersToChat[key] = chat
+	return chat, nil
+}
+
+func (m *ChatRepositoryMock) GetChatMeetingAdminId(ctx context.Context, chatId uint) (uint, error) {
+	if chatId == repositories.NotExistsChatId {
+		return 0, internal_errors.UnableToFindChatById
+	} else if chatId == BadChatId {
+		return 0, someInternalError
+	}
+
+	return repositories.GetChatMeetingAdminId(chatId), nil
+}
+
+func (m *ChatRepositoryMock) ExportMessages(
+	ctx context.Context, chatId uint, handle func(message models.TranscriptMessage) error) error {
+	for _, message := range repositories.GetAllMessages() {
+		if message.ChatId != chatId {
+			continue
+		}
+
+		err := handle(models.TranscriptMessage{
+			Id:             message.Id,
+			SenderId:       message.SenderId,
+			SenderNickname: repositories.GetUserNickname(message.SenderId),
+			Text:           message.Text,
+			SendingTime:    message.SendingTime,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func directChatKey(firstUserId, secondUserId uint) [2]uint {
+	if firstUserId > secondUserId {
+		return [2]uint{secondUserId, firstUserId}
+	}
+
+	return [2]uint{firstUserId, secondUserId}
+}
+
+func getUsersToDirectChat() map[[2]uint]models.Chat {
+	usersToChat := map[[2]uint]models.Chat{}
+	for chatIdx, chat := range repositories.DirectChats {
+		firstUserId, secondUserId := uint(chat["first_user_id"].(int)), uint(chat["second_user_id"].(int))
+		usersToChat[directChatKey(firstUserId, secondUserId)] = models.Chat{
+			Id:   repositories.FirstDirectChatId + uint(chatIdx),
+			Type: repositories.DirectType,
+		}
+	}
+
+	return usersToChat
+}
+
 func getMeetingIdToChat() map[uint]models.Chat {
 	meetingIdToChat := map[uint]models.Chat{}
 	for chatId, chat := range repositories.MeetingChats {
@@ -91,22 +250,57 @@ func getMeetingIdToChat() map[uint]models.Chat {
 	return meetingIdToChat
 }
 
-func getUserIdToChats() map[uint][]models.Chat {
-	userIdToChats := map[uint][]models.Chat{}
+func getOpenedRequestChats() map[[2]uint]bool {
+	requestChats := map[[2]uint]bool{}
+	for _, chat := range repositories.MeetingChats {
+		if applicantId, isRequestChat := chat["applicant_id"].(int); isRequestChat {
+			requestChats[[2]uint{uint(chat["meeting_id"].(int)), uint(applicantId)}] = true
+		}
+	}
+
+	return requestChats
+}
+
+func getUserIdToChats() map[uint][]models.UserChat {
+	userIdToChats := map[uint][]models.UserChat{}
 	for _, message := range repositories.ChatsMessages {
 		userId := uint(message["sender_id"].(int))
 		_, userIdFound := userIdToChats[userId]
 
 		if userIdFound {
-			userIdToChats[userId] = append(userIdToChats[userId], models.Chat{
-				Id: uint(message["chat_id"].(int)),
+			userIdToChats[userId] = append(userIdToChats[userId], models.UserChat{
+				Chat: models.Chat{Id: uint(message["chat_id"].(int))},
 			})
 		} else {
-			userIdToChats[userId] = []models.Chat{
-				{Id: uint(message["chat_id"].(int))},
+			userIdToChats[userId] = []models.UserChat{
+				{Chat: models.Chat{Id: uint(message["chat_id"].(int))}},
 			}
 		}
 	}
 
 	return userIdToChats
 }
+
+// members are the same as in chats_members table filled by mock of repositories
+func getChatsMembers() map[[2]uint]bool {
+	chatsMembers := map[[2]uint]bool{}
+	for chatIdx, chat := range repositories.MeetingChats {
+		chatId, meeting := uint(chatIdx+1), repositories.Meetings[chat["meeting_id"].(int)-1]
+		if applicantId, isRequestChat := chat["applicant_id"].(int); isRequestChat {
+			chatsMembers[[2]uint{chatId, uint(meeting["admin_id"].(int))}] = true
+			chatsMembers[[2]uint{chatId, uint(applicantId)}] = true
+			continue
+		}
+
+		for _, userId := range meeting["user_ids"].([]uint) {
+			chatsMembers[[2]uint{chatId, userId}] = true
+		}
+	}
+	for chatIdx, chat := range repositories.DirectChats {
+		chatId := repositories.FirstDirectChatId + uint(chatIdx)
+		chatsMembers[[2]uint{chatId, uint(chat["first_user_id"].(int))}] = true
+		chatsMembers[[2]uint{chatId, uint(chat["second_user_id"].(int))}] = true
+	}
+
+	return chatsMembers
+}
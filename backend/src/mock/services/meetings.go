@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/lib/pq"
 	"internal_errors"
 	"mock/repositories"
@@ -54,7 +55,9 @@ func (m *MeetingsRepositoryMock) ResetState() {
 	m.MeetingsUsers = allMeetingsUsers()
 }
 
-func (m *MeetingsRepositoryMock) GetFullMeetingInfo(meetingId uint) (models.PrivateMeeting, error) {
+func (m *MeetingsRepositoryMock) GetFullMeetingInfo(
+	ctx context.Context, meetingId uint,
+) (models.PrivateMeeting, error) {
 	if meetingId == BadMeetingId {
 		return models.PrivateMeeting{}, someInternalError
 	}
@@ -67,7 +70,7 @@ func (m *MeetingsRepositoryMock) GetFullMeetingInfo(meetingId uint) (models.Priv
 	return meetingInfo, nil
 }
 
-func (m *MeetingsRepositoryMock) GetPublicMeetings() ([]models.PublicMeeting, error) {
+func (m *MeetingsRepositoryMock) GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error) {
 	if m.Meetings == nil {
 		return nil, someInternalError
 	}
@@ -85,7 +88,7 @@ func (m *MeetingsRepositoryMock) GetPublicMeetings() ([]models.PublicMeeting, er
 }
 
 func (m *MeetingsRepositoryMock) GetExtendedMeetings(
-	data models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error) {
+	ctx context.Context, data models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error) {
 	userId := data.UserId
 	if userId == BadUserId {
 		return nil, someInternalError
@@ -104,7 +107,7 @@ func (m *MeetingsRepositoryMock) GetExtendedMeetings(
 	return meetings, nil
 }
 
-func (m *MeetingsRepositoryMock) CreateMeeting(adminId uint, settings models.AllSettings) error {
+func (m *MeetingsRepositoryMock) CreateMeeting(ctx context.Context, adminId uint, settings models.AllSettings) error {
 	if adminId == BadUserId {
 		return someInternalError
 	} else if adminId == repositories.GetNotExistsUserId() {
@@ -122,7 +125,7 @@ func (m *MeetingsRepositoryMock) CreateMeeting(adminId uint, settings models.All
 	return nil
 }
 
-func (m *MeetingsRepositoryMock) DeleteMeeting(meetingId uint) error {
+func (m *MeetingsRepositoryMock) DeleteMeeting(ctx context.Context, meetingId uint) error {
 	if meetingId == BadMeetingId {
 		return someInternalError
 	} else if meetingId == repositories.GetNotExistsMeetingId() {
@@ -133,7 +136,9 @@ func (m *MeetingsRepositoryMock) DeleteMeeting(meetingId uint) error {
 	return nil
 }
 
-func (m *MeetingsRepositoryMock) UpdateSettings(meetingId uint, settings models.AllSettings) error {
+func (m *MeetingsRepositoryMock) UpdateSettings(
+	ctx context.Context, meetingId uint, settings models.AllSettings,
+) error {
 	if meetingId == BadMeetingId {
 		return someInternalError
 	} else if meetingId == repositories.GetNotExistsMeetingId() {
@@ -149,7 +154,7 @@ func (m *MeetingsRepositoryMock) UpdateSettings(meetingId uint, settings models.
 	return nil
 }
 
-func (m *MeetingsRepositoryMock) AddUserToMeeting(meetingId, userId uint) error {
+func (m *MeetingsRepositoryMock) AddUserToMeeting(ctx context.Context, meetingId, userId uint) error {
 	if meetingId == BadMeetingId {
 		return someInternalError
 	}
@@ -168,7 +173,7 @@ func (m *MeetingsRepositoryMock) AddUserToMeeting(meetingId, userId uint) error
 	return internal_errors.UnableToFindMeetingById
 }
 
-func (m *MeetingsRepositoryMock) KickUserFromMeeting(meetingId, userId uint) error {
+func (m *MeetingsRepositoryMock) KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error {
 	if meetingId == BadMeetingId {
 		return someInternalError
 	}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"internal_errors"
 	"mock/repositories"
 	"models"
@@ -14,25 +15,41 @@ var (
 	MessagesMockRepository = MessagesRepositoryMock{
 		chatId2Messages: getChatIdToMessages(),
 	}
+	// recipient of this direct chat blocked sender, chat is known only to mocks of repositories
+	DeniedDirectChatId = ArchivedChatId + 1
 )
 
 func (m *MessagesRepositoryMock) ResetState() {
 	m.chatId2Messages = getChatIdToMessages()
 }
 
-func (m *MessagesRepositoryMock) Save(message models.Message) error {
+func (m *MessagesRepositoryMock) Save(ctx context.Context, message models.Message) (models.Message, error) {
 	if message.ChatId == repositories.NotExistsChatId {
-		return internal_errors.UnableToFindChatById
+		return models.Message{}, internal_errors.UnableToFindChatById
 	} else if message.SenderId == repositories.GetNotExistsUserId() {
-		return internal_errors.UnableToFindUserById
+		return models.Message{}, internal_errors.UnableToFindUserById
 	} else if message.ChatId == BadChatId {
-		return someInternalError
+		return models.Message{}, someInternalError
+	} else if message.ChatId == ArchivedChatId {
+		return models.Message{}, internal_errors.ChatIsArchived
+	} else if message.ChatId == DeniedDirectChatId {
+		return models.Message{}, internal_errors.DirectMessagesNotAllowed
+	} else if message.Announcement && message.SenderId != repositories.GetChatMeetingAdminId(message.ChatId) {
+		return models.Message{}, internal_errors.AnnouncementNotAllowed
+	} else if message.ReplyTo != nil && !m.hasChatMessage(message.ChatId, *message.ReplyTo) {
+		return models.Message{}, internal_errors.UnableToFindReplyMessage
+	}
+	for _, attachmentId := range message.AttachmentIds {
+		if attachmentId == NotExistsAttachmentId {
+			return models.Message{}, internal_errors.UnableToFindAttachment
+		}
 	}
 
-	return nil
+	message.Id = uint(len(repositories.ChatsMessages) + 1)
+	return message, nil
 }
 
-func (m *MessagesRepositoryMock) GetLastMessages(chatId, count uint) ([]models.Message, error) {
+func (m *MessagesRepositoryMock) GetLastMessages(ctx context.Context, chatId, count, _ uint) ([]models.Message, error) {
 	if chatId == repositories.NotExistsChatId {
 		return nil, internal_errors.UnableToFindChatById
 	} else if chatId == BadChatId {
@@ -48,8 +65,33 @@ func (m *MessagesRepositoryMock) GetLastMessages(chatId, count uint) ([]models.M
 }
 
 func (m *MessagesRepositoryMock) GetLastMessagesAfter(
-	chatId, _, count uint) ([]models.Message, error) {
-	return m.GetLastMessages(chatId, count)
+	ctx context.Context, chatId, _, count, viewerId uint) ([]models.Message, error) {
+	return m.GetLastMessages(ctx, chatId, count, viewerId)
+}
+
+// mock messages have no replies, so thread contains only root message
+func (m *MessagesRepositoryMock) GetThread(ctx context.Context, rootId, _, _, _ uint) (models.Thread, error) {
+	if rootId == BadMessageId {
+		return models.Thread{}, someInternalError
+	}
+
+	for _, message := range repositories.GetAllMessages() {
+		if message.Id == rootId {
+			return models.Thread{Root: message, Replies: []models.Message{}}, nil
+		}
+	}
+
+	return models.Thread{}, internal_errors.UnableToFindMessageById
+}
+
+func (m *MessagesRepositoryMock) hasChatMessage(chatId, messageId uint) bool {
+	for _, message := range m.chatId2Messages[chatId] {
+		if message.Id == messageId {
+			return true
+		}
+	}
+
+	return false
 }
 
 func GetMessageWithBadChatId() models.Message {
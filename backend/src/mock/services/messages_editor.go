@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"internal_errors"
+	"mock/repositories"
+	"models"
+	"sort"
+	"time"
+)
+
+type MessagesEditorRepositoryMock struct {
+	messages map[uint]models.ManagedMessage
+	edits    map[uint][]models.MessageEdit
+}
+
+const BadMessageId uint = 0
+
+var (
+	// message of first chat that was sent before edit window
+	ExpiredMessageId uint = 5
+	EditWindow            = time.Hour
+
+	MessagesEditorRepository = MessagesEditorRepositoryMock{
+		messages: getManagedMessages(),
+		edits:    map[uint][]models.MessageEdit{},
+	}
+)
+
+func (m *MessagesEditorRepositoryMock) ResetState() {
+	m.messages = getManagedMessages()
+	m.edits = map[uint][]models.MessageEdit{}
+}
+
+func (m *MessagesEditorRepositoryMock) GetManagedMessage(
+	ctx context.Context, messageId uint,
+) (models.ManagedMessage, error) {
+	if messageId == BadMessageId {
+		return models.ManagedMessage{}, someInternalError
+	}
+
+	message, found := m.messages[messageId]
+	if !found {
+		return models.ManagedMessage{}, internal_errors.UnableToFindMessageById
+	}
+
+	return message, nil
+}
+
+func (m *MessagesEditorRepositoryMock) EditMessage(
+	ctx context.Context, messageId uint, text string,
+) (models.Message, error) {
+	message, err := m.GetManagedMessage(ctx, messageId)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	now := time.Now()
+	m.edits[messageId] = append(m.edits[messageId], models.MessageEdit{
+		MessageId: messageId, Text: message.Text, EditedAt: now,
+	})
+	message.Text, message.EditedAt = text, &now
+	m.messages[messageId] = message
+
+	return message.Message, nil
+}
+
+func (m *MessagesEditorRepositoryMock) DeleteMessage(ctx context.Context, messageId uint) (models.Message, error) {
+	message, err := m.GetManagedMessage(ctx, messageId)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	now := time.Now()
+	message.Text, message.DeletedAt = "", &now
+	m.messages[messageId] = message
+
+	return message.Message, nil
+}
+
+func (m *MessagesEditorRepositoryMock) GetMessageEdits(
+	ctx context.Context, messageId uint,
+) ([]models.MessageEdit, error) {
+	if messageId == BadMessageId {
+		return nil, someInternalError
+	}
+
+	return m.edits[messageId], nil
+}
+
+func (m *MessagesEditorRepositoryMock) SetMessagePinned(
+	ctx context.Context, messageId uint, pinned bool,
+) (models.Message, error) {
+	message, err := m.GetManagedMessage(ctx, messageId)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	message.PinnedAt = nil
+	if pinned {
+		now := time.Now()
+		message.PinnedAt = &now
+	}
+	m.messages[messageId] = message
+
+	return message.Message, nil
+}
+
+func (m *MessagesEditorRepositoryMock) GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error) {
+	if chatId == BadChatId {
+		return nil, someInternalError
+	}
+
+	var messages []models.Message
+	for _, message := range m.messages {
+		if message.ChatId == chatId && message.PinnedAt != nil {
+			messages = append(messages, message.Message)
+		}
+	}
+	sort.Slice(messages, func(i, j int) bool { return messages[i].PinnedAt.After(*messages[j].PinnedAt) })
+
+	return messages, nil
+}
+
+func getManagedMessages() map[uint]models.ManagedMessage {
+	messages := map[uint]models.ManagedMessage{}
+	for _, message := range repositories.GetAllMessages() {
+		managedMessage := models.ManagedMessage{
+			Message:        message,
+			MeetingAdminId: repositories.GetChatMeetingAdminId(message.ChatId),
+		}
+		if message.Id == ExpiredMessageId {
+			managedMessage.AgeSeconds = int64(2 * EditWindow / time.Second)
+		}
+
+		messages[message.Id] = managedMessage
+	}
+	messages[ArchivedChatMessageId] = models.ManagedMessage{
+		Message:      models.Message{Id: ArchivedChatMessageId, ChatId: ArchivedChatId, SenderId: 1, Text: "archived"},
+		ChatArchived: true,
+	}
+
+	return messages
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+	"models"
+)
+
+type ModerationRepositoryMock struct {
+	Flags []models.ContentFlag
+}
+
+var ModerationRepository ModerationRepositoryMock
+
+func (m *ModerationRepositoryMock) ResetState() {
+	m.Flags = nil
+}
+
+func (m *ModerationRepositoryMock) FlagContent(ctx context.Context, flag models.ContentFlag) error {
+	if flag.SourceId == BadUserId {
+		return someInternalError
+	}
+
+	m.Flags = append(m.Flags, flag)
+	return nil
+}
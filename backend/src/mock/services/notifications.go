@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"mock/repositories"
+	"models"
+)
+
+type NotificationsRepositoryMock struct{}
+
+var NotificationsRepository NotificationsRepositoryMock
+
+// every message of chat is considered as announcement for all members except sender
+func (m NotificationsRepositoryMock) GetNotifications(
+	ctx context.Context, userId, count uint,
+) ([]models.Notification, error) {
+	if userId == BadUserId {
+		return nil, someInternalError
+	}
+
+	messages := repositories.GetAllMessages()
+	var notifications []models.Notification
+	for i := len(messages) - 1; i >= 0 && len(notifications) < int(count); i-- {
+		message := messages[i]
+		if message.SenderId == userId {
+			continue
+		}
+
+		notifications = append(notifications, models.Notification{
+			Id:          message.Id,
+			MessageId:   message.Id,
+			ChatId:      message.ChatId,
+			SenderId:    message.SenderId,
+			Text:        message.Text,
+			SendingTime: message.SendingTime,
+		})
+	}
+
+	return notifications, nil
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"github.com/lib/pq"
 	"internal_errors"
@@ -25,7 +26,9 @@ func (m *MeetingsSettingsRepositoryMock) ResetState() {
 	m.meetings = allMeetingsSettings()
 }
 
-func (m *MeetingsSettingsRepositoryMock) GetMeetingSettings(meetingId uint) (models.ParticipationMeetingSettings, error) {
+func (m *MeetingsSettingsRepositoryMock) GetMeetingSettings(
+	ctx context.Context, meetingId uint,
+) (models.ParticipationMeetingSettings, error) {
 	if meetingId == BadMeetingId {
 		return models.ParticipationMeetingSettings{}, someInternalError
 	} else if meetingId == repositories.GetNotExistsMeetingId() {
@@ -35,7 +38,9 @@ func (m *MeetingsSettingsRepositoryMock) GetMeetingSettings(meetingId uint) (mod
 	return m.meetings[meetingId], nil
 }
 
-func (m *MeetingsSettingsRepositoryMock) GetNearMeetings(data models.UserTimeCheckData) ([]models.TimeMeetingParameters, error) {
+func (m *MeetingsSettingsRepositoryMock) GetNearMeetings(
+	ctx context.Context, data models.UserTimeCheckData,
+) ([]models.TimeMeetingParameters, error) {
 	if data.MeetingId == BadMeetingId {
 		return nil, someInternalError
 	} else if data.MeetingId == repositories.GetNotExistsMeetingId() {
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"internal_errors"
+	"mock/repositories"
+	"models"
+)
+
+type ReactionsRepositoryMock struct {
+	// message id -> emoji -> ids of users
+	reactions map[uint]map[string]map[uint]bool
+}
+
+var ReactionsRepository = ReactionsRepositoryMock{
+	reactions: map[uint]map[string]map[uint]bool{},
+}
+
+func (m *ReactionsRepositoryMock) ResetState() {
+	m.reactions = map[uint]map[string]map[uint]bool{}
+}
+
+func (m *ReactionsRepositoryMock) AddReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	change, err := m.getChange(reaction)
+	if err != nil {
+		return models.ReactionChange{}, err
+	}
+
+	if m.reactions[reaction.MessageId] == nil {
+		m.reactions[reaction.MessageId] = map[string]map[uint]bool{}
+	}
+	if m.reactions[reaction.MessageId][reaction.Emoji] == nil {
+		m.reactions[reaction.MessageId][reaction.Emoji] = map[uint]bool{}
+	}
+	m.reactions[reaction.MessageId][reaction.Emoji][reaction.UserId] = true
+	change.Count = uint(len(m.reactions[reaction.MessageId][reaction.Emoji]))
+
+	return change, nil
+}
+
+func (m *ReactionsRepositoryMock) RemoveReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	change, err := m.getChange(reaction)
+	if err != nil {
+		return models.ReactionChange{}, err
+	}
+
+	delete(m.reactions[reaction.MessageId][reaction.Emoji], reaction.UserId)
+	change.Count = uint(len(m.reactions[reaction.MessageId][reaction.Emoji]))
+
+	return change, nil
+}
+
+func (m *ReactionsRepositoryMock) getChange(reaction models.MessageReaction) (models.ReactionChange, error) {
+	if reaction.UserId == BadUserId {
+		return models.ReactionChange{}, someInternalError
+	} else if reaction.UserId == repositories.GetNotExistsUserId() {
+		return models.ReactionChange{}, internal_errors.UnableToFindUserById
+	} else if reaction.MessageId == ArchivedChatMessageId {
+		return models.ReactionChange{}, internal_errors.ChatIsArchived
+	}
+
+	for _, message := range repositories.GetAllMessages() {
+		if message.Id == reaction.MessageId {
+			return models.ReactionChange{MessageReaction: reaction, ChatId: message.ChatId}, nil
+		}
+	}
+
+	return models.ReactionChange{}, internal_errors.UnableToFindMessageById
+}
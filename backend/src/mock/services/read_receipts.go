@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"internal_errors"
+	"mock/repositories"
+	"models"
+)
+
+type ReadReceiptsRepositoryMock struct {
+	cursors map[[2]uint]uint
+}
+
+var ReadReceiptsRepository = ReadReceiptsRepositoryMock{
+	cursors: map[[2]uint]uint{},
+}
+
+func (m *ReadReceiptsRepositoryMock) ResetState() {
+	m.cursors = map[[2]uint]uint{}
+}
+
+func (m *ReadReceiptsRepositoryMock) MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error {
+	if receipt.UserId == BadUserId {
+		return someInternalError
+	}
+
+	for _, message := range repositories.GetAllMessages() {
+		if message.Id == receipt.MessageId && message.ChatId == receipt.ChatId {
+			key := [2]uint{receipt.ChatId, receipt.UserId}
+			if m.cursors[key] < receipt.MessageId {
+				m.cursors[key] = receipt.MessageId
+			}
+			return nil
+		}
+	}
+
+	return internal_errors.UnableToFindChatMessage
+}
+
+// user is considered as member of chats where he has sent messages
+func (m *ReadReceiptsRepositoryMock) GetUnreadCounts(
+	ctx context.Context, userId uint,
+) ([]models.ChatUnreadCount, error) {
+	if userId == BadUserId {
+		return nil, someInternalError
+	}
+
+	var (
+		counts      []models.ChatUnreadCount
+		chatToIndex = map[uint]int{}
+	)
+	messages := repositories.GetAllMessages()
+	for _, message := range messages {
+		if _, found := chatToIndex[message.ChatId]; message.SenderId == userId && !found {
+			chatToIndex[message.ChatId] = len(counts)
+			counts = append(counts, models.ChatUnreadCount{ChatId: message.ChatId})
+		}
+	}
+	for _, message := range messages {
+		index, found := chatToIndex[message.ChatId]
+		if found && message.SenderId != userId && message.Id > m.cursors[[2]uint{message.ChatId, userId}] {
+			counts[index].Count++
+		}
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"mock/repositories"
+	"models"
+	"strings"
+)
+
+type MessagesSearchRepositoryMock struct{}
+
+var MessagesSearchRepository MessagesSearchRepositoryMock
+
+// user is considered as member of chats where he has sent messages, text is searched as substring
+func (m MessagesSearchRepositoryMock) SearchMessages(
+	ctx context.Context, query models.SearchQuery,
+) ([]models.SearchHit, error) {
+	if query.UserId == BadUserId {
+		return nil, someInternalError
+	}
+
+	messages := repositories.GetAllMessages()
+	userChats := map[uint]bool{}
+	for _, message := range messages {
+		if message.SenderId == query.UserId {
+			userChats[message.ChatId] = true
+		}
+	}
+
+	var hits []models.SearchHit
+	text := strings.ToLower(query.Text)
+	for i := len(messages) - 1; i >= 0 && len(hits) < int(query.Count); i-- {
+		message := messages[i]
+		if !userChats[message.ChatId] ||
+			query.ChatId != 0 && message.ChatId != query.ChatId ||
+			query.BeforeId != 0 && message.Id >= query.BeforeId ||
+			!strings.Contains(strings.ToLower(message.Text), text) {
+			continue
+		}
+
+		hits = append(hits, models.SearchHit{
+			MessageId:   message.Id,
+			ChatId:      message.ChatId,
+			SenderId:    message.SenderId,
+			SendingTime: message.SendingTime,
+			Context:     message.Text,
+		})
+	}
+
+	return hits, nil
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"internal_errors"
 	"mock/repositories"
 	"models"
@@ -23,7 +24,7 @@ func (u *UsersSettingsRepositoryMock) ResetState() {
 	u.Settings = allUsersSettings()
 }
 
-func (u *UsersSettingsRepositoryMock) GetUserSettings(userId uint) (models.FullUserInfo, error) {
+func (u *UsersSettingsRepositoryMock) GetUserSettings(ctx context.Context, userId uint) (models.FullUserInfo, error) {
 	if userId == BadUserId {
 		return models.FullUserInfo{}, someInternalError
 	}
@@ -36,7 +37,9 @@ func (u *UsersSettingsRepositoryMock) GetUserSettings(userId uint) (models.FullU
 	return userInfo, nil
 }
 
-func (u *UsersSettingsRepositoryMock) UpdateUserSettings(userId uint, info models.UserSettings) error {
+func (u *UsersSettingsRepositoryMock) UpdateUserSettings(
+	ctx context.Context, userId uint, info models.UserSettings,
+) error {
 	if userId == BadUserId {
 		return someInternalError
 	}
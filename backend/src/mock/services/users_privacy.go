@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"internal_errors"
+	"mock/repositories"
+)
+
+type UsersPrivacyRepositoryMock struct {
+	blocks                map[[2]uint]bool
+	directMessagesAllowed map[uint]bool
+}
+
+var UsersPrivacyRepository = UsersPrivacyRepositoryMock{
+	blocks:                getUsersBlocks(),
+	directMessagesAllowed: map[uint]bool{},
+}
+
+func (m *UsersPrivacyRepositoryMock) ResetState() {
+	m.blocks = getUsersBlocks()
+	m.directMessagesAllowed = map[uint]bool{}
+}
+
+func (m *UsersPrivacyRepositoryMock) BlockUser(ctx context.Context, userId, blockedUserId uint) error {
+	if userId == BadUserId {
+		return someInternalError
+	} else if blockedUserId == repositories.GetNotExistsUserId() {
+		return internal_errors.UnableToFindUserById
+	}
+
+	m.blocks[[2]uint{userId, blockedUserId}] = true
+	return nil
+}
+
+func (m *UsersPrivacyRepositoryMock) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	if userId == BadUserId {
+		return someInternalError
+	}
+
+	delete(m.blocks, [2]uint{userId, blockedUserId})
+	return nil
+}
+
+func (m *UsersPrivacyRepositoryMock) SetDirectMessagesAllowed(ctx context.Context, userId uint, allowed bool) error {
+	if userId == BadUserId {
+		return someInternalError
+	} else if userId == repositories.GetNotExistsUserId() {
+		return internal_errors.UnableToFindUserById
+	}
+
+	m.directMessagesAllowed[userId] = allowed
+	return nil
+}
+
+func (m *UsersPrivacyRepositoryMock) DirectMessagesAllowed(
+	ctx context.Context, senderId, recipientId uint,
+) (bool, error) {
+	if senderId == BadUserId {
+		return false, someInternalError
+	}
+
+	allowed, found := m.directMessagesAllowed[recipientId]
+	if found && !allowed {
+		return false, nil
+	}
+
+	return !m.blocks[[2]uint{senderId, recipientId}] && !m.blocks[[2]uint{recipientId, senderId}], nil
+}
+
+func getUsersBlocks() map[[2]uint]bool {
+	blocks := map[[2]uint]bool{}
+	for _, block := range repositories.UsersBlocks {
+		blocks[[2]uint{uint(block["user_id"].(int)), uint(block["blocked_user_id"].(int))}] = true
+	}
+
+	return blocks
+}
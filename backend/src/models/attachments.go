@@ -0,0 +1,44 @@
+package models
+
+import (
+	"io"
+	"time"
+)
+
+type (
+	Attachment struct {
+		Id         uint `db:"id" json:"id"`
+		UploaderId uint `db:"uploader_id" json:"uploader_id"`
+		// nil until message with attachment is sent
+		MessageId    *uint     `db:"message_id" json:"message_id"`
+		FileName     string    `db:"file_name" json:"file_name"`
+		MimeType     string    `db:"mime_type" json:"mime_type"`
+		Size         int64     `db:"size" json:"size"`
+		BlobId       string    `db:"blob_id" json:"-"`
+		HasThumbnail bool      `db:"has_thumbnail" json:"has_thumbnail"`
+		CreatedAt    time.Time `db:"created_at" json:"created_at"`
+		// chat of sent message, it is filled only when single attachment is got
+		ChatId *uint `db:"chat_id" json:"-"`
+	}
+
+	AttachmentUpload struct {
+		UploaderId uint
+		FileName   string
+		Content    io.Reader
+	}
+
+	// content must be closed by receiver
+	AttachmentContent struct {
+		FileName string
+		MimeType string
+		Content  io.ReadCloser
+	}
+
+	AttachmentLimits struct {
+		// in bytes
+		MaxSize      int64
+		AllowedTypes []string
+		// time in which file of max size must be uploaded
+		UploadTimeout time.Duration
+	}
+)
@@ -4,6 +4,18 @@ type (
 	ErrorResponse struct {
 		Status      string `json:"status"`
 		ErrorDetail string `json:"error_detail"`
+		// human readable text of error in language of request
+		Message string `json:"message"`
+		// only for validation errors
+		Errors []FieldError `json:"errors,omitempty"`
+	}
+
+	// field is name of request field in JSON
+	FieldError struct {
+		Field   string                 `json:"field"`
+		Code    string                 `json:"code"`
+		Params  map[string]interface{} `json:"params,omitempty"`
+		Message string                 `json:"message"`
 	}
 
 	DefaultResponse struct {
@@ -61,10 +73,25 @@ type (
 		Settings  AllSettings `json:"settings"`
 	}
 
+	BlockUserRequest struct {
+		UserId        uint `json:"user_id"`
+		BlockedUserId uint `json:"blocked_user_id"`
+	}
+
+	UpdatePrivacyRequest struct {
+		UserId                uint `json:"user_id"`
+		DirectMessagesAllowed bool `json:"direct_messages_allowed"`
+	}
+
 	CloseChatRequest struct {
 		ChatId uint `json:"chat_id"`
 	}
 
+	ReopenChatRequest struct {
+		ChatId  uint `json:"chat_id"`
+		AdminId uint `json:"admin_id"`
+	}
+
 	MessagesRequest struct {
 		ChatId uint `json:"chat_id"`
 		Count  uint `json:"count"`
@@ -75,4 +102,21 @@ type (
 		MessageId uint `json:"message_id"`
 		Count     uint `json:"count"`
 	}
+
+	EditMessageRequest struct {
+		MessageId uint   `json:"message_id"`
+		UserId    uint   `json:"user_id"`
+		Text      string `json:"text"`
+	}
+
+	DeleteMessageRequest struct {
+		MessageId uint `json:"message_id"`
+		UserId    uint `json:"user_id"`
+	}
+
+	// only meeting admin can pin and unpin messages of meeting chat
+	PinMessageRequest struct {
+		MessageId uint `json:"message_id"`
+		UserId    uint `json:"user_id"`
+	}
 )
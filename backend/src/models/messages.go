@@ -2,6 +2,13 @@ package models
 
 import "time"
 
+// found words in context of search hit are wrapped in these markers instead of HTML tags,
+// so client must escape context before replacing markers with highlighting
+const (
+	SearchHighlightStart = "\x02"
+	SearchHighlightStop  = "\x03"
+)
+
 type (
 	Chat struct {
 		Id        uint      `db:"id"`
@@ -10,10 +17,160 @@ type (
 		CreatedAt time.Time `db:"created_at"`
 	}
 
+	UserChat struct {
+		Chat
+		// nil if nobody has written to chat yet
+		LastMessage *Message
+		UnreadCount uint
+	}
+
 	Message struct {
-		ChatId      uint      `db:"chat_id"`
-		Text        string    `db:"text"`
-		SendingTime time.Time `db:"sending_time"`
-		SenderId    uint      `db:"sender_id"`
+		Id          uint       `db:"id"`
+		ChatId      uint       `db:"chat_id"`
+		Text        string     `db:"text"`
+		SendingTime time.Time  `db:"sending_time"`
+		SenderId    uint       `db:"sender_id"`
+		EditedAt    *time.Time `db:"edited_at"`
+		// deleted message has empty text
+		DeletedAt *time.Time `db:"deleted_at"`
+		ReplyTo   *uint      `db:"reply_to"`
+		// id of the first message of replies chain
+		ThreadRootId *uint `db:"thread_root_id"`
+		// announcement can be sent only by meeting admin, chat members get notification about it
+		Announcement bool       `db:"announcement"`
+		PinnedAt     *time.Time `db:"pinned_at"`
+		// summary of replied message, filled only for messages history
+		Parent *QuotedMessage `db:"-"`
+		// ids of uploaded attachments, used only for sending message
+		AttachmentIds []uint       `db:"-"`
+		Attachments   []Attachment `db:"-"`
+		// used only for direct messages, chat will be found (or created) by sender and recipient ids
+		RecipientId uint `db:"-"`
+		// aggregated by emoji, filled only for messages history
+		Reactions []Reaction `db:"-"`
+	}
+
+	QuotedMessage struct {
+		Id       uint `db:"id" json:"id"`
+		SenderId uint `db:"sender_id" json:"sender_id"`
+		// beginning of replied message text
+		Text    string `db:"text" json:"text"`
+		Deleted bool   `db:"deleted" json:"deleted"`
+	}
+
+	Thread struct {
+		Root    Message   `json:"root"`
+		Replies []Message `json:"replies"`
+	}
+
+	Reaction struct {
+		Emoji       string `db:"emoji" json:"emoji"`
+		Count       uint   `db:"count" json:"count"`
+		ReactedByMe bool   `db:"reacted_by_me" json:"reacted_by_me"`
+	}
+
+	MessageReaction struct {
+		MessageId uint   `db:"message_id" json:"message_id"`
+		UserId    uint   `db:"user_id" json:"user_id"`
+		Emoji     string `db:"emoji" json:"emoji"`
+	}
+
+	// count of reactions with the same emoji after change
+	ReactionChange struct {
+		MessageReaction
+		ChatId uint `db:"chat_id" json:"chat_id"`
+		Count  uint `db:"count" json:"count"`
+	}
+
+	// only admin of meeting can export its chats
+	ChatExportRequest struct {
+		ChatId  uint
+		AdminId uint
+		// json, csv or text
+		Format string
+	}
+
+	// message of exported chat history, deleted messages have empty text
+	TranscriptMessage struct {
+		Id             uint       `db:"id" json:"id"`
+		SenderId       uint       `db:"sender_id" json:"sender_id"`
+		SenderNickname string     `db:"sender_nickname" json:"sender_nickname"`
+		Text           string     `db:"text" json:"text"`
+		SendingTime    time.Time  `db:"sending_time" json:"sending_time"`
+		EditedAt       *time.Time `db:"edited_at" json:"edited_at"`
+		DeletedAt      *time.Time `db:"deleted_at" json:"deleted_at"`
+		ReplyTo        *uint      `db:"reply_to" json:"reply_to"`
+	}
+
+	// messages are searched only in chats of user, chat id and before id are optional
+	SearchQuery struct {
+		UserId   uint
+		Text     string
+		ChatId   uint
+		BeforeId uint
+		Count    uint
+	}
+
+	// chat id and message id can be used to load history around found message
+	SearchHit struct {
+		MessageId   uint      `db:"message_id" json:"message_id"`
+		ChatId      uint      `db:"chat_id" json:"chat_id"`
+		SenderId    uint      `db:"sender_id" json:"sender_id"`
+		SendingTime time.Time `db:"sending_time" json:"sending_time"`
+		// fragments of text around found words, which are wrapped in highlight markers
+		Context string `db:"context" json:"context"`
+	}
+
+	// message with information required to check if user can change it
+	ManagedMessage struct {
+		Message
+		// 0 if message is not in meeting chat
+		MeetingAdminId uint  `db:"meeting_admin_id"`
+		AgeSeconds     int64 `db:"age_seconds"`
+		ChatArchived   bool  `db:"chat_archived"`
+	}
+
+	// notification about announcement in one of user chats
+	Notification struct {
+		Id          uint      `db:"id" json:"id"`
+		MessageId   uint      `db:"message_id" json:"message_id"`
+		ChatId      uint      `db:"chat_id" json:"chat_id"`
+		SenderId    uint      `db:"sender_id" json:"sender_id"`
+		Text        string    `db:"text" json:"text"`
+		SendingTime time.Time `db:"sending_time" json:"sending_time"`
+	}
+
+	MessageEdit struct {
+		MessageId uint      `db:"message_id" json:"message_id"`
+		Text      string    `db:"text" json:"text"`
+		EditedAt  time.Time `db:"edited_at" json:"edited_at"`
+	}
+
+	// read cursor of user in chat, all messages up to MessageId are considered read
+	ReadReceipt struct {
+		ChatId    uint `db:"chat_id" json:"chat_id"`
+		UserId    uint `db:"user_id" json:"user_id"`
+		MessageId uint `db:"message_id" json:"message_id"`
+	}
+
+	ChatUnreadCount struct {
+		ChatId uint `db:"chat_id" json:"chat_id"`
+		Count  uint `db:"count" json:"count"`
+	}
+
+	UnreadCounts struct {
+		Total uint              `json:"total"`
+		Chats []ChatUnreadCount `json:"chats"`
+	}
+
+	// zero rate or window disables corresponding limit
+	MessageRateLimits struct {
+		// messages per second
+		UserRate  float64
+		UserBurst int
+		ChatRate  float64
+		ChatBurst int
+		// the same text can not be sent to chat by the same user again during this time
+		DuplicateWindow time.Duration
 	}
 )
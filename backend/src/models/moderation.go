@@ -0,0 +1,24 @@
+package models
+
+type (
+	ContentFilterConfig struct {
+		// reject, mask or flag
+		Mode string `json:"mode"`
+		// lower case words by language, word ending with * matches all words with this prefix
+		Words map[string][]string `json:"words"`
+		// text with more links is considered as spam, negative value disables check
+		MaxLinks int `json:"max_links"`
+		// longest allowed sequence of the same character, zero disables check
+		MaxRepeatedChars int `json:"max_repeated_chars"`
+	}
+
+	// text that was saved despite of content filter violations and waits for moderator review
+	ContentFlag struct {
+		// message, meeting, meeting_settings or nickname
+		Kind string
+		// sender of message, admin of created meeting, updated meeting or user with nickname
+		SourceId uint
+		Text     string
+		Reasons  []string
+	}
+)
@@ -0,0 +1,23 @@
+package models
+
+type (
+	// lengths are counted in symbols, pattern is regular expression for the whole text
+	TextRule struct {
+		MinLength int    `json:"min_length"`
+		MaxLength int    `json:"max_length"`
+		Pattern   string `json:"pattern"`
+	}
+
+	// rules of text fields checked by validation proxies, zero values keep default rules
+	ValidationRules struct {
+		Nickname    TextRule `json:"nickname"`
+		Password    TextRule `json:"password"`
+		Name        TextRule `json:"name"`
+		Title       TextRule `json:"title"`
+		Description TextRule `json:"description"`
+		Message     TextRule `json:"message"`
+		SearchText  TextRule `json:"search_text"`
+		FileName    TextRule `json:"file_name"`
+		Emoji       TextRule `json:"emoji"`
+	}
+)
@@ -1,33 +1,93 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	"models"
 	"os"
 	"plugins/logger"
+	"plugins/tracer"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const (
-	defaultPort     = 8080
-	maxOpenConns    = 30
-	maxIdleConns    = 30
-	connMaxLifetime = time.Hour
+	defaultPort         = 8080
+	maxOpenConns        = 30
+	maxIdleConns        = 30
+	connMaxLifetime     = time.Hour
+	defaultQueryTimeout = 5 * time.Second
+
+	defaultMessageEditWindow = 15 * time.Minute
+
+	defaultMessageUserRate        = 1
+	defaultMessageUserBurst       = 5
+	defaultMessageChatRate        = 10
+	defaultMessageChatBurst       = 30
+	defaultMessageDuplicateWindow = 10 * time.Second
+
+	defaultContentFilterConfig       = "content_filter.json"
+	defaultContentFilterMaxLinks     = 3
+	defaultContentFilterMaxRepeating = 10
+
+	defaultValidationRulesConfig = "validation_rules.json"
+
+	defaultAttachmentsDir      = "attachments"
+	defaultAttachmentMaxSize   = 10 * 1024 * 1024
+	defaultAttachmentOrphanTTL = 24 * time.Hour
+	// upload timeout is sized to max size, so only uploads slower than this rate (bytes per second) are cut off
+	minAttachmentUploadRate    = 64 * 1024
+	minAttachmentUploadTimeout = 15 * time.Second
 )
 
+var defaultAttachmentTypes = []string{
+	"image/jpeg", "image/png", "image/gif", "image/webp", "application/pdf", "text/plain",
+}
+
 type AllConfigs struct {
-	DB             *sqlx.DB
+	LogLevel logger.Level
+	// name of exporter of spans, tracing is disabled if it is empty
+	TracingExporter string
+	DB              *sqlx.DB
+	// each query to DB is canceled after this time
+	QueryTimeout   time.Duration
 	CoderKey       string
 	CsrfPrivateKey string
 	Port           string
+	// time during which author can edit or delete message
+	MessageEditWindow time.Duration
+	MessageRateLimits models.MessageRateLimits
+	ContentFilter     models.ContentFilterConfig
+	ValidationRules   models.ValidationRules
+	AttachmentsDir    string
+	AttachmentLimits  models.AttachmentLimits
+	// not sent attachments are deleted after this time
+	AttachmentOrphanTTL time.Duration
 }
 
 func GetAll() (configs AllConfigs, err error) {
+	configs.LogLevel, err = GetLogLevel()
+	if err != nil {
+		return AllConfigs{}, err
+	}
+
 	configs.DB, err = GetConfiguredConnection()
 	if err != nil {
 		return AllConfigs{}, err
 	}
 
+	configs.TracingExporter, err = GetTracingExporter()
+	if err != nil {
+		return AllConfigs{}, err
+	}
+
+	configs.QueryTimeout, err = GetQueryTimeout()
+	if err != nil {
+		return AllConfigs{}, err
+	}
+
 	configs.CoderKey, err = GetCoderKey()
 	if err != nil {
 		return AllConfigs{}, err
@@ -40,9 +100,64 @@ func GetAll() (configs AllConfigs, err error) {
 
 	configs.Port = GetAPIPort()
 
+	configs.MessageEditWindow, err = GetMessageEditWindow()
+	if err != nil {
+		return AllConfigs{}, err
+	}
+
+	configs.MessageRateLimits, err = GetMessageRateLimits()
+	if err != nil {
+		return AllConfigs{}, err
+	}
+
+	configs.ContentFilter, err = GetContentFilterConfig()
+	if err != nil {
+		return AllConfigs{}, err
+	}
+
+	configs.ValidationRules, err = GetValidationRules()
+	if err != nil {
+		return AllConfigs{}, err
+	}
+
+	configs.AttachmentsDir = GetAttachmentsDir()
+	configs.AttachmentLimits, err = GetAttachmentLimits()
+	if err != nil {
+		return AllConfigs{}, err
+	}
+
+	configs.AttachmentOrphanTTL, err = GetAttachmentOrphanTTL()
+	if err != nil {
+		return AllConfigs{}, err
+	}
+
 	return configs, nil
 }
 
+// lines with lower level than configured one are not logged, info level is used by default
+func GetLogLevel() (logger.Level, error) {
+	level := os.Getenv("LOG_LEVEL")
+	if level == "" {
+		return logger.InfoLevel, nil
+	}
+
+	parsedLevel, err := logger.ParseLevel(level)
+	if err != nil {
+		return 0, invalidLogLevel
+	}
+
+	return parsedLevel, nil
+}
+
+func GetTracingExporter() (string, error) {
+	exporter := os.Getenv("TRACING_EXPORTER")
+	if !tracer.IsKnownExporter(exporter) {
+		return "", invalidTracingExporter
+	}
+
+	return exporter, nil
+}
+
 func GetConfiguredConnection() (*sqlx.DB, error) {
 	connStr := os.Getenv("CONN_STR")
 	if connStr == "" {
@@ -62,6 +177,20 @@ func GetConfiguredConnection() (*sqlx.DB, error) {
 	return db, nil
 }
 
+func GetQueryTimeout() (time.Duration, error) {
+	timeout := os.Getenv("QUERY_TIMEOUT")
+	if timeout == "" {
+		return defaultQueryTimeout, nil
+	}
+
+	duration, err := time.ParseDuration(timeout)
+	if err != nil || duration <= 0 {
+		return 0, invalidQueryTimeout
+	}
+
+	return duration, nil
+}
+
 func GetCoderKey() (string, error) {
 	coderKey := os.Getenv("CODER_KEY")
 	if coderKey == "" {
@@ -89,3 +218,157 @@ func GetAPIPort() string {
 		return fmt.Sprintf("%d", defaultPort)
 	}
 }
+
+func GetMessageEditWindow() (time.Duration, error) {
+	editWindow := os.Getenv("MESSAGE_EDIT_WINDOW")
+	if editWindow == "" {
+		return defaultMessageEditWindow, nil
+	}
+
+	duration, err := time.ParseDuration(editWindow)
+	if err != nil || duration < 0 {
+		return 0, invalidMessageEditWindow
+	}
+
+	return duration, nil
+}
+
+// rates are numbers of messages per second, zero rate disables limit
+func GetMessageRateLimits() (models.MessageRateLimits, error) {
+	limits := models.MessageRateLimits{
+		UserRate:        defaultMessageUserRate,
+		UserBurst:       defaultMessageUserBurst,
+		ChatRate:        defaultMessageChatRate,
+		ChatBurst:       defaultMessageChatBurst,
+		DuplicateWindow: defaultMessageDuplicateWindow,
+	}
+
+	rates := []struct {
+		rateEnv, burstEnv string
+		rate              *float64
+		burst             *int
+	}{
+		{"MESSAGE_USER_RATE", "MESSAGE_USER_BURST", &limits.UserRate, &limits.UserBurst},
+		{"MESSAGE_CHAT_RATE", "MESSAGE_CHAT_BURST", &limits.ChatRate, &limits.ChatBurst},
+	}
+	for _, r := range rates {
+		if rate := os.Getenv(r.rateEnv); rate != "" {
+			value, err := strconv.ParseFloat(rate, 64)
+			if err != nil || value < 0 {
+				return models.MessageRateLimits{}, invalidMessageRateLimits
+			}
+			*r.rate = value
+		}
+
+		if burst := os.Getenv(r.burstEnv); burst != "" {
+			value, err := strconv.Atoi(burst)
+			if err != nil || value <= 0 {
+				return models.MessageRateLimits{}, invalidMessageRateLimits
+			}
+			*r.burst = value
+		}
+	}
+
+	if window := os.Getenv("MESSAGE_DUPLICATE_WINDOW"); window != "" {
+		duration, err := time.ParseDuration(window)
+		if err != nil || duration < 0 {
+			return models.MessageRateLimits{}, invalidMessageRateLimits
+		}
+		limits.DuplicateWindow = duration
+	}
+
+	return limits, nil
+}
+
+// config is read from JSON file, filter without word lists is used if default file does not exist
+func GetContentFilterConfig() (models.ContentFilterConfig, error) {
+	config := models.ContentFilterConfig{
+		MaxLinks:         defaultContentFilterMaxLinks,
+		MaxRepeatedChars: defaultContentFilterMaxRepeating,
+	}
+
+	if err := loadJSONConfig("CONTENT_FILTER_CONFIG", defaultContentFilterConfig, &config); err != nil {
+		logger.ErrorF("Error while reading content filter config: %v", err)
+		return models.ContentFilterConfig{}, invalidContentFilterConfig
+	}
+
+	return config, nil
+}
+
+// rules are read from JSON file, not configured rules have default values of validator
+func GetValidationRules() (models.ValidationRules, error) {
+	var rules models.ValidationRules
+
+	if err := loadJSONConfig("VALIDATION_RULES_CONFIG", defaultValidationRulesConfig, &rules); err != nil {
+		logger.ErrorF("Error while reading validation rules config: %v", err)
+		return models.ValidationRules{}, invalidValidationRules
+	}
+
+	return rules, nil
+}
+
+// v keeps its values if path is not set and default file does not exist
+func loadJSONConfig(envVar, defaultPath string, v interface{}) error {
+	path := os.Getenv(envVar)
+	if path == "" {
+		path = defaultPath
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return json.NewDecoder(file).Decode(v)
+}
+
+func GetAttachmentsDir() string {
+	dir := os.Getenv("ATTACHMENTS_DIR")
+	if dir == "" {
+		return defaultAttachmentsDir
+	}
+
+	return dir
+}
+
+// allowed types are comma separated list of MIME types without parameters,
+// upload timeout is derived from max size
+func GetAttachmentLimits() (models.AttachmentLimits, error) {
+	limits := models.AttachmentLimits{MaxSize: defaultAttachmentMaxSize, AllowedTypes: defaultAttachmentTypes}
+
+	if maxSize := os.Getenv("ATTACHMENT_MAX_SIZE"); maxSize != "" {
+		size, err := strconv.ParseInt(maxSize, 10, 64)
+		if err != nil || size <= 0 {
+			return models.AttachmentLimits{}, invalidAttachmentMaxSize
+		}
+		limits.MaxSize = size
+	}
+	limits.UploadTimeout = minAttachmentUploadTimeout + time.Duration(limits.MaxSize/minAttachmentUploadRate)*time.Second
+
+	if allowedTypes := os.Getenv("ATTACHMENT_ALLOWED_TYPES"); allowedTypes != "" {
+		limits.AllowedTypes = nil
+		for _, allowedType := range strings.Split(allowedTypes, ",") {
+			limits.AllowedTypes = append(limits.AllowedTypes, strings.TrimSpace(allowedType))
+		}
+	}
+
+	return limits, nil
+}
+
+func GetAttachmentOrphanTTL() (time.Duration, error) {
+	ttl := os.Getenv("ATTACHMENT_ORPHAN_TTL")
+	if ttl == "" {
+		return defaultAttachmentOrphanTTL, nil
+	}
+
+	duration, err := time.ParseDuration(ttl)
+	if err != nil || duration <= 0 {
+		return 0, invalidAttachmentOrphanTTL
+	}
+
+	return duration, nil
+}
@@ -3,8 +3,18 @@ package config
 import "errors"
 
 var (
-	noCoderKey         = errors.New("CODER_KEY env var is not set")
-	noCSRFPrivateKey   = errors.New("CSRF_PRIVATE_KEY env var is not set")
-	noConnectionString = errors.New("CONN_STR env var is not set")
-	cannotOpenDB       = errors.New("cannot open DB")
+	noCoderKey             = errors.New("CODER_KEY env var is not set")
+	noCSRFPrivateKey       = errors.New("CSRF_PRIVATE_KEY env var is not set")
+	noConnectionString     = errors.New("CONN_STR env var is not set")
+	cannotOpenDB           = errors.New("cannot open DB")
+	invalidLogLevel        = errors.New("LOG_LEVEL env var is not one of debug, info, warning or error")
+	invalidTracingExporter = errors.New("TRACING_EXPORTER env var is not one of stdout or otlp")
+	invalidQueryTimeout    = errors.New("QUERY_TIMEOUT env var is not a valid duration")
+
+	invalidMessageEditWindow   = errors.New("MESSAGE_EDIT_WINDOW env var is not a valid duration")
+	invalidMessageRateLimits   = errors.New("MESSAGE_USER_*, MESSAGE_CHAT_* or MESSAGE_DUPLICATE_WINDOW env var is invalid")
+	invalidContentFilterConfig = errors.New("CONTENT_FILTER_CONFIG file can not be read")
+	invalidValidationRules     = errors.New("VALIDATION_RULES_CONFIG file can not be read")
+	invalidAttachmentMaxSize   = errors.New("ATTACHMENT_MAX_SIZE env var is not a positive number of bytes")
+	invalidAttachmentOrphanTTL = errors.New("ATTACHMENT_ORPHAN_TTL env var is not a valid duration")
 )
@@ -1,43 +1,188 @@
 package logger
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"strings"
+	"sync"
+	"time"
 )
 
+type Level int
+
+const (
+	DebugLevel Level = iota
+	InfoLevel
+	WarningLevel
+	ErrorLevel
+)
+
+var levelNames = map[Level]string{
+	DebugLevel:   "debug",
+	InfoLevel:    "info",
+	WarningLevel: "warning",
+	ErrorLevel:   "error",
+}
+
+var UnknownLevel = errors.New("unknown log level")
+
 type Fields struct {
 	MessageTemplate string
 	Args            []interface{}
 	Optional        map[string]interface{}
 }
 
-func WithFields(fields Fields, logFn func(interface{})) {
-	optionalArgs, _ := json.Marshal(fields.Optional)
-	message := fmt.Sprintf(fields.MessageTemplate, fields.Args...)
+// one line of output, fields are not merged to line, so they never overwrite common keys
+type entry struct {
+	Time      string                 `json:"time"`
+	Level     string                 `json:"level"`
+	Message   string                 `json:"message"`
+	RequestId string                 `json:"request_id,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+type requestIdKey struct{}
+
+var (
+	mutex    sync.Mutex
+	minLevel = InfoLevel
+	// nil output means output of standard logger, so log.SetOutput silences logger too
+	output io.Writer
+)
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+func ParseLevel(name string) (Level, error) {
+	for level, levelName := range levelNames {
+		if strings.EqualFold(name, levelName) {
+			return level, nil
+		}
+	}
+
+	return 0, UnknownLevel
+}
+
+// lines with lower level are not written
+func SetLevel(level Level) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	minLevel = level
+}
+
+func SetOutput(w io.Writer) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	output = w
+}
+
+func ContextWithRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIdKey{}, requestId)
+}
+
+// returns empty string if context does not belong to request
+func RequestId(ctx context.Context) string {
+	requestId, _ := ctx.Value(requestIdKey{}).(string)
+	return requestId
+}
+
+func WithFields(ctx context.Context, fields Fields, level Level) {
+	WithContext(ctx).WithFields(fields, level)
+}
 
-	logFn(fmt.Sprintf("%s\n\t%s", message, "[CONTEXT]: "+string(optionalArgs)))
+// Logger writes lines with id of request which it is created for
+type Logger struct {
+	requestId string
+}
+
+func WithContext(ctx context.Context) Logger {
+	return Logger{RequestId(ctx)}
+}
+
+func WithRequestId(requestId string) Logger {
+	return Logger{requestId}
+}
+
+func (l Logger) WithFields(fields Fields, level Level) {
+	write(level, fmt.Sprintf(fields.MessageTemplate, fields.Args...), l.requestId, fields.Optional)
+}
+
+func (l Logger) Debug(msg interface{}) {
+	write(DebugLevel, fmt.Sprintf("%v", msg), l.requestId, nil)
+}
+
+func (l Logger) Info(msg interface{}) {
+	write(InfoLevel, fmt.Sprintf("%v", msg), l.requestId, nil)
+}
+
+func (l Logger) Warning(msg interface{}) {
+	write(WarningLevel, fmt.Sprintf("%v", msg), l.requestId, nil)
+}
+
+func (l Logger) WarningF(template string, a ...interface{}) {
+	l.Warning(fmt.Sprintf(template, a...))
+}
+
+func (l Logger) Error(msg interface{}) {
+	write(ErrorLevel, fmt.Sprintf("%v", msg), l.requestId, nil)
+}
+
+func (l Logger) ErrorF(template string, a ...interface{}) {
+	l.Error(fmt.Sprintf(template, a...))
+}
+
+func Debug(msg interface{}) {
+	Logger{}.Debug(msg)
 }
 
 func Info(msg interface{}) {
-	log.Println(
-		"[INFO]: " + fmt.Sprintf("%v", msg))
+	Logger{}.Info(msg)
 }
 
 func Warning(msg interface{}) {
-	log.Println(
-		"[WARNING]: " + fmt.Sprintf("%v", msg))
+	Logger{}.Warning(msg)
 }
 
 func WarningF(template string, a ...interface{}) {
-	Warning(fmt.Sprintf(template, a...))
+	Logger{}.WarningF(template, a...)
 }
 
 func Error(msg interface{}) {
-	log.Println(
-		"[ERROR]: " + fmt.Sprintf("%v", msg))
+	Logger{}.Error(msg)
 }
 
 func ErrorF(template string, a ...interface{}) {
-	Error(fmt.Sprintf(template, a...))
+	Logger{}.ErrorF(template, a...)
+}
+
+func write(level Level, message, requestId string, fields map[string]interface{}) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if level < minLevel {
+		return
+	}
+
+	line := entry{
+		Time:      time.Now().UTC().Format(time.RFC3339Nano),
+		Level:     level.String(),
+		Message:   message,
+		RequestId: requestId,
+		Fields:    fields,
+	}
+	data, err := json.Marshal(line)
+	if err != nil {
+		line.Fields = map[string]interface{}{"encoding_error": err.Error()}
+		data, _ = json.Marshal(line)
+	}
+
+	w := output
+	if w == nil {
+		w = log.Writer()
+	}
+	_, _ = w.Write(append(data, '\n'))
 }
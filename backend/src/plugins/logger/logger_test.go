@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"utils"
+)
+
+func captureOutput(level Level) (*bytes.Buffer, func()) {
+	var buffer bytes.Buffer
+	SetOutput(&buffer)
+	SetLevel(level)
+
+	return &buffer, func() {
+		SetOutput(nil)
+		SetLevel(InfoLevel)
+	}
+}
+
+func decodeLine(buffer *bytes.Buffer, t *testing.T) entry {
+	var line entry
+	err := json.Unmarshal(buffer.Bytes(), &line)
+	utils.AssertNil(err, t)
+	return line
+}
+
+func TestWithFields_WritesJSONLineWithRequestId(t *testing.T) {
+	buffer, restore := captureOutput(InfoLevel)
+	defer restore()
+
+	ctx := ContextWithRequestId(context.Background(), "abc")
+	WithFields(ctx, Fields{
+		MessageTemplate: "Error while getting chat: %v",
+		Args:            []interface{}{"no rows"},
+		Optional:        map[string]interface{}{"chat_id": 1},
+	}, WarningLevel)
+
+	line := decodeLine(buffer, t)
+	utils.AssertEqual("warning", line.Level, t)
+	utils.AssertEqual("Error while getting chat: no rows", line.Message, t)
+	utils.AssertEqual("abc", line.RequestId, t)
+	utils.AssertEqual(float64(1), line.Fields["chat_id"], t)
+	utils.AssertNotEqual("", line.Time, t)
+}
+
+func TestLogger_WithoutRequestId(t *testing.T) {
+	buffer, restore := captureOutput(InfoLevel)
+	defer restore()
+
+	WithContext(context.Background()).ErrorF("Error: %d", 1)
+
+	line := decodeLine(buffer, t)
+	utils.AssertEqual("error", line.Level, t)
+	utils.AssertEqual("Error: 1", line.Message, t)
+	utils.AssertEqual("", line.RequestId, t)
+}
+
+func TestLogger_SkipsLinesBelowLevel(t *testing.T) {
+	buffer, restore := captureOutput(WarningLevel)
+	defer restore()
+
+	Info("started")
+	Debug("details")
+
+	utils.AssertEqual(0, buffer.Len(), t)
+}
+
+func TestLogger_NotEncodableFields(t *testing.T) {
+	buffer, restore := captureOutput(InfoLevel)
+	defer restore()
+
+	WithFields(context.Background(), Fields{
+		MessageTemplate: "message",
+		Optional:        map[string]interface{}{"channel": make(chan int)},
+	}, ErrorLevel)
+
+	line := decodeLine(buffer, t)
+	utils.AssertEqual("message", line.Message, t)
+	utils.AssertNotNil(line.Fields["encoding_error"], t)
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("Warning")
+	utils.AssertNil(err, t)
+	utils.AssertEqual(WarningLevel, level, t)
+
+	_, err = ParseLevel("verbose")
+	utils.AssertErrorsEqual(UnknownLevel, err, t)
+}
@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// buckets that are full again are forgotten when there are more of them than this number
+const maxIdleBuckets = 10000
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// Limiter keeps separate token bucket for every key, bucket refills with rate tokens per second up to burst
+type Limiter struct {
+	rate    float64
+	burst   float64
+	mutex   sync.Mutex
+	buckets map[interface{}]*bucket
+	now     func() time.Time
+}
+
+// zero rate means that there is no limit
+func NewLimiter(rate float64, burst int) *Limiter {
+	return &Limiter{
+		rate:    rate,
+		burst:   math.Max(float64(burst), 1),
+		buckets: map[interface{}]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// takes token from bucket of key, if bucket is empty returns time after which token will be available
+func (l *Limiter) Allow(key interface{}) (bool, time.Duration) {
+	if l.rate <= 0 {
+		return true, 0
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	b, found := l.buckets[key]
+	if !found {
+		if len(l.buckets) >= maxIdleBuckets {
+			l.forgetFullBuckets(now)
+		}
+		b = &bucket{tokens: l.burst, updatedAt: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = l.refilled(b, now)
+	b.updatedAt = now
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+func (l *Limiter) refilled(b *bucket, now time.Time) float64 {
+	return math.Min(l.burst, b.tokens+now.Sub(b.updatedAt).Seconds()*l.rate)
+}
+
+func (l *Limiter) forgetFullBuckets(now time.Time) {
+	for key, b := range l.buckets {
+		if l.refilled(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+type lastText struct {
+	text   string
+	sentAt time.Time
+}
+
+// DuplicatesFilter remembers last text of every key during window
+type DuplicatesFilter struct {
+	window time.Duration
+	mutex  sync.Mutex
+	texts  map[interface{}]lastText
+	now    func() time.Time
+}
+
+// zero window means that duplicates are allowed
+func NewDuplicatesFilter(window time.Duration) *DuplicatesFilter {
+	return &DuplicatesFilter{window: window, texts: map[interface{}]lastText{}, now: time.Now}
+}
+
+// returns true and time till the end of window if key has sent the same text during window
+func (f *DuplicatesFilter) IsDuplicate(key interface{}, text string) (bool, time.Duration) {
+	if f.window <= 0 {
+		return false, 0
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	last, found := f.texts[key]
+	elapsed := f.now().Sub(last.sentAt)
+	if !found || last.text != text || elapsed >= f.window {
+		return false, 0
+	}
+
+	return true, f.window - elapsed
+}
+
+func (f *DuplicatesFilter) Remember(key interface{}, text string) {
+	if f.window <= 0 {
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	now := f.now()
+	if len(f.texts) >= maxIdleBuckets {
+		for oldKey, last := range f.texts {
+			if now.Sub(last.sentAt) >= f.window {
+				delete(f.texts, oldKey)
+			}
+		}
+	}
+	f.texts[key] = lastText{text, now}
+}
@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+	"utils"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func getLimiter(rate float64, burst int) (*Limiter, *fakeClock) {
+	clock := &fakeClock{time.Now()}
+	limiter := NewLimiter(rate, burst)
+	limiter.now = clock.Now
+
+	return limiter, clock
+}
+
+func TestLimiter_Burst(t *testing.T) {
+	limiter, _ := getLimiter(1, 3)
+
+	for i := 0; i < 3; i++ {
+		allowed, _ := limiter.Allow(1)
+		utils.AssertTrue(allowed, t)
+	}
+	allowed, retryAfter := limiter.Allow(1)
+
+	utils.AssertFalse(allowed, t)
+	utils.AssertEqual(time.Second, retryAfter, t)
+}
+
+func TestLimiter_Refill(t *testing.T) {
+	limiter, clock := getLimiter(2, 1)
+
+	_, _ = limiter.Allow(1)
+	allowed, retryAfter := limiter.Allow(1)
+	utils.AssertFalse(allowed, t)
+	utils.AssertEqual(500*time.Millisecond, retryAfter, t)
+
+	clock.Advance(retryAfter)
+	allowed, _ = limiter.Allow(1)
+	utils.AssertTrue(allowed, t)
+}
+
+func TestLimiter_KeysAreIndependent(t *testing.T) {
+	limiter, _ := getLimiter(1, 1)
+
+	_, _ = limiter.Allow(1)
+	allowed, _ := limiter.Allow(2)
+
+	utils.AssertTrue(allowed, t)
+}
+
+func TestLimiter_ZeroRateIsUnlimited(t *testing.T) {
+	limiter, _ := getLimiter(0, 1)
+
+	for i := 0; i < 100; i++ {
+		allowed, _ := limiter.Allow(1)
+		utils.AssertTrue(allowed, t)
+	}
+}
+
+func getDuplicatesFilter(window time.Duration) (*DuplicatesFilter, *fakeClock) {
+	clock := &fakeClock{time.Now()}
+	filter := NewDuplicatesFilter(window)
+	filter.now = clock.Now
+
+	return filter, clock
+}
+
+func TestDuplicatesFilter_SameTextDuringWindow(t *testing.T) {
+	filter, clock := getDuplicatesFilter(time.Minute)
+
+	filter.Remember(1, "hello")
+	clock.Advance(20 * time.Second)
+	duplicate, retryAfter := filter.IsDuplicate(1, "hello")
+
+	utils.AssertTrue(duplicate, t)
+	utils.AssertEqual(40*time.Second, retryAfter, t)
+}
+
+func TestDuplicatesFilter_AnotherTextOrKey(t *testing.T) {
+	filter, _ := getDuplicatesFilter(time.Minute)
+
+	filter.Remember(1, "hello")
+	anotherText, _ := filter.IsDuplicate(1, "bye")
+	anotherKey, _ := filter.IsDuplicate(2, "hello")
+
+	utils.AssertFalse(anotherText, t)
+	utils.AssertFalse(anotherKey, t)
+}
+
+func TestDuplicatesFilter_WindowExpired(t *testing.T) {
+	filter, clock := getDuplicatesFilter(time.Minute)
+
+	filter.Remember(1, "hello")
+	clock.Advance(time.Minute)
+	duplicate, _ := filter.IsDuplicate(1, "hello")
+
+	utils.AssertFalse(duplicate, t)
+}
@@ -0,0 +1,80 @@
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+)
+
+const (
+	// all thumbnails are encoded as JPEG regardless of source format
+	MimeType = "image/jpeg"
+
+	quality = 80
+	// protects from decompression bombs
+	maxSourcePixels = 50 * 1000 * 1000
+)
+
+var TooLargeImage = errors.New("image is too large for thumbnail")
+
+// scales image down to fit into square with side of max size, small images are not scaled up
+func Make(source io.Reader, maxSize int) ([]byte, error) {
+	var header bytes.Buffer
+	config, _, err := image.DecodeConfig(io.TeeReader(source, &header))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > maxSourcePixels {
+		return nil, TooLargeImage
+	}
+
+	// header consumed by config decoding is read again
+	img, _, err := image.Decode(io.MultiReader(&header, source))
+	if err != nil {
+		return nil, err
+	}
+
+	var thumbnail bytes.Buffer
+	err = jpeg.Encode(&thumbnail, scale(img, maxSize), &jpeg.Options{Quality: quality})
+	if err != nil {
+		return nil, err
+	}
+
+	return thumbnail.Bytes(), nil
+}
+
+// nearest neighbour scaling is enough for preview
+func scale(img image.Image, maxSize int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSize && height <= maxSize {
+		return img
+	}
+
+	newWidth, newHeight := maxSize, maxSize
+	if width > height {
+		newHeight = max(1, height*maxSize/width)
+	} else {
+		newWidth = max(1, width*maxSize/height)
+	}
+
+	scaled := image.NewRGBA(image.Rect(0, 0, newWidth, newHeight))
+	for y := 0; y < newHeight; y++ {
+		for x := 0; x < newWidth; x++ {
+			scaled.Set(x, y, img.At(bounds.Min.X+x*width/newWidth, bounds.Min.Y+y*height/newHeight))
+		}
+	}
+
+	return scaled
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
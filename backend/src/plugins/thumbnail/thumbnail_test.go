@@ -0,0 +1,54 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"utils"
+)
+
+func getPNG(width, height int) *bytes.Buffer {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+
+	var buffer bytes.Buffer
+	_ = png.Encode(&buffer, img)
+	return &buffer
+}
+
+func decode(thumbnail []byte, t *testing.T) image.Image {
+	img, format, err := image.Decode(bytes.NewReader(thumbnail))
+	utils.AssertNil(err, t)
+	utils.AssertEqual("jpeg", format, t)
+
+	return img
+}
+
+func TestMake_ScalesDown(t *testing.T) {
+	thumbnail, err := Make(getPNG(400, 200), 100)
+
+	utils.AssertNil(err, t)
+	bounds := decode(thumbnail, t).Bounds()
+	utils.AssertEqual(100, bounds.Dx(), t)
+	utils.AssertEqual(50, bounds.Dy(), t)
+}
+
+func TestMake_SmallImageIsNotScaled(t *testing.T) {
+	thumbnail, err := Make(getPNG(30, 60), 100)
+
+	utils.AssertNil(err, t)
+	bounds := decode(thumbnail, t).Bounds()
+	utils.AssertEqual(30, bounds.Dx(), t)
+	utils.AssertEqual(60, bounds.Dy(), t)
+}
+
+func TestMake_NotImage(t *testing.T) {
+	_, err := Make(strings.NewReader("just text"), 100)
+
+	utils.AssertNotNil(err, t)
+}
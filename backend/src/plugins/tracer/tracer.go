@@ -0,0 +1,89 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+const (
+	tracerName  = "meetings"
+	serviceName = "meetings-api"
+)
+
+// names of exporters, spans are not exported and not recorded without exporter
+const (
+	NoExporter     = ""
+	StdoutExporter = "stdout"
+	// endpoint of collector is configured by standard OTEL_EXPORTER_OTLP_* env vars
+	OTLPExporter = "otlp"
+)
+
+var UnknownExporter = errors.New("unknown tracing exporter")
+
+// SetUp sets global tracer provider which sends spans to exporter, returned function flushes
+// not exported spans, provider of OpenTelemetry API is no-op until it is set up
+func SetUp(exporterName string) (func(context.Context) error, error) {
+	exporter, err := newExporter(exporterName)
+	if err != nil || exporter == nil {
+		return func(context.Context) error { return nil }, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider.Shutdown, nil
+}
+
+func IsKnownExporter(exporterName string) bool {
+	switch exporterName {
+	case NoExporter, StdoutExporter, OTLPExporter:
+		return true
+	default:
+		return false
+	}
+}
+
+func newExporter(exporterName string) (sdktrace.SpanExporter, error) {
+	switch exporterName {
+	case NoExporter:
+		return nil, nil
+	case StdoutExporter:
+		return stdouttrace.New()
+	case OTLPExporter:
+		return otlptracehttp.New(context.Background())
+	default:
+		return nil, UnknownExporter
+	}
+}
+
+// Extract continues trace of caller if request has trace context headers
+func Extract(ctx context.Context, header http.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+func Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, options...)
+}
+
+// End marks span as failed if call returned error and ends span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,50 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel/codes"
+	"mock/plugins"
+	"testing"
+	"utils"
+)
+
+func TestEnd_RecordsError(t *testing.T) {
+	spans := plugins.RecordSpans()
+
+	_, span := Start(context.Background(), "failed")
+	End(span, errors.New("connection refused"))
+
+	ended := spans.GetSpans()
+	utils.AssertEqual(1, len(ended), t)
+	utils.AssertEqual("failed", ended[0].Name, t)
+	utils.AssertEqual(codes.Error, ended[0].Status.Code, t)
+	utils.AssertEqual("connection refused", ended[0].Status.Description, t)
+	utils.AssertEqual(1, len(ended[0].Events), t)
+}
+
+func TestEnd_WithoutError(t *testing.T) {
+	spans := plugins.RecordSpans()
+
+	_, span := Start(context.Background(), "succeeded")
+	End(span, nil)
+
+	ended := spans.GetSpans()
+	utils.AssertEqual(1, len(ended), t)
+	utils.AssertEqual(codes.Unset, ended[0].Status.Code, t)
+}
+
+func TestSetUp_NoExporter(t *testing.T) {
+	shutdown, err := SetUp(NoExporter)
+
+	utils.AssertNil(err, t)
+	utils.AssertNil(shutdown(context.Background()), t)
+}
+
+func TestSetUp_UnknownExporter(t *testing.T) {
+	_, err := SetUp("zipkin")
+
+	utils.AssertErrorsEqual(UnknownExporter, err, t)
+	utils.AssertFalse(IsKnownExporter("zipkin"), t)
+	utils.AssertTrue(IsKnownExporter(OTLPExporter), t)
+}
@@ -0,0 +1,184 @@
+package transcript
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"models"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	JSONFormat = "json"
+	CSVFormat  = "csv"
+	TextFormat = "text"
+
+	timeFormat = "2006-01-02 15:04:05"
+)
+
+// messages are written one by one, so transcript of any size can be streamed
+type Writer interface {
+	WriteMessage(message models.TranscriptMessage) error
+	// completes transcript, must be called after the last message
+	Close() error
+}
+
+// unknown format is treated as JSON
+func NewWriter(format string, w io.Writer) Writer {
+	switch format {
+	case CSVFormat:
+		return &csvWriter{w: csv.NewWriter(w)}
+	case TextFormat:
+		return textWriter{w}
+	default:
+		return &jsonWriter{w: w}
+	}
+}
+
+func ContentType(format string) string {
+	switch format {
+	case CSVFormat:
+		return "text/csv; charset=utf-8"
+	case TextFormat:
+		return "text/plain; charset=utf-8"
+	default:
+		return "application/json"
+	}
+}
+
+func FileName(chatId uint, format string) string {
+	extension := format
+	if format == TextFormat {
+		extension = "txt"
+	}
+
+	return fmt.Sprintf("chat_%d.%s", chatId, extension)
+}
+
+// writes array of messages
+type jsonWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (j *jsonWriter) WriteMessage(message models.TranscriptMessage) error {
+	separator := ","
+	if j.count == 0 {
+		separator = "["
+	}
+	j.count++
+
+	if _, err := io.WriteString(j.w, separator); err != nil {
+		return err
+	}
+	return json.NewEncoder(j.w).Encode(message)
+}
+
+func (j *jsonWriter) Close() error {
+	end := "]"
+	if j.count == 0 {
+		end = "[]"
+	}
+
+	_, err := io.WriteString(j.w, end)
+	return err
+}
+
+// header is written before the first message
+type csvWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (c *csvWriter) WriteMessage(message models.TranscriptMessage) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	err := c.w.Write([]string{
+		strconv.FormatUint(uint64(message.Id), 10),
+		message.SendingTime.Format(time.RFC3339),
+		strconv.FormatUint(uint64(message.SenderId), 10),
+		message.SenderNickname,
+		message.Text,
+		formatOptionalTime(message.EditedAt),
+		formatOptionalTime(message.DeletedAt),
+		formatOptionalId(message.ReplyTo),
+	})
+	if err != nil {
+		return err
+	}
+
+	// rows are flushed on each message, so they are not collected in memory
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.headerWritten {
+		return nil
+	}
+
+	c.headerWritten = true
+	return c.w.Write([]string{
+		"id", "sending_time", "sender_id", "sender_nickname", "text", "edited_at", "deleted_at", "reply_to",
+	})
+}
+
+// writes one line per message, continuation lines of multiline text are indented
+type textWriter struct {
+	w io.Writer
+}
+
+func (t textWriter) WriteMessage(message models.TranscriptMessage) error {
+	sender := message.SenderNickname
+	if sender == "" {
+		sender = fmt.Sprintf("user %d", message.SenderId)
+	}
+
+	text := strings.Replace(message.Text, "\n", "\n\t", -1)
+	switch {
+	case message.DeletedAt != nil:
+		text = "(deleted)"
+	case message.EditedAt != nil:
+		text += " (edited)"
+	}
+	if message.ReplyTo != nil {
+		text = fmt.Sprintf("(reply to #%d) %s", *message.ReplyTo, text)
+	}
+
+	_, err := fmt.Fprintf(t.w, "[%s] #%d %s: %s\n", message.SendingTime.Format(timeFormat), message.Id, sender, text)
+	return err
+}
+
+func (t textWriter) Close() error {
+	return nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func formatOptionalId(id *uint) string {
+	if id == nil {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(*id), 10)
+}
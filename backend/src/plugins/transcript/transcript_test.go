@@ -0,0 +1,60 @@
+package transcript
+
+import (
+	"bytes"
+	"encoding/json"
+	"models"
+	"strings"
+	"testing"
+	"time"
+	"utils"
+)
+
+func getMessage() models.TranscriptMessage {
+	return models.TranscriptMessage{
+		Id: 1, SenderId: 2, SenderNickname: "nick", Text: "first line\nsecond line", SendingTime: time.Now(),
+	}
+}
+
+func TestNewWriter_EmptyJSON(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWriter(JSONFormat, &buffer)
+
+	utils.AssertNil(writer.Close(), t)
+	utils.AssertEqual("[]", buffer.String(), t)
+}
+
+func TestNewWriter_JSON(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWriter(JSONFormat, &buffer)
+	_ = writer.WriteMessage(getMessage())
+	_ = writer.WriteMessage(getMessage())
+
+	utils.AssertNil(writer.Close(), t)
+	var messages []models.TranscriptMessage
+	utils.AssertNil(json.Unmarshal(buffer.Bytes(), &messages), t)
+	utils.AssertEqual(2, len(messages), t)
+	utils.AssertEqual(getMessage().Text, messages[0].Text, t)
+}
+
+func TestNewWriter_EmptyCSV(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWriter(CSVFormat, &buffer)
+
+	utils.AssertNil(writer.Close(), t)
+	utils.AssertTrue(strings.HasPrefix(buffer.String(), "id,sending_time,sender_id"), t)
+}
+
+func TestNewWriter_TextMultiline(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWriter(TextFormat, &buffer)
+	_ = writer.WriteMessage(getMessage())
+
+	utils.AssertNil(writer.Close(), t)
+	utils.AssertTrue(strings.Contains(buffer.String(), "nick: first line\n\tsecond line\n"), t)
+}
+
+func TestFileName(t *testing.T) {
+	utils.AssertEqual("chat_1.txt", FileName(1, TextFormat), t)
+	utils.AssertEqual("chat_1.csv", FileName(1, CSVFormat), t)
+}
@@ -0,0 +1,96 @@
+package attachments
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"internal_errors"
+	"models"
+	"time"
+)
+
+const (
+	SaveAttachmentQuery = `
+	INSERT INTO attachments(uploader_id, file_name, mime_type, size, blob_id, has_thumbnail)
+	VALUES(:uploader_id, :file_name, :mime_type, :size, :blob_id, :has_thumbnail)
+	RETURNING id, created_at`
+	// attachments of deleted messages are not available
+	GetAttachmentQuery = `
+	SELECT a.id, a.uploader_id, a.message_id, m.chat_id, a.file_name, a.mime_type, a.size, a.blob_id,
+	a.has_thumbnail, a.created_at
+	FROM attachments a
+	LEFT JOIN messages m ON m.id = a.message_id
+	WHERE a.id = $1 AND m.deleted_at IS NULL`
+	IsChatMemberQuery         = `SELECT EXISTS(SELECT 1 FROM chats_members WHERE chat_id = $1 AND user_id = $2)`
+	GetOrphanAttachmentsQuery = `
+	SELECT a.id, a.uploader_id, a.message_id, a.file_name, a.mime_type, a.size, a.blob_id,
+	a.has_thumbnail, a.created_at
+	FROM attachments a
+	LEFT JOIN messages m ON m.id = a.message_id
+	WHERE (a.message_id IS NULL OR m.deleted_at IS NOT NULL)
+	AND a.created_at < LOCALTIMESTAMP - $1 * INTERVAL '1 second'`
+	DeleteAttachmentsQuery = `DELETE FROM attachments WHERE id = ANY($1)`
+
+	attachmentNotFound      = `sql: no rows in result set`
+	uploaderIdNotFoundError = `pq: insert or update on table "attachments" violates foreign key constraint "attachments_uploader_id_fkey"`
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) Repository {
+	return Repository{db}
+}
+
+func (r Repository) SaveAttachment(ctx context.Context, attachment models.Attachment) (models.Attachment, error) {
+	rows, err := r.db.NamedQueryContext(ctx, SaveAttachmentQuery, attachment)
+	if err != nil {
+		if err.Error() == uploaderIdNotFoundError {
+			err = internal_errors.UnableToFindUserById
+		}
+		return models.Attachment{}, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return models.Attachment{}, rows.Err()
+	}
+	err = rows.Scan(&attachment.Id, &attachment.CreatedAt)
+
+	return attachment, err
+}
+
+func (r Repository) GetAttachment(ctx context.Context, attachmentId uint) (models.Attachment, error) {
+	var attachment models.Attachment
+	err := r.db.GetContext(ctx, &attachment, GetAttachmentQuery, attachmentId)
+	if err != nil && err.Error() == attachmentNotFound {
+		err = internal_errors.UnableToFindAttachment
+	}
+
+	return attachment, err
+}
+
+func (r Repository) IsChatMember(ctx context.Context, chatId, userId uint) (bool, error) {
+	var isMember bool
+	err := r.db.GetContext(ctx, &isMember, IsChatMemberQuery, chatId, userId)
+
+	return isMember, err
+}
+
+func (r Repository) GetOrphanAttachments(ctx context.Context, olderThan time.Duration) ([]models.Attachment, error) {
+	var attachments []models.Attachment
+	err := r.db.SelectContext(ctx, &attachments, GetOrphanAttachmentsQuery, int64(olderThan/time.Second))
+
+	return attachments, err
+}
+
+func (r Repository) DeleteAttachments(ctx context.Context, attachmentIds []uint) error {
+	ids := make([]int64, len(attachmentIds))
+	for i, id := range attachmentIds {
+		ids[i] = int64(id)
+	}
+
+	_, err := r.db.ExecContext(ctx, DeleteAttachmentsQuery, pq.Array(ids))
+	return err
+}
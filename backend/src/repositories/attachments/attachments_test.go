@@ -0,0 +1,126 @@
+package attachments
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"internal_errors"
+	mock "mock/repositories"
+	"models"
+	"os"
+	"plugins/config"
+	"testing"
+	"time"
+	"utils"
+)
+
+var (
+	db         *sqlx.DB
+	repository Repository
+)
+
+func init() {
+	utils.SkipInShortMode()
+
+	var err error
+	db, err = config.GetConfiguredConnection()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	repository = New(db)
+}
+
+func getAttachment(blobId string) models.Attachment {
+	return models.Attachment{
+		UploaderId: 1,
+		FileName:   "notes.txt",
+		MimeType:   "text/plain",
+		Size:       5,
+		BlobId:     blobId,
+	}
+}
+
+// we need this function to avoiding DB errors due parallel queries
+func TestMain(t *testing.M) {
+	res := t.Run()
+	mock.DropTables(db)
+	os.Exit(res)
+}
+
+func TestRepository_SaveAttachmentSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	attachment, err := repository.SaveAttachment(context.Background(), getAttachment("blob"))
+	savedAttachment, getErr := repository.GetAttachment(context.Background(), attachment.Id)
+
+	utils.AssertNil(err, t)
+	utils.AssertNil(getErr, t)
+	utils.AssertNotEqual(uint(0), attachment.Id, t)
+	utils.AssertEqual("blob", savedAttachment.BlobId, t)
+	utils.AssertTrue(savedAttachment.MessageId == nil, t)
+}
+
+func TestRepository_SaveAttachmentUserNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	attachment := getAttachment("blob")
+	attachment.UploaderId = mock.GetNextUserId()
+	_, err := repository.SaveAttachment(context.Background(), attachment)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
+}
+
+func TestRepository_GetAttachmentNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.GetAttachment(context.Background(), 1)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindAttachment, err, t)
+}
+
+func TestRepository_GetOrphanAttachments(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	attachment, _ := repository.SaveAttachment(context.Background(), getAttachment("blob"))
+
+	recentOrphans, err := repository.GetOrphanAttachments(context.Background(), time.Hour)
+	utils.AssertNil(err, t)
+	utils.AssertEqual(0, len(recentOrphans), t)
+
+	// negative age makes just uploaded attachment old enough
+	orphans, err := repository.GetOrphanAttachments(context.Background(), -time.Hour)
+	utils.AssertNil(err, t)
+	utils.AssertEqual(1, len(orphans), t)
+	utils.AssertEqual(attachment.Id, orphans[0].Id, t)
+}
+
+func TestRepository_DeleteAttachments(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	attachment, _ := repository.SaveAttachment(context.Background(), getAttachment("blob"))
+	err := repository.DeleteAttachments(context.Background(), []uint{attachment.Id})
+	_, getErr := repository.GetAttachment(context.Background(), attachment.Id)
+
+	utils.AssertNil(err, t)
+	utils.AssertErrorsEqual(internal_errors.UnableToFindAttachment, getErr, t)
+}
+
+func TestRepository_IsChatMember(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	receipt := mock.GetFirstChatReadReceipt()
+	isMember, err := repository.IsChatMember(context.Background(), receipt.ChatId, receipt.UserId)
+	isOtherMember, _ := repository.IsChatMember(context.Background(), receipt.ChatId, mock.UserIdNotInFirstChat)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(isMember, t)
+	utils.AssertFalse(isOtherMember, t)
+}
@@ -0,0 +1,106 @@
+package blobs
+
+import (
+	"context"
+	"internal_errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const (
+	dirPermissions = 0750
+	// blobs are grouped to subdirectories by first symbols of id
+	shardLength = 2
+)
+
+// stores blobs in local file system, started operation with file is not interrupted by context,
+// but copying of content is stopped when context is done
+type LocalStore struct {
+	root string
+}
+
+func New(root string) LocalStore {
+	return LocalStore{root}
+}
+
+// blob is written to temporary file first, so partially written blob is never visible
+func (s LocalStore) Save(ctx context.Context, blobId string, content io.Reader) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	path := s.getPath(blobId)
+	err := os.MkdirAll(filepath.Dir(path), dirPermissions)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(path), "upload-")
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, contextReader{ctx, content})
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (s LocalStore) Open(ctx context.Context, blobId string) (io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(s.getPath(blobId))
+	if os.IsNotExist(err) {
+		return nil, internal_errors.UnableToFindBlob
+	}
+
+	return file, err
+}
+
+// deleting of not existing blob is not an error
+func (s LocalStore) Delete(ctx context.Context, blobId string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err := os.Remove(s.getPath(blobId))
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (s LocalStore) getPath(blobId string) string {
+	// base name prevents escaping from root directory
+	name := filepath.Base(blobId)
+	shard := name
+	if len(shard) > shardLength {
+		shard = shard[:shardLength]
+	}
+
+	return filepath.Join(s.root, shard, name)
+}
+
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return r.reader.Read(p)
+}
@@ -0,0 +1,85 @@
+package blobs
+
+import (
+	"context"
+	"internal_errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"utils"
+)
+
+func getStore(t *testing.T) (LocalStore, func()) {
+	root, err := ioutil.TempDir("", "blobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return New(root), func() {
+		_ = os.RemoveAll(root)
+	}
+}
+
+func TestLocalStore_SaveAndOpen(t *testing.T) {
+	store, cleanup := getStore(t)
+	defer cleanup()
+
+	err := store.Save(context.Background(), "abcdef", strings.NewReader("content"))
+	utils.AssertNil(err, t)
+
+	blob, err := store.Open(context.Background(), "abcdef")
+	utils.AssertNil(err, t)
+	defer blob.Close()
+
+	content, _ := ioutil.ReadAll(blob)
+	utils.AssertEqual("content", string(content), t)
+}
+
+func TestLocalStore_OpenNotExists(t *testing.T) {
+	store, cleanup := getStore(t)
+	defer cleanup()
+
+	_, err := store.Open(context.Background(), "abcdef")
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindBlob, err, t)
+}
+
+func TestLocalStore_Delete(t *testing.T) {
+	store, cleanup := getStore(t)
+	defer cleanup()
+
+	_ = store.Save(context.Background(), "abcdef", strings.NewReader("content"))
+	err := store.Delete(context.Background(), "abcdef")
+	utils.AssertNil(err, t)
+
+	_, err = store.Open(context.Background(), "abcdef")
+	utils.AssertErrorsEqual(internal_errors.UnableToFindBlob, err, t)
+
+	err = store.Delete(context.Background(), "abcdef")
+	utils.AssertNil(err, t)
+}
+
+func TestLocalStore_PathTraversal(t *testing.T) {
+	store, cleanup := getStore(t)
+	defer cleanup()
+
+	_ = store.Save(context.Background(), "../../escaped", strings.NewReader("content"))
+	_, err := os.Stat(store.getPath("escaped"))
+
+	utils.AssertNil(err, t)
+}
+
+func TestLocalStore_CanceledContext(t *testing.T) {
+	store, cleanup := getStore(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := store.Save(ctx, "abcdef", strings.NewReader("content"))
+	utils.AssertErrorsEqual(context.Canceled, err, t)
+
+	_, err = store.Open(context.Background(), "abcdef")
+	utils.AssertErrorsEqual(internal_errors.UnableToFindBlob, err, t)
+}
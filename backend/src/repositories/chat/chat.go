@@ -1,9 +1,12 @@
 package chat
 
 import (
+	"context"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"internal_errors"
 	"models"
+	"strings"
 )
 
 const (
@@ -11,18 +14,83 @@ const (
 	SELECT id, type, status, created_at FROM chats
 	WHERE meeting_id = $1 AND type = 'meeting' AND status != 'archived'`
 	GetUserChatsQuery = `
-	SELECT c.id, type, status, created_at FROM chats c
-	JOIN messages m ON m.sender_id = $1 AND m.chat_id = c.id
-	WHERE c.status != 'archived'`
+	SELECT c.id, c.type, c.status, c.created_at,
+	COALESCE(lm.id, 0), COALESCE(lm.sender_id, 0), COALESCE(lm.text, ''), COALESCE(lm.sending_time, c.created_at),
+	(
+		SELECT COUNT(*) FROM messages um
+		WHERE um.chat_id = c.id AND um.sender_id != cm.user_id AND um.id > COALESCE(cm.last_read_message_id, 0)
+	) AS unread_count
+	FROM chats_members cm
+	JOIN chats c ON c.id = cm.chat_id
+	LEFT JOIN LATERAL (
+		SELECT id, sender_id, text, sending_time FROM messages
+		WHERE chat_id = c.id ORDER BY sending_time DESC, id DESC LIMIT 1
+	) lm ON TRUE
+	WHERE cm.user_id = $1 AND c.status != 'archived'
+	ORDER BY COALESCE(lm.sending_time, c.created_at) DESC, c.id DESC`
+	GetChatMembersQuery = `
+	SELECT user_id FROM chats_members WHERE chat_id = $1 AND user_id = ANY($2) ORDER BY user_id`
 	CreateChatQuery = `
 	INSERT INTO chats(meeting_id, type)
 	SELECT :meeting_id, :type
 	WHERE NOT EXISTS (
 		SELECT id FROM chats WHERE meeting_id = :meeting_id AND type = :type AND type = 'meeting'
-	)`
+	) RETURNING id`
+	// meeting chat members are meeting users, request chat is available for meeting admin
+	AddChatMembersQuery = `
+	INSERT INTO chats_members(chat_id, user_id)
+	SELECT c.id, UNNEST(CASE WHEN c.type = 'meeting' THEN m.user_ids ELSE ARRAY[m.admin_id] END)
+	FROM chats c JOIN meetings m ON m.id = c.meeting_id
+	WHERE c.id = $1
+	ON CONFLICT (chat_id, user_id) DO NOTHING`
+	// meeting request chat is visible only for applicant and meeting admin
+	CreateMeetingRequestChatQuery = `
+	WITH chat AS (
+		INSERT INTO chats(meeting_id, applicant_id, type)
+		SELECT id, $2, 'meeting_request' FROM meetings WHERE id = $1
+		RETURNING id, meeting_id
+	)
+	INSERT INTO chats_members(chat_id, user_id)
+	SELECT c.id, UNNEST(ARRAY[m.admin_id, $2::INTEGER])
+	FROM chat c JOIN meetings m ON m.id = c.meeting_id
+	ON CONFLICT (chat_id, user_id) DO NOTHING`
+	CloseMeetingRequestChatQuery = `
+	UPDATE chats SET status = 'archived'
+	WHERE meeting_id = $1 AND applicant_id = $2 AND type = 'meeting_request' AND status != 'archived'`
 	UpdateChatStatusQuery = `UPDATE chats SET status = :status WHERE id = :chat_id`
+	GetDirectChatQuery    = `
+	SELECT c.id, type, status, created_at FROM chats c
+	JOIN direct_chats dc ON dc.chat_id = c.id
+	WHERE dc.first_user_id = $1 AND dc.second_user_id = $2`
+	CreateDirectChatQuery = `
+	WITH chat AS (
+		INSERT INTO chats(type) VALUES('direct') RETURNING id, type, status, created_at
+	), direct_chat AS (
+		INSERT INTO direct_chats(chat_id, first_user_id, second_user_id) SELECT id, $1, $2 FROM chat
+	), members AS (
+		INSERT INTO chats_members(chat_id, user_id) SELECT id, UNNEST(ARRAY[$1, $2]::INTEGER[]) FROM chat
+	)
+	SELECT id, type, status, created_at FROM chat`
+	// both meeting chat and meeting request chat are managed by meeting admin
+	GetChatMeetingAdminIdQuery = `
+	SELECT COALESCE(m.admin_id, 0) FROM chats c
+	LEFT JOIN meetings m ON m.id = c.meeting_id
+	WHERE c.id = $1`
+	ExportMessagesQuery = `
+	SELECT m.id, m.sender_id, COALESCE(ui.nickname, '') AS sender_nickname, m.text, m.sending_time,
+	m.edited_at, m.deleted_at, m.reply_to
+	FROM messages m
+	LEFT JOIN users_info ui ON ui.user_id = m.sender_id
+	WHERE m.chat_id = $1 ORDER BY m.sending_time, m.id`
 
 	meetingChatNotFound = `sql: no rows in result set`
+	directChatNotFound  = `sql: no rows in result set`
+	chatNotFound        = `sql: no rows in result set`
+	// both direct_chats and chats_members reference users, so any of them can report not existing user
+	userIdNotFoundSuffix    = `user_id_fkey"`
+	applicantNotFound       = `pq: insert or update on table "chats" violates foreign key constraint "chats_applicant_id_fkey"`
+	directChatAlreadyExists = `pq: duplicate key value violates unique constraint "direct_chats_first_user_id_second_user_id_key"`
+	requestChatExists       = `pq: duplicate key value violates unique constraint "chats_meeting_request_idx"`
 )
 
 type Repository struct {
@@ -33,9 +101,9 @@ func New(db *sqlx.DB) Repository {
 	return Repository{db}
 }
 
-func (r Repository) GetMeetingChat(meetingId uint) (models.Chat, error) {
+func (r Repository) GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error) {
 	var chat models.Chat
-	err := r.db.Get(&chat, GetMeetingChatQuery, meetingId)
+	err := r.db.GetContext(ctx, &chat, GetMeetingChatQuery, meetingId)
 	if err != nil && err.Error() == meetingChatNotFound {
 		err = internal_errors.UnableToFindChatByMeetingId
 	}
@@ -43,20 +111,117 @@ func (r Repository) GetMeetingChat(meetingId uint) (models.Chat, error) {
 	return chat, err
 }
 
-func (r Repository) GetUserChats(userId uint) ([]models.Chat, error) {
-	var chats []models.Chat
-	err := r.db.Select(&chats, GetUserChatsQuery, userId)
+func (r Repository) GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error) {
+	var chats []models.UserChat
+	rows, err := r.db.QueryContext(ctx, GetUserChatsQuery, userId)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			chat        models.UserChat
+			lastMessage models.Message
+		)
+		err = rows.Scan(
+			&chat.Id, &chat.Type, &chat.Status, &chat.CreatedAt,
+			&lastMessage.Id, &lastMessage.SenderId, &lastMessage.Text, &lastMessage.SendingTime,
+			&chat.UnreadCount)
+		if err != nil {
+			return nil, err
+		}
+
+		if lastMessage.Id != 0 {
+			lastMessage.ChatId = chat.Id
+			chat.LastMessage = &lastMessage
+		}
+		chats = append(chats, chat)
+	}
 
-	return chats, nil
+	return chats, rows.Err()
 }
 
-func (r Repository) CreateChat(meetingId uint, chatType string) error {
-	res, err := r.db.NamedExec(CreateChatQuery, map[string]interface{}{
+func (r Repository) GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error) {
+	ids := make([]int64, len(usersIds))
+	for i, id := range usersIds {
+		ids[i] = int64(id)
+	}
+
+	members := []uint{}
+	err := r.db.SelectContext(ctx, &members, GetChatMembersQuery, chatId, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (r Repository) CreateChat(ctx context.Context, meetingId uint, chatType string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	chatId, err := r.addChat(ctx, tx, meetingId, chatType)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, AddChatMembersQuery, chatId)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r Repository) addChat(ctx context.Context, tx *sqlx.Tx, meetingId uint, chatType string) (uint, error) {
+	rows, err := sqlx.NamedQueryContext(ctx, tx, CreateChatQuery, map[string]interface{}{
 		"meeting_id": meetingId, "type": chatType,
 	})
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return 0, internal_errors.MeetingChatAlreadyExists
+	}
+
+	var chatId uint
+	err = rows.Scan(&chatId)
+	return chatId, err
+}
+
+func (r Repository) CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	res, err := r.db.ExecContext(ctx, CreateMeetingRequestChatQuery, meetingId, applicantId)
+	if err != nil {
+		switch err.Error() {
+		case applicantNotFound:
+			return internal_errors.UnableToFindUserById
+		case requestChatExists:
+			return internal_errors.MeetingRequestChatAlreadyExists
+		default:
+			return err
+		}
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return internal_errors.UnableToFindMeetingById
+	}
+	return nil
+}
+
+func (r Repository) CloseMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	res, err := r.db.ExecContext(ctx, CloseMeetingRequestChatQuery, meetingId, applicantId)
 	if err != nil {
 		return err
 	}
@@ -67,13 +232,13 @@ func (r Repository) CreateChat(meetingId uint, chatType string) error {
 	}
 
 	if rowsAffected == 0 {
-		return internal_errors.MeetingChatAlreadyExists
+		return internal_errors.UnableToFindMeetingRequestChat
 	}
 	return nil
 }
 
-func (r Repository) SetChatStatus(chatId uint, status string) error {
-	res, err := r.db.NamedExec(UpdateChatStatusQuery, map[string]interface{}{
+func (r Repository) SetChatStatus(ctx context.Context, chatId uint, status string) error {
+	res, err := r.db.NamedExecContext(ctx, UpdateChatStatusQuery, map[string]interface{}{
 		"chat_id": chatId, "status": status,
 	})
 	if err != nil {
@@ -90,3 +255,73 @@ func (r Repository) SetChatStatus(chatId uint, status string) error {
 	}
 	return nil
 }
+
+func (r Repository) GetDirectChat(ctx context.Context, firstUserId, secondUserId uint) (models.Chat, error) {
+	var chat models.Chat
+	firstUserId, secondUserId = orderedUsersIds(firstUserId, secondUserId)
+	err := r.db.GetContext(ctx, &chat, GetDirectChatQuery, firstUserId, secondUserId)
+	if err != nil && err.Error() == directChatNotFound {
+		err = internal_errors.UnableToFindDirectChat
+	}
+
+	return chat, err
+}
+
+func (r Repository) CreateDirectChat(ctx context.Context, firstUserId, secondUserId uint) (models.Chat, error) {
+	var chat models.Chat
+	firstUserId, secondUserId = orderedUsersIds(firstUserId, secondUserId)
+	err := r.db.GetContext(ctx, &chat, CreateDirectChatQuery, firstUserId, secondUserId)
+
+	switch {
+	case err == nil:
+		return chat, nil
+	case strings.HasSuffix(err.Error(), userIdNotFoundSuffix):
+		return models.Chat{}, internal_errors.UnableToFindUserById
+	case err.Error() == directChatAlreadyExists:
+		return models.Chat{}, internal_errors.DirectChatAlreadyExists
+	default:
+		return models.Chat{}, err
+	}
+}
+
+// direct chat is stored only once for pair of users, so we always keep smaller id first
+func orderedUsersIds(firstUserId, secondUserId uint) (uint, uint) {
+	if firstUserId > secondUserId {
+		return secondUserId, firstUserId
+	}
+
+	return firstUserId, secondUserId
+}
+
+func (r Repository) GetChatMeetingAdminId(ctx context.Context, chatId uint) (uint, error) {
+	var adminId uint
+	err := r.db.GetContext(ctx, &adminId, GetChatMeetingAdminIdQuery, chatId)
+	if err != nil && err.Error() == chatNotFound {
+		err = internal_errors.UnableToFindChatById
+	}
+
+	return adminId, err
+}
+
+// messages are read one by one, so history of any size can be exported
+func (r Repository) ExportMessages(
+	ctx context.Context, chatId uint, handle func(message models.TranscriptMessage) error,
+) error {
+	rows, err := r.db.QueryxContext(ctx, ExportMessagesQuery, chatId)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var message models.TranscriptMessage
+		if err = rows.StructScan(&message); err != nil {
+			return err
+		}
+		if err = handle(message); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
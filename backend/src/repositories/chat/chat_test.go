@@ -1,6 +1,7 @@
 package chat
 
 import (
+	"context"
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	"internal_errors"
@@ -9,6 +10,7 @@ import (
 	"os"
 	"plugins/config"
 	"testing"
+	"time"
 	"utils"
 )
 
@@ -44,6 +46,26 @@ func getChat(meetingId uint) models.Chat {
 	return chat
 }
 
+func hasOpenedRequestChat(meetingId, applicantId uint) bool {
+	var count int
+	err := db.Get(&count, `
+	SELECT COUNT(*) FROM chats c JOIN chats_members cm ON cm.chat_id = c.id AND cm.user_id = $2
+	WHERE c.meeting_id = $1 AND c.applicant_id = $2 AND c.type = 'meeting_request' AND c.status != 'archived'`,
+		meetingId, applicantId)
+	if err != nil {
+		panic(err)
+	}
+
+	return count != 0
+}
+
+func lastActivity(chat models.UserChat) time.Time {
+	if chat.LastMessage != nil {
+		return chat.LastMessage.SendingTime
+	}
+	return chat.CreatedAt
+}
+
 // we need this function to avoiding DB errors due parallel queries
 func TestMain(t *testing.M) {
 	res := t.Run()
@@ -55,7 +77,7 @@ func TestRepository_GetMeetingChatSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	chat, err := repository.GetMeetingChat(1)
+	chat, err := repository.GetMeetingChat(context.Background(), 1)
 	firstChat := mock.MeetingChats[0]
 
 	utils.AssertNil(err, t)
@@ -67,14 +89,14 @@ func TestRepository_GetMeetingChatNotFound(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	_, err := repository.GetMeetingChat(mock.GetNotExistsMeetingId())
+	_, err := repository.GetMeetingChat(context.Background(), mock.GetNotExistsMeetingId())
 	utils.AssertErrorsEqual(internal_errors.UnableToFindChatByMeetingId, err, t)
 }
 
 func TestRepository_GetMeetingChatSomeError(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetMeetingChat(1)
+	_, err := repository.GetMeetingChat(context.Background(), 1)
 	utils.AssertNotNil(err, t)
 }
 
@@ -82,20 +104,88 @@ func TestRepository_GetUserChatsSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	chats, err := repository.GetUserChats(1)
-	firstChat := mock.MeetingChats[0]
+	chats, err := repository.GetUserChats(context.Background(), 1)
+	lastText, unreadCount := mock.GetChatLastMessageAndUnreadCount(1, 1)
 
 	utils.AssertNil(err, t)
-	utils.AssertEqual(firstChat["type"].(string), chats[0].Type, t)
+	chatsIds := map[uint]bool{}
 	for _, chat := range chats {
 		utils.AssertNotEqual(mock.ArchivedStatus, chat.Status, t)
+		utils.AssertFalse(chatsIds[chat.Id], t)
+		chatsIds[chat.Id] = true
+
+		if chat.Id == 1 {
+			utils.AssertNotNil(chat.LastMessage, t)
+			utils.AssertEqual(lastText, chat.LastMessage.Text, t)
+			utils.AssertEqual(unreadCount, chat.UnreadCount, t)
+		}
+	}
+	utils.AssertTrue(chatsIds[1], t)
+}
+
+func TestRepository_GetUserChatsSortedByActivity(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	chats, err := repository.GetUserChats(context.Background(), 1)
+
+	utils.AssertNil(err, t)
+	for i := 1; i < len(chats); i++ {
+		utils.AssertTrue(!lastActivity(chats[i]).After(lastActivity(chats[i-1])), t)
+	}
+}
+
+func TestRepository_GetUserChatsWithoutMessages(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	firstUserId, _ := mock.GetFirstDirectChatUsers()
+	chats, err := repository.GetUserChats(context.Background(), firstUserId)
+
+	utils.AssertNil(err, t)
+	for _, chat := range chats {
+		if chat.Id == mock.FirstDirectChatId {
+			utils.AssertTrue(chat.LastMessage == nil, t)
+			utils.AssertEqual(uint(0), chat.UnreadCount, t)
+		}
 	}
 }
 
 func TestRepository_GetUserChatsSomeError(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetUserChats(1)
+	_, err := repository.GetUserChats(context.Background(), 1)
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_GetChatMembersSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	members, err := repository.GetChatMembers(context.Background(), mock.FirstRequestChatId,
+		[]uint{mock.UserIdWithoutFirstRequestChat, mock.FirstMeetingApplicantId, 1})
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(2, len(members), t)
+	utils.AssertEqual(uint(1), members[0], t)
+	utils.AssertEqual(mock.FirstMeetingApplicantId, members[1], t)
+}
+
+func TestRepository_GetChatMembersOfNotExistsChat(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	members, err := repository.GetChatMembers(context.Background(), mock.NotExistsChatId, []uint{1})
+
+	utils.AssertNil(err, t)
+	utils.AssertNotNil(members, t)
+	utils.AssertEqual(0, len(members), t)
+}
+
+func TestRepository_GetChatMembersSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	_, err := repository.GetChatMembers(context.Background(), 1, []uint{1})
 	utils.AssertNotNil(err, t)
 }
 
@@ -103,18 +193,18 @@ func TestRepository_CreateChatSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.CreateChat(mock.MeetingIdWithoutMeetingChat, mock.MeetingType)
-	chat, _ := repository.GetMeetingChat(mock.MeetingIdWithoutMeetingChat)
+	err := repository.CreateChat(context.Background(), mock.MeetingIdWithoutMeetingChat, mock.MeetingType)
+	chat, _ := repository.GetMeetingChat(context.Background(), mock.MeetingIdWithoutMeetingChat)
 
 	utils.AssertNil(err, t)
-	utils.AssertEqual(uint(len(mock.MeetingChats)+1), chat.Id, t)
+	utils.AssertEqual(mock.NotExistsChatId, chat.Id, t)
 }
 
 func TestRepository_CreateChatMeetingAlreadyHasMeetingChat(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.CreateChat(1, mock.MeetingType)
+	err := repository.CreateChat(context.Background(), 1, mock.MeetingType)
 
 	utils.AssertErrorsEqual(internal_errors.MeetingChatAlreadyExists, err, t)
 }
@@ -122,7 +212,91 @@ func TestRepository_CreateChatMeetingAlreadyHasMeetingChat(t *testing.T) {
 func TestRepository_CreateChatSomeError(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.CreateChat(1, mock.MeetingType)
+	err := repository.CreateChat(context.Background(), 1, mock.MeetingType)
+
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_CreateMeetingRequestChatSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.CreateMeetingRequestChat(context.Background(), 1, mock.UserIdWithoutFirstRequestChat)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(hasOpenedRequestChat(1, mock.UserIdWithoutFirstRequestChat), t)
+}
+
+func TestRepository_CreateMeetingRequestChatAlreadyExists(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.CreateMeetingRequestChat(context.Background(), 1, mock.FirstMeetingApplicantId)
+
+	utils.AssertErrorsEqual(internal_errors.MeetingRequestChatAlreadyExists, err, t)
+}
+
+func TestRepository_CreateMeetingRequestChatAfterDecline(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_ = repository.CloseMeetingRequestChat(context.Background(), 1, mock.FirstMeetingApplicantId)
+	err := repository.CreateMeetingRequestChat(context.Background(), 1, mock.FirstMeetingApplicantId)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(hasOpenedRequestChat(1, mock.FirstMeetingApplicantId), t)
+}
+
+func TestRepository_CreateMeetingRequestChatMeetingNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.CreateMeetingRequestChat(
+		context.Background(), mock.GetNotExistsMeetingId(), mock.UserIdWithoutFirstRequestChat)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindMeetingById, err, t)
+}
+
+func TestRepository_CreateMeetingRequestChatUserNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.CreateMeetingRequestChat(context.Background(), 1, mock.GetNotExistsUserId())
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
+}
+
+func TestRepository_CreateMeetingRequestChatSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	err := repository.CreateMeetingRequestChat(context.Background(), 1, mock.UserIdWithoutFirstRequestChat)
+
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_CloseMeetingRequestChatSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.CloseMeetingRequestChat(context.Background(), 1, mock.FirstMeetingApplicantId)
+
+	utils.AssertNil(err, t)
+	utils.AssertFalse(hasOpenedRequestChat(1, mock.FirstMeetingApplicantId), t)
+}
+
+func TestRepository_CloseMeetingRequestChatNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.CloseMeetingRequestChat(context.Background(), 1, mock.UserIdWithoutFirstRequestChat)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindMeetingRequestChat, err, t)
+}
+
+func TestRepository_CloseMeetingRequestChatSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	err := repository.CloseMeetingRequestChat(context.Background(), 1, mock.FirstMeetingApplicantId)
 
 	utils.AssertNotNil(err, t)
 }
@@ -131,7 +305,7 @@ func TestRepository_SetChatStatusSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.SetChatStatus(1, mock.ArchivedStatus)
+	err := repository.SetChatStatus(context.Background(), 1, mock.ArchivedStatus)
 	chat := getChat(1)
 
 	utils.AssertNil(err, t)
@@ -142,7 +316,7 @@ func TestRepository_SetChatStatusChatNotFound(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.SetChatStatus(mock.NotExistsChatId, mock.ArchivedStatus)
+	err := repository.SetChatStatus(context.Background(), mock.NotExistsChatId, mock.ArchivedStatus)
 
 	utils.AssertErrorsEqual(internal_errors.UnableToFindChatById, err, t)
 }
@@ -150,7 +324,141 @@ func TestRepository_SetChatStatusChatNotFound(t *testing.T) {
 func TestRepository_SetChatStatusSomeError(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.SetChatStatus(1, mock.ArchivedStatus)
+	err := repository.SetChatStatus(context.Background(), 1, mock.ArchivedStatus)
+
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_GetUserChatsWithDirectChat(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	chats, err := repository.GetUserChats(context.Background(), mock.UserIdWithoutDirectChats)
+
+	utils.AssertNil(err, t)
+	for _, chat := range chats {
+		utils.AssertNotEqual(mock.DirectType, chat.Type, t)
+	}
+
+	firstUserId, _ := mock.GetFirstDirectChatUsers()
+	chats, err = repository.GetUserChats(context.Background(), firstUserId)
+	var directChatFound bool
+	for _, chat := range chats {
+		directChatFound = directChatFound || chat.Id == mock.FirstDirectChatId
+	}
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(directChatFound, t)
+}
+
+func TestRepository_GetDirectChatSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	firstUserId, secondUserId := mock.GetFirstDirectChatUsers()
+	chat, err := repository.GetDirectChat(context.Background(), secondUserId, firstUserId)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(mock.FirstDirectChatId, chat.Id, t)
+	utils.AssertEqual(mock.DirectType, chat.Type, t)
+}
+
+func TestRepository_GetDirectChatNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.GetDirectChat(context.Background(), 1, mock.UserIdWithoutDirectChats)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindDirectChat, err, t)
+}
+
+func TestRepository_GetDirectChatSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	_, err := repository.GetDirectChat(context.Background(), 1, 2)
+
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_CreateDirectChatSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	chat, err := repository.CreateDirectChat(context.Background(), mock.UserIdWithoutDirectChats, 1)
+	foundChat, _ := repository.GetDirectChat(context.Background(), 1, mock.UserIdWithoutDirectChats)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(mock.NotExistsChatId, chat.Id, t)
+	utils.AssertEqual(chat.Id, foundChat.Id, t)
+}
+
+func TestRepository_CreateDirectChatAlreadyExists(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	firstUserId, secondUserId := mock.GetFirstDirectChatUsers()
+	_, err := repository.CreateDirectChat(context.Background(), firstUserId, secondUserId)
+
+	utils.AssertErrorsEqual(internal_errors.DirectChatAlreadyExists, err, t)
+}
+
+func TestRepository_CreateDirectChatUserNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.CreateDirectChat(context.Background(), 1, mock.GetNotExistsUserId())
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
+}
+
+func TestRepository_CreateDirectChatSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	_, err := repository.CreateDirectChat(context.Background(), 1, 2)
 
 	utils.AssertNotNil(err, t)
 }
+
+func TestRepository_GetChatMeetingAdminIdSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	adminId, err := repository.GetChatMeetingAdminId(context.Background(), 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(mock.GetChatMeetingAdminId(1), adminId, t)
+}
+
+func TestRepository_GetChatMeetingAdminIdDirectChat(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	adminId, err := repository.GetChatMeetingAdminId(context.Background(), mock.FirstDirectChatId)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(uint(0), adminId, t)
+}
+
+func TestRepository_GetChatMeetingAdminIdChatNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.GetChatMeetingAdminId(context.Background(), mock.NotExistsChatId)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindChatById, err, t)
+}
+
+func TestRepository_ExportMessages(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	var messages []models.TranscriptMessage
+	err := repository.ExportMessages(context.Background(), 1, func(message models.TranscriptMessage) error {
+		messages = append(messages, message)
+		return nil
+	})
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(mock.GetChatMessagesCount(1), len(messages), t)
+	utils.AssertEqual(mock.GetUserNickname(messages[0].SenderId), messages[0].SenderNickname, t)
+}
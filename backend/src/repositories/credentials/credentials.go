@@ -1,6 +1,7 @@
 package credentials
 
 import (
+	"context"
 	"database/sql"
 	"github.com/jmoiron/sqlx"
 	"internal_errors"
@@ -31,9 +32,9 @@ func New(db *sqlx.DB) Repository {
 	return Repository{db}
 }
 
-func (r Repository) CreateUser(user models.UserCredentials) error {
+func (r Repository) CreateUser(ctx context.Context, user models.UserCredentials) error {
 	var insertedUserId uint
-	err := r.db.Get(&insertedUserId, AddUserQuery, user.Email)
+	err := r.db.GetContext(ctx, &insertedUserId, AddUserQuery, user.Email)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			err = internal_errors.UnableToRegisterUserEmailExists
@@ -42,7 +43,7 @@ func (r Repository) CreateUser(user models.UserCredentials) error {
 		return err
 	}
 
-	_, err = r.db.NamedExec(AddUserCredentialsQuery, map[string]interface{}{
+	_, err = r.db.NamedExecContext(ctx, AddUserCredentialsQuery, map[string]interface{}{
 		"user_id":  insertedUserId,
 		"email":    user.Email,
 		"password": user.Password,
@@ -50,9 +51,9 @@ func (r Repository) CreateUser(user models.UserCredentials) error {
 	return err
 }
 
-func (r Repository) GetUserIdByCredentials(user models.UserCredentials) (uint, error) {
+func (r Repository) GetUserIdByCredentials(ctx context.Context, user models.UserCredentials) (uint, error) {
 	var id uint
-	rows, err := r.db.NamedQuery(UserIdByCredentialsQuery, user)
+	rows, err := r.db.NamedQueryContext(ctx, UserIdByCredentialsQuery, user)
 	if err != nil {
 		return 0, err
 	}
@@ -66,8 +67,8 @@ func (r Repository) GetUserIdByCredentials(user models.UserCredentials) (uint, e
 	return id, err
 }
 
-func (r Repository) UpdateUserPassword(user models.UserCredentials) error {
-	res, err := r.db.NamedExec(UpdateUserPasswordQuery, user)
+func (r Repository) UpdateUserPassword(ctx context.Context, user models.UserCredentials) error {
+	res, err := r.db.NamedExecContext(ctx, UpdateUserPasswordQuery, user)
 	if err != nil {
 		return err
 	}
@@ -82,9 +83,9 @@ func (r Repository) UpdateUserPassword(user models.UserCredentials) error {
 	return nil
 }
 
-func (r Repository) GetUserEmail(userId uint) (string, error) {
+func (r Repository) GetUserEmail(ctx context.Context, userId uint) (string, error) {
 	var email string
-	rows, err := r.db.Query(UserEmailByIdQuery, userId)
+	rows, err := r.db.QueryContext(ctx, UserEmailByIdQuery, userId)
 	if err != nil {
 		return "", err
 	}
@@ -1,6 +1,7 @@
 package credentials
 
 import (
+	"context"
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
@@ -41,7 +42,7 @@ func TestRepository_GetUserIdByCredentialsSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	id, err := repository.GetUserIdByCredentials(mock.GetFirstUser())
+	id, err := repository.GetUserIdByCredentials(context.Background(), mock.GetFirstUser())
 
 	utils.AssertNil(err, t)
 	utils.AssertEqual(1, int(id), t)
@@ -51,7 +52,7 @@ func TestRepository_GetUserIdByCredentialsErrorUserNotExists(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	_, err := repository.GetUserIdByCredentials(mock.NotExistsUser)
+	_, err := repository.GetUserIdByCredentials(context.Background(), mock.NotExistsUser)
 
 	utils.AssertErrorsEqual(internal_errors.UnableToLoginUserNotFound, err, t)
 }
@@ -59,7 +60,7 @@ func TestRepository_GetUserIdByCredentialsErrorUserNotExists(t *testing.T) {
 func TestRepository_GetUserIdByCredentialsErrorNoTable(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetUserIdByCredentials(mock.GetFirstUser())
+	_, err := repository.GetUserIdByCredentials(context.Background(), mock.GetFirstUser())
 	utils.AssertNotNil(err, t)
 }
 
@@ -67,10 +68,10 @@ func TestRepository_CreateUserSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.CreateUser(mock.NewUser)
+	err := repository.CreateUser(context.Background(), mock.NewUser)
 	utils.AssertNil(err, t)
 
-	id, _ := repository.GetUserIdByCredentials(mock.NewUser)
+	id, _ := repository.GetUserIdByCredentials(context.Background(), mock.NewUser)
 	utils.AssertEqual(mock.GetNextUserId(), id, t)
 }
 
@@ -78,7 +79,7 @@ func TestRepository_CreateUserEmailExistsError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.CreateUser(mock.GetFirstUser())
+	err := repository.CreateUser(context.Background(), mock.GetFirstUser())
 	utils.AssertErrorsEqual(internal_errors.UnableToRegisterUserEmailExists, err, t)
 }
 
@@ -88,10 +89,10 @@ func TestRepository_UpdateUserPasswordSuccess(t *testing.T) {
 
 	user := mock.GetFirstUser()
 	user.Password = "new_pass"
-	err := repository.UpdateUserPassword(user)
+	err := repository.UpdateUserPassword(context.Background(), user)
 	utils.AssertNil(err, t)
 
-	id, _ := repository.GetUserIdByCredentials(user)
+	id, _ := repository.GetUserIdByCredentials(context.Background(), user)
 	utils.AssertEqual(1, int(id), t)
 }
 
@@ -99,14 +100,14 @@ func TestRepository_UpdateUserPasswordUserNotFoundError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.UpdateUserPassword(mock.NotExistsUser)
+	err := repository.UpdateUserPassword(context.Background(), mock.NotExistsUser)
 	utils.AssertErrorsEqual(internal_errors.UnableToChangePasswordUserNotFound, err, t)
 }
 
 func TestRepository_UpdateUserPasswordErrorNoTable(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.UpdateUserPassword(mock.GetFirstUser())
+	err := repository.UpdateUserPassword(context.Background(), mock.GetFirstUser())
 	utils.AssertNotNil(err, t)
 }
 
@@ -114,7 +115,7 @@ func TestRepository_GetUserEmailSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	email, err := repository.GetUserEmail(1)
+	email, err := repository.GetUserEmail(context.Background(), 1)
 	utils.AssertNil(err, t)
 	utils.AssertEqual(mock.GetFirstUser().Email, email, t)
 }
@@ -123,13 +124,13 @@ func TestRepository_GetUserEmailUserNotFoundError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	_, err := repository.GetUserEmail(mock.GetNotExistsUserId())
+	_, err := repository.GetUserEmail(context.Background(), mock.GetNotExistsUserId())
 	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
 }
 
 func TestRepository_GetUserEmailErrorNoTable(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetUserEmail(1)
+	_, err := repository.GetUserEmail(context.Background(), 1)
 	utils.AssertNotNil(err, t)
 }
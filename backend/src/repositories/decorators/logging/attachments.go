@@ -0,0 +1,160 @@
+package logging
+
+import (
+	"context"
+	"interfaces"
+	"internal_errors"
+	"io"
+	"models"
+	"plugins/logger"
+	"time"
+)
+
+type AttachmentsRepositoryDecorator struct {
+	repository interfaces.AttachmentsRepository
+}
+
+func NewAttachmentsRepositoryDecorator(
+	repository interfaces.AttachmentsRepository) AttachmentsRepositoryDecorator {
+	return AttachmentsRepositoryDecorator{repository}
+}
+
+func (d AttachmentsRepositoryDecorator) SaveAttachment(
+	ctx context.Context, attachment models.Attachment,
+) (models.Attachment, error) {
+	savedAttachment, err := d.repository.SaveAttachment(ctx, attachment)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while saving attachment: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"attachment": attachment,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return savedAttachment, err
+}
+
+func (d AttachmentsRepositoryDecorator) GetAttachment(
+	ctx context.Context, attachmentId uint,
+) (models.Attachment, error) {
+	attachment, err := d.repository.GetAttachment(ctx, attachmentId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting attachment: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"attachment_id": attachmentId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return attachment, err
+}
+
+func (d AttachmentsRepositoryDecorator) IsChatMember(ctx context.Context, chatId, userId uint) (bool, error) {
+	isMember, err := d.repository.IsChatMember(ctx, chatId, userId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while checking chat member: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"chat_id": chatId,
+				"user_id": userId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return isMember, err
+}
+
+func (d AttachmentsRepositoryDecorator) GetOrphanAttachments(
+	ctx context.Context, olderThan time.Duration,
+) ([]models.Attachment, error) {
+	attachments, err := d.repository.GetOrphanAttachments(ctx, olderThan)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting orphan attachments: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"older_than": olderThan.String(),
+			},
+		}, logger.WarningLevel)
+	}
+
+	return attachments, err
+}
+
+func (d AttachmentsRepositoryDecorator) DeleteAttachments(ctx context.Context, attachmentIds []uint) error {
+	err := d.repository.DeleteAttachments(ctx, attachmentIds)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while deleting attachments: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"attachment_ids": attachmentIds,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return err
+}
+
+type BlobStoreDecorator struct {
+	store interfaces.BlobStore
+}
+
+func NewBlobStoreDecorator(store interfaces.BlobStore) BlobStoreDecorator {
+	return BlobStoreDecorator{store}
+}
+
+func (d BlobStoreDecorator) Save(ctx context.Context, blobId string, content io.Reader) error {
+	err := d.store.Save(ctx, blobId, content)
+	if err != nil {
+		logBlobError(ctx, "Error while saving blob: %v", blobId, err)
+	}
+
+	return err
+}
+
+// not found blob is expected error and is not logged
+func (d BlobStoreDecorator) Open(ctx context.Context, blobId string) (io.ReadCloser, error) {
+	content, err := d.store.Open(ctx, blobId)
+	if err != nil && err != internal_errors.UnableToFindBlob {
+		logBlobError(ctx, "Error while opening blob: %v", blobId, err)
+	}
+
+	return content, err
+}
+
+func (d BlobStoreDecorator) Delete(ctx context.Context, blobId string) error {
+	err := d.store.Delete(ctx, blobId)
+	if err != nil {
+		logBlobError(ctx, "Error while deleting blob: %v", blobId, err)
+	}
+
+	return err
+}
+
+func logBlobError(ctx context.Context, messageTemplate, blobId string, err error) {
+	logger.WithFields(ctx, logger.Fields{
+		MessageTemplate: messageTemplate,
+		Args: []interface{}{
+			err,
+		},
+		Optional: map[string]interface{}{
+			"blob_id": blobId,
+		},
+	}, logger.WarningLevel)
+}
@@ -1,6 +1,7 @@
 package logging
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"plugins/logger"
@@ -14,10 +15,10 @@ func NewChatRepositoryDecorator(repository interfaces.FullChatsRepository) ChatR
 	return ChatRepositoryDecorator{repository}
 }
 
-func (d ChatRepositoryDecorator) GetMeetingChat(meetingId uint) (models.Chat, error) {
-	chat, err := d.repository.GetMeetingChat(meetingId)
+func (d ChatRepositoryDecorator) GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error) {
+	chat, err := d.repository.GetMeetingChat(ctx, meetingId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting meeting chat by meeting id: %v",
 			Args: []interface{}{
 				err,
@@ -25,16 +26,16 @@ func (d ChatRepositoryDecorator) GetMeetingChat(meetingId uint) (models.Chat, er
 			Optional: map[string]interface{}{
 				"meeting_id": meetingId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return chat, err
 }
 
-func (d ChatRepositoryDecorator) GetUserChats(userId uint) ([]models.Chat, error) {
-	chats, err := d.repository.GetUserChats(userId)
+func (d ChatRepositoryDecorator) GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error) {
+	chats, err := d.repository.GetUserChats(ctx, userId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting user chats by user id: %v",
 			Args: []interface{}{
 				err,
@@ -42,16 +43,34 @@ func (d ChatRepositoryDecorator) GetUserChats(userId uint) ([]models.Chat, error
 			Optional: map[string]interface{}{
 				"user_id": userId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return chats, err
 }
 
-func (d ChatRepositoryDecorator) CreateChat(meetingId uint, chatType string) error {
-	err := d.repository.CreateChat(meetingId, chatType)
+func (d ChatRepositoryDecorator) GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error) {
+	members, err := d.repository.GetChatMembers(ctx, chatId, usersIds)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting chat members by chat id: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"chat_id":   chatId,
+				"users_ids": usersIds,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return members, err
+}
+
+func (d ChatRepositoryDecorator) CreateChat(ctx context.Context, meetingId uint, chatType string) error {
+	err := d.repository.CreateChat(ctx, meetingId, chatType)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while creating chat: %v",
 			Args: []interface{}{
 				err,
@@ -60,16 +79,52 @@ func (d ChatRepositoryDecorator) CreateChat(meetingId uint, chatType string) err
 				"meeting_id": meetingId,
 				"chat_type":  chatType,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
+	}
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	err := d.repository.CreateMeetingRequestChat(ctx, meetingId, applicantId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while creating meeting request chat: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"meeting_id":   meetingId,
+				"applicant_id": applicantId,
+			},
+		}, logger.WarningLevel)
 	}
 
 	return err
 }
 
-func (d ChatRepositoryDecorator) SetChatStatus(chatId uint, status string) error {
-	err := d.repository.SetChatStatus(chatId, status)
+func (d ChatRepositoryDecorator) CloseMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	err := d.repository.CloseMeetingRequestChat(ctx, meetingId, applicantId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while closing meeting request chat: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"meeting_id":   meetingId,
+				"applicant_id": applicantId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) SetChatStatus(ctx context.Context, chatId uint, status string) error {
+	err := d.repository.SetChatStatus(ctx, chatId, status)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while setting chat status: %v",
 			Args: []interface{}{
 				err,
@@ -78,7 +133,82 @@ func (d ChatRepositoryDecorator) SetChatStatus(chatId uint, status string) error
 				"chat_id":     chatId,
 				"chat_status": status,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
+	}
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) GetDirectChat(
+	ctx context.Context, firstUserId, secondUserId uint,
+) (models.Chat, error) {
+	chat, err := d.repository.GetDirectChat(ctx, firstUserId, secondUserId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting direct chat: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"first_user_id":  firstUserId,
+				"second_user_id": secondUserId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return chat, err
+}
+
+func (d ChatRepositoryDecorator) CreateDirectChat(
+	ctx context.Context, firstUserId, secondUserId uint,
+) (models.Chat, error) {
+	chat, err := d.repository.CreateDirectChat(ctx, firstUserId, secondUserId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while creating direct chat: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"first_user_id":  firstUserId,
+				"second_user_id": secondUserId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return chat, err
+}
+
+func (d ChatRepositoryDecorator) GetChatMeetingAdminId(ctx context.Context, chatId uint) (uint, error) {
+	adminId, err := d.repository.GetChatMeetingAdminId(ctx, chatId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting chat meeting admin id: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"chat_id": chatId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return adminId, err
+}
+
+func (d ChatRepositoryDecorator) ExportMessages(
+	ctx context.Context, chatId uint, handle func(message models.TranscriptMessage) error) error {
+	err := d.repository.ExportMessages(ctx, chatId, handle)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while exporting chat messages: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"chat_id": chatId,
+			},
+		}, logger.WarningLevel)
 	}
 
 	return err
@@ -1,6 +1,7 @@
 package logging
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"plugins/logger"
@@ -14,10 +15,10 @@ func NewCredentialsRepositoryDecorator(repository interfaces.CredentialsReposito
 	return CredentialsRepositoryDecorator{repository}
 }
 
-func (d CredentialsRepositoryDecorator) CreateUser(user models.UserCredentials) error {
-	err := d.repository.CreateUser(user)
+func (d CredentialsRepositoryDecorator) CreateUser(ctx context.Context, user models.UserCredentials) error {
+	err := d.repository.CreateUser(ctx, user)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while creating user: %v",
 			Args: []interface{}{
 				err,
@@ -25,16 +26,18 @@ func (d CredentialsRepositoryDecorator) CreateUser(user models.UserCredentials)
 			Optional: map[string]interface{}{
 				"credentials": user,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return err
 }
 
-func (d CredentialsRepositoryDecorator) GetUserIdByCredentials(user models.UserCredentials) (uint, error) {
-	userId, err := d.repository.GetUserIdByCredentials(user)
+func (d CredentialsRepositoryDecorator) GetUserIdByCredentials(
+	ctx context.Context, user models.UserCredentials,
+) (uint, error) {
+	userId, err := d.repository.GetUserIdByCredentials(ctx, user)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting user id by credentials: %v",
 			Args: []interface{}{
 				err,
@@ -42,16 +45,16 @@ func (d CredentialsRepositoryDecorator) GetUserIdByCredentials(user models.UserC
 			Optional: map[string]interface{}{
 				"credentials": user,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return userId, err
 }
 
-func (d CredentialsRepositoryDecorator) UpdateUserPassword(user models.UserCredentials) error {
-	err := d.repository.UpdateUserPassword(user)
+func (d CredentialsRepositoryDecorator) UpdateUserPassword(ctx context.Context, user models.UserCredentials) error {
+	err := d.repository.UpdateUserPassword(ctx, user)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while updating user password: %v",
 			Args: []interface{}{
 				err,
@@ -59,16 +62,16 @@ func (d CredentialsRepositoryDecorator) UpdateUserPassword(user models.UserCrede
 			Optional: map[string]interface{}{
 				"credentials": user,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return err
 }
 
-func (d CredentialsRepositoryDecorator) GetUserEmail(userId uint) (string, error) {
-	email, err := d.repository.GetUserEmail(userId)
+func (d CredentialsRepositoryDecorator) GetUserEmail(ctx context.Context, userId uint) (string, error) {
+	email, err := d.repository.GetUserEmail(ctx, userId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting user email: %v",
 			Args: []interface{}{
 				err,
@@ -76,7 +79,7 @@ func (d CredentialsRepositoryDecorator) GetUserEmail(userId uint) (string, error
 			Optional: map[string]interface{}{
 				"user_id": userId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return email, err
@@ -1,6 +1,7 @@
 package logging
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"plugins/logger"
@@ -14,10 +15,12 @@ func NewMeetingsRepositoryDecorator(repository interfaces.FullMeetingsRepository
 	return MeetingsRepositoryDecorator{repository}
 }
 
-func (d MeetingsRepositoryDecorator) GetFullMeetingInfo(meetingId uint) (models.PrivateMeeting, error) {
-	meeting, err := d.repository.GetFullMeetingInfo(meetingId)
+func (d MeetingsRepositoryDecorator) GetFullMeetingInfo(
+	ctx context.Context, meetingId uint,
+) (models.PrivateMeeting, error) {
+	meeting, err := d.repository.GetFullMeetingInfo(ctx, meetingId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting full meeting info: %v",
 			Args: []interface{}{
 				err,
@@ -25,31 +28,31 @@ func (d MeetingsRepositoryDecorator) GetFullMeetingInfo(meetingId uint) (models.
 			Optional: map[string]interface{}{
 				"meeting_id": meetingId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return meeting, err
 }
 
-func (d MeetingsRepositoryDecorator) GetPublicMeetings() ([]models.PublicMeeting, error) {
-	meetings, err := d.repository.GetPublicMeetings()
+func (d MeetingsRepositoryDecorator) GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error) {
+	meetings, err := d.repository.GetPublicMeetings(ctx)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting public meetings: %v",
 			Args: []interface{}{
 				err,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return meetings, err
 }
 
 func (d MeetingsRepositoryDecorator) GetExtendedMeetings(
-	userStatusesData models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error) {
-	meetings, err := d.repository.GetExtendedMeetings(userStatusesData)
+	ctx context.Context, userStatusesData models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error) {
+	meetings, err := d.repository.GetExtendedMeetings(ctx, userStatusesData)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting extended meetings: %v",
 			Args: []interface{}{
 				err,
@@ -57,16 +60,18 @@ func (d MeetingsRepositoryDecorator) GetExtendedMeetings(
 			Optional: map[string]interface{}{
 				"user_id": userStatusesData.UserId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return meetings, err
 }
 
-func (d MeetingsRepositoryDecorator) CreateMeeting(adminId uint, settings models.AllSettings) error {
-	err := d.repository.CreateMeeting(adminId, settings)
+func (d MeetingsRepositoryDecorator) CreateMeeting(
+	ctx context.Context, adminId uint, settings models.AllSettings,
+) error {
+	err := d.repository.CreateMeeting(ctx, adminId, settings)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while creating meeting: %v",
 			Args: []interface{}{
 				err,
@@ -75,16 +80,16 @@ func (d MeetingsRepositoryDecorator) CreateMeeting(adminId uint, settings models
 				"admin_id": adminId,
 				"settings": settings,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return err
 }
 
-func (d MeetingsRepositoryDecorator) DeleteMeeting(meetingId uint) error {
-	err := d.repository.DeleteMeeting(meetingId)
+func (d MeetingsRepositoryDecorator) DeleteMeeting(ctx context.Context, meetingId uint) error {
+	err := d.repository.DeleteMeeting(ctx, meetingId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while deleting meeting: %v",
 			Args: []interface{}{
 				err,
@@ -92,16 +97,18 @@ func (d MeetingsRepositoryDecorator) DeleteMeeting(meetingId uint) error {
 			Optional: map[string]interface{}{
 				"meeting_id": meetingId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return err
 }
 
-func (d MeetingsRepositoryDecorator) UpdateSettings(meetingId uint, settings models.AllSettings) error {
-	err := d.repository.UpdateSettings(meetingId, settings)
+func (d MeetingsRepositoryDecorator) UpdateSettings(
+	ctx context.Context, meetingId uint, settings models.AllSettings,
+) error {
+	err := d.repository.UpdateSettings(ctx, meetingId, settings)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while updating settings: %v",
 			Args: []interface{}{
 				err,
@@ -110,16 +117,16 @@ func (d MeetingsRepositoryDecorator) UpdateSettings(meetingId uint, settings mod
 				"meeting_id": meetingId,
 				"settings":   settings,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return err
 }
 
-func (d MeetingsRepositoryDecorator) AddUserToMeeting(meetingId, userId uint) error {
-	err := d.repository.AddUserToMeeting(meetingId, userId)
+func (d MeetingsRepositoryDecorator) AddUserToMeeting(ctx context.Context, meetingId, userId uint) error {
+	err := d.repository.AddUserToMeeting(ctx, meetingId, userId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while adding user to meeting: %v",
 			Args: []interface{}{
 				err,
@@ -128,16 +135,16 @@ func (d MeetingsRepositoryDecorator) AddUserToMeeting(meetingId, userId uint) er
 				"meeting_id": meetingId,
 				"user_id":    userId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return err
 }
 
-func (d MeetingsRepositoryDecorator) KickUserFromMeeting(meetingId, userId uint) error {
-	err := d.repository.KickUserFromMeeting(meetingId, userId)
+func (d MeetingsRepositoryDecorator) KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error {
+	err := d.repository.KickUserFromMeeting(ctx, meetingId, userId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while kicking user from meeting: %v",
 			Args: []interface{}{
 				err,
@@ -146,7 +153,7 @@ func (d MeetingsRepositoryDecorator) KickUserFromMeeting(meetingId, userId uint)
 				"meeting_id": meetingId,
 				"user_id":    userId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return err
@@ -1,6 +1,7 @@
 package logging
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"plugins/logger"
@@ -16,10 +17,10 @@ func NewMeetingsSettingsRepositoryDecorator(
 }
 
 func (d MeetingsSettingsRepositoryDecorator) GetMeetingSettings(
-	meetingId uint) (models.ParticipationMeetingSettings, error) {
-	settings, err := d.repository.GetMeetingSettings(meetingId)
+	ctx context.Context, meetingId uint) (models.ParticipationMeetingSettings, error) {
+	settings, err := d.repository.GetMeetingSettings(ctx, meetingId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting meeting settings: %v",
 			Args: []interface{}{
 				err,
@@ -27,17 +28,17 @@ func (d MeetingsSettingsRepositoryDecorator) GetMeetingSettings(
 			Optional: map[string]interface{}{
 				"meeting_id": meetingId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return settings, err
 }
 
 func (d MeetingsSettingsRepositoryDecorator) GetNearMeetings(
-	data models.UserTimeCheckData) ([]models.TimeMeetingParameters, error) {
-	parameters, err := d.repository.GetNearMeetings(data)
+	ctx context.Context, data models.UserTimeCheckData) ([]models.TimeMeetingParameters, error) {
+	parameters, err := d.repository.GetNearMeetings(ctx, data)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting near meeting: %v",
 			Args: []interface{}{
 				err,
@@ -45,7 +46,7 @@ func (d MeetingsSettingsRepositoryDecorator) GetNearMeetings(
 			Optional: map[string]interface{}{
 				"time_check_data": data,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return parameters, err
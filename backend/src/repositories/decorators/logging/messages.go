@@ -1,23 +1,24 @@
 package logging
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"plugins/logger"
 )
 
 type MessagesRepositoryDecorator struct {
-	repository interfaces.Messages
+	repository interfaces.FullMessagesRepository
 }
 
-func NewMessagesRepositoryDecorator(repository interfaces.Messages) MessagesRepositoryDecorator {
+func NewMessagesRepositoryDecorator(repository interfaces.FullMessagesRepository) MessagesRepositoryDecorator {
 	return MessagesRepositoryDecorator{repository}
 }
 
-func (d MessagesRepositoryDecorator) Save(message models.Message) error {
-	err := d.repository.Save(message)
+func (d MessagesRepositoryDecorator) Save(ctx context.Context, message models.Message) (models.Message, error) {
+	savedMessage, err := d.repository.Save(ctx, message)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while saving message: %v",
 			Args: []interface{}{
 				err,
@@ -25,36 +26,39 @@ func (d MessagesRepositoryDecorator) Save(message models.Message) error {
 			Optional: map[string]interface{}{
 				"message": message,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
-	return err
+	return savedMessage, err
 }
 
-func (d MessagesRepositoryDecorator) GetLastMessages(chatId, count uint) ([]models.Message, error) {
-	messages, err := d.repository.GetLastMessages(chatId, count)
+func (d MessagesRepositoryDecorator) GetLastMessages(
+	ctx context.Context, chatId, count, viewerId uint,
+) ([]models.Message, error) {
+	messages, err := d.repository.GetLastMessages(ctx, chatId, count, viewerId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting messages: %v",
 			Args: []interface{}{
 				err,
 			},
 			Optional: map[string]interface{}{
-				"chat_id": chatId,
-				"count":   count,
+				"chat_id":   chatId,
+				"count":     count,
+				"viewer_id": viewerId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return messages, err
 }
 
 func (d MessagesRepositoryDecorator) GetLastMessagesAfter(
-	chatId, messageId, count uint,
+	ctx context.Context, chatId, messageId, count, viewerId uint,
 ) ([]models.Message, error) {
-	messages, err := d.repository.GetLastMessagesAfter(chatId, messageId, count)
+	messages, err := d.repository.GetLastMessagesAfter(ctx, chatId, messageId, count, viewerId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting messages after date: %v",
 			Args: []interface{}{
 				err,
@@ -63,9 +67,221 @@ func (d MessagesRepositoryDecorator) GetLastMessagesAfter(
 				"chat_id":    chatId,
 				"message_id": messageId,
 				"count":      count,
+				"viewer_id":  viewerId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return messages, err
 }
+
+func (d MessagesRepositoryDecorator) GetThread(
+	ctx context.Context, rootId, afterId, count, viewerId uint,
+) (models.Thread, error) {
+	thread, err := d.repository.GetThread(ctx, rootId, afterId, count, viewerId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting thread: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"root_id":   rootId,
+				"after_id":  afterId,
+				"count":     count,
+				"viewer_id": viewerId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return thread, err
+}
+
+func (d MessagesRepositoryDecorator) GetManagedMessage(
+	ctx context.Context, messageId uint,
+) (models.ManagedMessage, error) {
+	message, err := d.repository.GetManagedMessage(ctx, messageId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting message: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"message_id": messageId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) EditMessage(
+	ctx context.Context, messageId uint, text string,
+) (models.Message, error) {
+	message, err := d.repository.EditMessage(ctx, messageId, text)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while editing message: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"message_id": messageId,
+				"text":       text,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) DeleteMessage(ctx context.Context, messageId uint) (models.Message, error) {
+	message, err := d.repository.DeleteMessage(ctx, messageId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while deleting message: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"message_id": messageId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) GetMessageEdits(
+	ctx context.Context, messageId uint,
+) ([]models.MessageEdit, error) {
+	edits, err := d.repository.GetMessageEdits(ctx, messageId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting message edits: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"message_id": messageId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return edits, err
+}
+
+func (d MessagesRepositoryDecorator) SetMessagePinned(
+	ctx context.Context, messageId uint, pinned bool,
+) (models.Message, error) {
+	message, err := d.repository.SetMessagePinned(ctx, messageId, pinned)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while setting message pinned: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"message_id": messageId,
+				"pinned":     pinned,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error) {
+	messages, err := d.repository.GetPinnedMessages(ctx, chatId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting pinned messages: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"chat_id": chatId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return messages, err
+}
+
+func (d MessagesRepositoryDecorator) AddReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	change, err := d.repository.AddReaction(ctx, reaction)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while adding reaction: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"reaction": reaction,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return change, err
+}
+
+func (d MessagesRepositoryDecorator) RemoveReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	change, err := d.repository.RemoveReaction(ctx, reaction)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while removing reaction: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"reaction": reaction,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return change, err
+}
+
+func (d MessagesRepositoryDecorator) SearchMessages(
+	ctx context.Context, query models.SearchQuery,
+) ([]models.SearchHit, error) {
+	hits, err := d.repository.SearchMessages(ctx, query)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while searching messages: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"query": query,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return hits, err
+}
+
+func (d MessagesRepositoryDecorator) GetNotifications(
+	ctx context.Context, userId, count uint,
+) ([]models.Notification, error) {
+	notifications, err := d.repository.GetNotifications(ctx, userId, count)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting notifications: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"user_id": userId,
+				"count":   count,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return notifications, err
+}
@@ -0,0 +1,35 @@
+package logging
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/logger"
+)
+
+type ModerationRepositoryDecorator struct {
+	repository interfaces.ModerationRepository
+}
+
+func NewModerationRepositoryDecorator(repository interfaces.ModerationRepository) ModerationRepositoryDecorator {
+	return ModerationRepositoryDecorator{repository}
+}
+
+func (d ModerationRepositoryDecorator) FlagContent(ctx context.Context, flag models.ContentFlag) error {
+	err := d.repository.FlagContent(ctx, flag)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while flagging content for moderation: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"kind":      flag.Kind,
+				"source_id": flag.SourceId,
+				"reasons":   flag.Reasons,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return err
+}
@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/logger"
+)
+
+type ReadReceiptsRepositoryDecorator struct {
+	repository interfaces.ReadReceiptsRepository
+}
+
+func NewReadReceiptsRepositoryDecorator(
+	repository interfaces.ReadReceiptsRepository) ReadReceiptsRepositoryDecorator {
+	return ReadReceiptsRepositoryDecorator{repository}
+}
+
+func (d ReadReceiptsRepositoryDecorator) MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error {
+	err := d.repository.MarkAsRead(ctx, receipt)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while marking messages as read: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"chat_id":    receipt.ChatId,
+				"user_id":    receipt.UserId,
+				"message_id": receipt.MessageId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return err
+}
+
+func (d ReadReceiptsRepositoryDecorator) GetUnreadCounts(
+	ctx context.Context, userId uint,
+) ([]models.ChatUnreadCount, error) {
+	counts, err := d.repository.GetUnreadCounts(ctx, userId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while getting unread messages counts: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"user_id": userId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return counts, err
+}
@@ -1,6 +1,7 @@
 package logging
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"plugins/logger"
@@ -15,10 +16,12 @@ func NewUserSettingsRepositoryDecorator(
 	return UserSettingsRepositoryDecorator{repository}
 }
 
-func (d UserSettingsRepositoryDecorator) GetUserSettings(userId uint) (models.FullUserInfo, error) {
-	info, err := d.repository.GetUserSettings(userId)
+func (d UserSettingsRepositoryDecorator) GetUserSettings(
+	ctx context.Context, userId uint,
+) (models.FullUserInfo, error) {
+	info, err := d.repository.GetUserSettings(ctx, userId)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while getting user settings: %v",
 			Args: []interface{}{
 				err,
@@ -26,16 +29,18 @@ func (d UserSettingsRepositoryDecorator) GetUserSettings(userId uint) (models.Fu
 			Optional: map[string]interface{}{
 				"user_id": userId,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return info, err
 }
 
-func (d UserSettingsRepositoryDecorator) UpdateUserSettings(userId uint, info models.UserSettings) error {
-	err := d.repository.UpdateUserSettings(userId, info)
+func (d UserSettingsRepositoryDecorator) UpdateUserSettings(
+	ctx context.Context, userId uint, info models.UserSettings,
+) error {
+	err := d.repository.UpdateUserSettings(ctx, userId, info)
 	if err != nil {
-		logger.WithFields(logger.Fields{
+		logger.WithFields(ctx, logger.Fields{
 			MessageTemplate: "Error while updating user settings: %v",
 			Args: []interface{}{
 				err,
@@ -44,7 +49,7 @@ func (d UserSettingsRepositoryDecorator) UpdateUserSettings(userId uint, info mo
 				"user_id":       userId,
 				"user_settings": info,
 			},
-		}, logger.Warning)
+		}, logger.WarningLevel)
 	}
 
 	return err
@@ -0,0 +1,92 @@
+package logging
+
+import (
+	"context"
+	"interfaces"
+	"plugins/logger"
+)
+
+type UsersPrivacyRepositoryDecorator struct {
+	repository interfaces.UsersPrivacyRepository
+}
+
+func NewUsersPrivacyRepositoryDecorator(
+	repository interfaces.UsersPrivacyRepository) UsersPrivacyRepositoryDecorator {
+	return UsersPrivacyRepositoryDecorator{repository}
+}
+
+func (d UsersPrivacyRepositoryDecorator) BlockUser(ctx context.Context, userId, blockedUserId uint) error {
+	err := d.repository.BlockUser(ctx, userId, blockedUserId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while blocking user: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"user_id":         userId,
+				"blocked_user_id": blockedUserId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return err
+}
+
+func (d UsersPrivacyRepositoryDecorator) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	err := d.repository.UnblockUser(ctx, userId, blockedUserId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while unblocking user: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"user_id":         userId,
+				"blocked_user_id": blockedUserId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return err
+}
+
+func (d UsersPrivacyRepositoryDecorator) SetDirectMessagesAllowed(
+	ctx context.Context, userId uint, allowed bool,
+) error {
+	err := d.repository.SetDirectMessagesAllowed(ctx, userId, allowed)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while updating direct messages privacy: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"user_id": userId,
+				"allowed": allowed,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return err
+}
+
+func (d UsersPrivacyRepositoryDecorator) DirectMessagesAllowed(
+	ctx context.Context, senderId, recipientId uint,
+) (bool, error) {
+	allowed, err := d.repository.DirectMessagesAllowed(ctx, senderId, recipientId)
+	if err != nil {
+		logger.WithFields(ctx, logger.Fields{
+			MessageTemplate: "Error while checking if direct messages are allowed: %v",
+			Args: []interface{}{
+				err,
+			},
+			Optional: map[string]interface{}{
+				"sender_id":    senderId,
+				"recipient_id": recipientId,
+			},
+		}, logger.WarningLevel)
+	}
+
+	return allowed, err
+}
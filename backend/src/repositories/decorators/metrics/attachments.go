@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"io"
+	"models"
+	"time"
+)
+
+const (
+	attachmentsRepository = "attachments"
+	blobStore             = "blob_store"
+)
+
+type AttachmentsRepositoryDecorator struct {
+	repository interfaces.AttachmentsRepository
+}
+
+func NewAttachmentsRepositoryDecorator(repository interfaces.AttachmentsRepository) AttachmentsRepositoryDecorator {
+	return AttachmentsRepositoryDecorator{repository}
+}
+
+func (d AttachmentsRepositoryDecorator) SaveAttachment(
+	ctx context.Context, attachment models.Attachment,
+) (models.Attachment, error) {
+	start := time.Now()
+	savedAttachment, err := d.repository.SaveAttachment(ctx, attachment)
+	observe(attachmentsRepository, "SaveAttachment", start, err)
+
+	return savedAttachment, err
+}
+
+func (d AttachmentsRepositoryDecorator) GetAttachment(
+	ctx context.Context, attachmentId uint,
+) (models.Attachment, error) {
+	start := time.Now()
+	attachment, err := d.repository.GetAttachment(ctx, attachmentId)
+	observe(attachmentsRepository, "GetAttachment", start, err)
+
+	return attachment, err
+}
+
+func (d AttachmentsRepositoryDecorator) IsChatMember(ctx context.Context, chatId, userId uint) (bool, error) {
+	start := time.Now()
+	isMember, err := d.repository.IsChatMember(ctx, chatId, userId)
+	observe(attachmentsRepository, "IsChatMember", start, err)
+
+	return isMember, err
+}
+
+func (d AttachmentsRepositoryDecorator) GetOrphanAttachments(
+	ctx context.Context, olderThan time.Duration,
+) ([]models.Attachment, error) {
+	start := time.Now()
+	attachments, err := d.repository.GetOrphanAttachments(ctx, olderThan)
+	observe(attachmentsRepository, "GetOrphanAttachments", start, err)
+
+	return attachments, err
+}
+
+func (d AttachmentsRepositoryDecorator) DeleteAttachments(ctx context.Context, attachmentIds []uint) error {
+	start := time.Now()
+	err := d.repository.DeleteAttachments(ctx, attachmentIds)
+	observe(attachmentsRepository, "DeleteAttachments", start, err)
+
+	return err
+}
+
+type BlobStoreDecorator struct {
+	store interfaces.BlobStore
+}
+
+func NewBlobStoreDecorator(store interfaces.BlobStore) BlobStoreDecorator {
+	return BlobStoreDecorator{store}
+}
+
+func (d BlobStoreDecorator) Save(ctx context.Context, blobId string, content io.Reader) error {
+	start := time.Now()
+	err := d.store.Save(ctx, blobId, content)
+	observe(blobStore, "Save", start, err)
+
+	return err
+}
+
+// duration of opening is measured, reading of content is done by caller
+func (d BlobStoreDecorator) Open(ctx context.Context, blobId string) (io.ReadCloser, error) {
+	start := time.Now()
+	content, err := d.store.Open(ctx, blobId)
+	observe(blobStore, "Open", start, err)
+
+	return content, err
+}
+
+func (d BlobStoreDecorator) Delete(ctx context.Context, blobId string) error {
+	start := time.Now()
+	err := d.store.Delete(ctx, blobId)
+	observe(blobStore, "Delete", start, err)
+
+	return err
+}
@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+const chatRepository = "chat"
+
+type ChatRepositoryDecorator struct {
+	repository interfaces.FullChatsRepository
+}
+
+func NewChatRepositoryDecorator(repository interfaces.FullChatsRepository) ChatRepositoryDecorator {
+	return ChatRepositoryDecorator{repository}
+}
+
+func (d ChatRepositoryDecorator) GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error) {
+	start := time.Now()
+	chat, err := d.repository.GetMeetingChat(ctx, meetingId)
+	observe(chatRepository, "GetMeetingChat", start, err)
+
+	return chat, err
+}
+
+func (d ChatRepositoryDecorator) GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error) {
+	start := time.Now()
+	chats, err := d.repository.GetUserChats(ctx, userId)
+	observe(chatRepository, "GetUserChats", start, err)
+
+	return chats, err
+}
+
+func (d ChatRepositoryDecorator) GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error) {
+	start := time.Now()
+	members, err := d.repository.GetChatMembers(ctx, chatId, usersIds)
+	observe(chatRepository, "GetChatMembers", start, err)
+
+	return members, err
+}
+
+func (d ChatRepositoryDecorator) CreateChat(ctx context.Context, meetingId uint, chatType string) error {
+	start := time.Now()
+	err := d.repository.CreateChat(ctx, meetingId, chatType)
+	observe(chatRepository, "CreateChat", start, err)
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	start := time.Now()
+	err := d.repository.CreateMeetingRequestChat(ctx, meetingId, applicantId)
+	observe(chatRepository, "CreateMeetingRequestChat", start, err)
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) CloseMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	start := time.Now()
+	err := d.repository.CloseMeetingRequestChat(ctx, meetingId, applicantId)
+	observe(chatRepository, "CloseMeetingRequestChat", start, err)
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) SetChatStatus(ctx context.Context, chatId uint, status string) error {
+	start := time.Now()
+	err := d.repository.SetChatStatus(ctx, chatId, status)
+	observe(chatRepository, "SetChatStatus", start, err)
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) GetDirectChat(
+	ctx context.Context, firstUserId, secondUserId uint,
+) (models.Chat, error) {
+	start := time.Now()
+	chat, err := d.repository.GetDirectChat(ctx, firstUserId, secondUserId)
+	observe(chatRepository, "GetDirectChat", start, err)
+
+	return chat, err
+}
+
+func (d ChatRepositoryDecorator) CreateDirectChat(
+	ctx context.Context, firstUserId, secondUserId uint,
+) (models.Chat, error) {
+	start := time.Now()
+	chat, err := d.repository.CreateDirectChat(ctx, firstUserId, secondUserId)
+	observe(chatRepository, "CreateDirectChat", start, err)
+
+	return chat, err
+}
+
+func (d ChatRepositoryDecorator) GetChatMeetingAdminId(ctx context.Context, chatId uint) (uint, error) {
+	start := time.Now()
+	adminId, err := d.repository.GetChatMeetingAdminId(ctx, chatId)
+	observe(chatRepository, "GetChatMeetingAdminId", start, err)
+
+	return adminId, err
+}
+
+func (d ChatRepositoryDecorator) ExportMessages(
+	ctx context.Context, chatId uint, handle func(message models.TranscriptMessage) error) error {
+	start := time.Now()
+	err := d.repository.ExportMessages(ctx, chatId, handle)
+	observe(chatRepository, "ExportMessages", start, err)
+
+	return err
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+const credentialsRepository = "credentials"
+
+type CredentialsRepositoryDecorator struct {
+	repository interfaces.CredentialsRepository
+}
+
+func NewCredentialsRepositoryDecorator(repository interfaces.CredentialsRepository) CredentialsRepositoryDecorator {
+	return CredentialsRepositoryDecorator{repository}
+}
+
+func (d CredentialsRepositoryDecorator) CreateUser(ctx context.Context, user models.UserCredentials) error {
+	start := time.Now()
+	err := d.repository.CreateUser(ctx, user)
+	observe(credentialsRepository, "CreateUser", start, err)
+
+	return err
+}
+
+func (d CredentialsRepositoryDecorator) GetUserIdByCredentials(
+	ctx context.Context, user models.UserCredentials,
+) (uint, error) {
+	start := time.Now()
+	userId, err := d.repository.GetUserIdByCredentials(ctx, user)
+	observe(credentialsRepository, "GetUserIdByCredentials", start, err)
+
+	return userId, err
+}
+
+func (d CredentialsRepositoryDecorator) UpdateUserPassword(ctx context.Context, user models.UserCredentials) error {
+	start := time.Now()
+	err := d.repository.UpdateUserPassword(ctx, user)
+	observe(credentialsRepository, "UpdateUserPassword", start, err)
+
+	return err
+}
+
+func (d CredentialsRepositoryDecorator) GetUserEmail(ctx context.Context, userId uint) (string, error) {
+	start := time.Now()
+	email, err := d.repository.GetUserEmail(ctx, userId)
+	observe(credentialsRepository, "GetUserEmail", start, err)
+
+	return email, err
+}
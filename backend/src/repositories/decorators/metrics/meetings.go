@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+const meetingsRepository = "meetings"
+
+type MeetingsRepositoryDecorator struct {
+	repository interfaces.FullMeetingsRepository
+}
+
+func NewMeetingsRepositoryDecorator(repository interfaces.FullMeetingsRepository) MeetingsRepositoryDecorator {
+	return MeetingsRepositoryDecorator{repository}
+}
+
+func (d MeetingsRepositoryDecorator) GetFullMeetingInfo(
+	ctx context.Context, meetingId uint,
+) (models.PrivateMeeting, error) {
+	start := time.Now()
+	meeting, err := d.repository.GetFullMeetingInfo(ctx, meetingId)
+	observe(meetingsRepository, "GetFullMeetingInfo", start, err)
+
+	return meeting, err
+}
+
+func (d MeetingsRepositoryDecorator) GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error) {
+	start := time.Now()
+	meetings, err := d.repository.GetPublicMeetings(ctx)
+	observe(meetingsRepository, "GetPublicMeetings", start, err)
+
+	return meetings, err
+}
+
+func (d MeetingsRepositoryDecorator) GetExtendedMeetings(
+	ctx context.Context, userStatusesData models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error) {
+	start := time.Now()
+	meetings, err := d.repository.GetExtendedMeetings(ctx, userStatusesData)
+	observe(meetingsRepository, "GetExtendedMeetings", start, err)
+
+	return meetings, err
+}
+
+func (d MeetingsRepositoryDecorator) CreateMeeting(
+	ctx context.Context, adminId uint, settings models.AllSettings,
+) error {
+	start := time.Now()
+	err := d.repository.CreateMeeting(ctx, adminId, settings)
+	observe(meetingsRepository, "CreateMeeting", start, err)
+
+	return err
+}
+
+func (d MeetingsRepositoryDecorator) DeleteMeeting(ctx context.Context, meetingId uint) error {
+	start := time.Now()
+	err := d.repository.DeleteMeeting(ctx, meetingId)
+	observe(meetingsRepository, "DeleteMeeting", start, err)
+
+	return err
+}
+
+func (d MeetingsRepositoryDecorator) UpdateSettings(
+	ctx context.Context, meetingId uint, settings models.AllSettings,
+) error {
+	start := time.Now()
+	err := d.repository.UpdateSettings(ctx, meetingId, settings)
+	observe(meetingsRepository, "UpdateSettings", start, err)
+
+	return err
+}
+
+func (d MeetingsRepositoryDecorator) AddUserToMeeting(ctx context.Context, meetingId, userId uint) error {
+	start := time.Now()
+	err := d.repository.AddUserToMeeting(ctx, meetingId, userId)
+	observe(meetingsRepository, "AddUserToMeeting", start, err)
+
+	return err
+}
+
+func (d MeetingsRepositoryDecorator) KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error {
+	start := time.Now()
+	err := d.repository.KickUserFromMeeting(ctx, meetingId, userId)
+	observe(meetingsRepository, "KickUserFromMeeting", start, err)
+
+	return err
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+const meetings_settingsRepository = "meetings_settings"
+
+type MeetingsSettingsRepositoryDecorator struct {
+	repository interfaces.MeetingsSettingsRepository
+}
+
+func NewMeetingsSettingsRepositoryDecorator(
+	repository interfaces.MeetingsSettingsRepository,
+) MeetingsSettingsRepositoryDecorator {
+	return MeetingsSettingsRepositoryDecorator{repository}
+}
+
+func (d MeetingsSettingsRepositoryDecorator) GetMeetingSettings(
+	ctx context.Context, meetingId uint) (models.ParticipationMeetingSettings, error) {
+	start := time.Now()
+	settings, err := d.repository.GetMeetingSettings(ctx, meetingId)
+	observe(meetings_settingsRepository, "GetMeetingSettings", start, err)
+
+	return settings, err
+}
+
+func (d MeetingsSettingsRepositoryDecorator) GetNearMeetings(
+	ctx context.Context, data models.UserTimeCheckData) ([]models.TimeMeetingParameters, error) {
+	start := time.Now()
+	parameters, err := d.repository.GetNearMeetings(ctx, data)
+	observe(meetings_settingsRepository, "GetNearMeetings", start, err)
+
+	return parameters, err
+}
@@ -0,0 +1,152 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+const messagesRepository = "messages"
+
+type MessagesRepositoryDecorator struct {
+	repository interfaces.FullMessagesRepository
+}
+
+func NewMessagesRepositoryDecorator(repository interfaces.FullMessagesRepository) MessagesRepositoryDecorator {
+	return MessagesRepositoryDecorator{repository}
+}
+
+func (d MessagesRepositoryDecorator) Save(ctx context.Context, message models.Message) (models.Message, error) {
+	start := time.Now()
+	savedMessage, err := d.repository.Save(ctx, message)
+	observe(messagesRepository, "Save", start, err)
+
+	return savedMessage, err
+}
+
+func (d MessagesRepositoryDecorator) GetLastMessages(
+	ctx context.Context, chatId, count, viewerId uint,
+) ([]models.Message, error) {
+	start := time.Now()
+	messages, err := d.repository.GetLastMessages(ctx, chatId, count, viewerId)
+	observe(messagesRepository, "GetLastMessages", start, err)
+
+	return messages, err
+}
+
+func (d MessagesRepositoryDecorator) GetLastMessagesAfter(
+	ctx context.Context, chatId, messageId, count, viewerId uint,
+) ([]models.Message, error) {
+	start := time.Now()
+	messages, err := d.repository.GetLastMessagesAfter(ctx, chatId, messageId, count, viewerId)
+	observe(messagesRepository, "GetLastMessagesAfter", start, err)
+
+	return messages, err
+}
+
+func (d MessagesRepositoryDecorator) GetThread(
+	ctx context.Context, rootId, afterId, count, viewerId uint,
+) (models.Thread, error) {
+	start := time.Now()
+	thread, err := d.repository.GetThread(ctx, rootId, afterId, count, viewerId)
+	observe(messagesRepository, "GetThread", start, err)
+
+	return thread, err
+}
+
+func (d MessagesRepositoryDecorator) GetManagedMessage(
+	ctx context.Context, messageId uint,
+) (models.ManagedMessage, error) {
+	start := time.Now()
+	message, err := d.repository.GetManagedMessage(ctx, messageId)
+	observe(messagesRepository, "GetManagedMessage", start, err)
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) EditMessage(
+	ctx context.Context, messageId uint, text string,
+) (models.Message, error) {
+	start := time.Now()
+	message, err := d.repository.EditMessage(ctx, messageId, text)
+	observe(messagesRepository, "EditMessage", start, err)
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) DeleteMessage(ctx context.Context, messageId uint) (models.Message, error) {
+	start := time.Now()
+	message, err := d.repository.DeleteMessage(ctx, messageId)
+	observe(messagesRepository, "DeleteMessage", start, err)
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) GetMessageEdits(
+	ctx context.Context, messageId uint,
+) ([]models.MessageEdit, error) {
+	start := time.Now()
+	edits, err := d.repository.GetMessageEdits(ctx, messageId)
+	observe(messagesRepository, "GetMessageEdits", start, err)
+
+	return edits, err
+}
+
+func (d MessagesRepositoryDecorator) SetMessagePinned(
+	ctx context.Context, messageId uint, pinned bool,
+) (models.Message, error) {
+	start := time.Now()
+	message, err := d.repository.SetMessagePinned(ctx, messageId, pinned)
+	observe(messagesRepository, "SetMessagePinned", start, err)
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error) {
+	start := time.Now()
+	messages, err := d.repository.GetPinnedMessages(ctx, chatId)
+	observe(messagesRepository, "GetPinnedMessages", start, err)
+
+	return messages, err
+}
+
+func (d MessagesRepositoryDecorator) AddReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	start := time.Now()
+	change, err := d.repository.AddReaction(ctx, reaction)
+	observe(messagesRepository, "AddReaction", start, err)
+
+	return change, err
+}
+
+func (d MessagesRepositoryDecorator) RemoveReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	start := time.Now()
+	change, err := d.repository.RemoveReaction(ctx, reaction)
+	observe(messagesRepository, "RemoveReaction", start, err)
+
+	return change, err
+}
+
+func (d MessagesRepositoryDecorator) SearchMessages(
+	ctx context.Context, query models.SearchQuery,
+) ([]models.SearchHit, error) {
+	start := time.Now()
+	hits, err := d.repository.SearchMessages(ctx, query)
+	observe(messagesRepository, "SearchMessages", start, err)
+
+	return hits, err
+}
+
+func (d MessagesRepositoryDecorator) GetNotifications(
+	ctx context.Context, userId, count uint,
+) ([]models.Notification, error) {
+	start := time.Now()
+	notifications, err := d.repository.GetNotifications(ctx, userId, count)
+	observe(messagesRepository, "GetNotifications", start, err)
+
+	return notifications, err
+}
@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+var (
+	callDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "repository_call_duration_seconds",
+		Help:    "Duration of calls of repository methods",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"repository", "method"})
+
+	callErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "repository_call_errors_total",
+		Help: "Count of errors returned by repository methods",
+	}, []string{"repository", "method"})
+)
+
+func init() {
+	prometheus.MustRegister(callDuration, callErrors)
+}
+
+// expected errors like not found records are counted too, because decorator does not know what is expected
+func observe(repository, method string, start time.Time, err error) {
+	callDuration.WithLabelValues(repository, method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		callErrors.WithLabelValues(repository, method).Inc()
+	}
+}
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"models"
+	"testing"
+	"utils"
+)
+
+type moderationRepositoryMock struct {
+	err error
+}
+
+func (m moderationRepositoryMock) FlagContent(context.Context, models.ContentFlag) error {
+	return m.err
+}
+
+func sampleCount(observer prometheus.Observer, t *testing.T) uint64 {
+	var metric dto.Metric
+	err := observer.(prometheus.Metric).Write(&metric)
+	utils.AssertNil(err, t)
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestDecorator_ObservesSuccessfulCall(t *testing.T) {
+	duration := callDuration.WithLabelValues(moderationRepository, "FlagContent")
+	errorsCounter := callErrors.WithLabelValues(moderationRepository, "FlagContent")
+	callsBefore, errorsBefore := sampleCount(duration, t), testutil.ToFloat64(errorsCounter)
+
+	decorator := NewModerationRepositoryDecorator(moderationRepositoryMock{})
+	err := decorator.FlagContent(context.Background(), models.ContentFlag{})
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(callsBefore+1, sampleCount(duration, t), t)
+	utils.AssertEqual(errorsBefore, testutil.ToFloat64(errorsCounter), t)
+}
+
+func TestDecorator_CountsError(t *testing.T) {
+	repositoryError := errors.New("connection refused")
+	duration := callDuration.WithLabelValues(moderationRepository, "FlagContent")
+	errorsCounter := callErrors.WithLabelValues(moderationRepository, "FlagContent")
+	callsBefore, errorsBefore := sampleCount(duration, t), testutil.ToFloat64(errorsCounter)
+
+	decorator := NewModerationRepositoryDecorator(moderationRepositoryMock{repositoryError})
+	err := decorator.FlagContent(context.Background(), models.ContentFlag{})
+
+	utils.AssertErrorsEqual(repositoryError, err, t)
+	utils.AssertEqual(callsBefore+1, sampleCount(duration, t), t)
+	utils.AssertEqual(errorsBefore+1, testutil.ToFloat64(errorsCounter), t)
+}
@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+const moderationRepository = "moderation"
+
+type ModerationRepositoryDecorator struct {
+	repository interfaces.ModerationRepository
+}
+
+func NewModerationRepositoryDecorator(repository interfaces.ModerationRepository) ModerationRepositoryDecorator {
+	return ModerationRepositoryDecorator{repository}
+}
+
+func (d ModerationRepositoryDecorator) FlagContent(ctx context.Context, flag models.ContentFlag) error {
+	start := time.Now()
+	err := d.repository.FlagContent(ctx, flag)
+	observe(moderationRepository, "FlagContent", start, err)
+
+	return err
+}
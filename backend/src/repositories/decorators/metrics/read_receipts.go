@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+const read_receiptsRepository = "read_receipts"
+
+type ReadReceiptsRepositoryDecorator struct {
+	repository interfaces.ReadReceiptsRepository
+}
+
+func NewReadReceiptsRepositoryDecorator(repository interfaces.ReadReceiptsRepository) ReadReceiptsRepositoryDecorator {
+	return ReadReceiptsRepositoryDecorator{repository}
+}
+
+func (d ReadReceiptsRepositoryDecorator) MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error {
+	start := time.Now()
+	err := d.repository.MarkAsRead(ctx, receipt)
+	observe(read_receiptsRepository, "MarkAsRead", start, err)
+
+	return err
+}
+
+func (d ReadReceiptsRepositoryDecorator) GetUnreadCounts(
+	ctx context.Context, userId uint,
+) ([]models.ChatUnreadCount, error) {
+	start := time.Now()
+	counts, err := d.repository.GetUnreadCounts(ctx, userId)
+	observe(read_receiptsRepository, "GetUnreadCounts", start, err)
+
+	return counts, err
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+const user_settingsRepository = "user_settings"
+
+type UserSettingsRepositoryDecorator struct {
+	repository interfaces.UsersSettings
+}
+
+func NewUserSettingsRepositoryDecorator(repository interfaces.UsersSettings) UserSettingsRepositoryDecorator {
+	return UserSettingsRepositoryDecorator{repository}
+}
+
+func (d UserSettingsRepositoryDecorator) GetUserSettings(
+	ctx context.Context, userId uint,
+) (models.FullUserInfo, error) {
+	start := time.Now()
+	info, err := d.repository.GetUserSettings(ctx, userId)
+	observe(user_settingsRepository, "GetUserSettings", start, err)
+
+	return info, err
+}
+
+func (d UserSettingsRepositoryDecorator) UpdateUserSettings(
+	ctx context.Context, userId uint, info models.UserSettings,
+) error {
+	start := time.Now()
+	err := d.repository.UpdateUserSettings(ctx, userId, info)
+	observe(user_settingsRepository, "UpdateUserSettings", start, err)
+
+	return err
+}
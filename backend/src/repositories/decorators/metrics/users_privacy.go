@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"context"
+	"interfaces"
+	"time"
+)
+
+const users_privacyRepository = "users_privacy"
+
+type UsersPrivacyRepositoryDecorator struct {
+	repository interfaces.UsersPrivacyRepository
+}
+
+func NewUsersPrivacyRepositoryDecorator(repository interfaces.UsersPrivacyRepository) UsersPrivacyRepositoryDecorator {
+	return UsersPrivacyRepositoryDecorator{repository}
+}
+
+func (d UsersPrivacyRepositoryDecorator) BlockUser(ctx context.Context, userId, blockedUserId uint) error {
+	start := time.Now()
+	err := d.repository.BlockUser(ctx, userId, blockedUserId)
+	observe(users_privacyRepository, "BlockUser", start, err)
+
+	return err
+}
+
+func (d UsersPrivacyRepositoryDecorator) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	start := time.Now()
+	err := d.repository.UnblockUser(ctx, userId, blockedUserId)
+	observe(users_privacyRepository, "UnblockUser", start, err)
+
+	return err
+}
+
+func (d UsersPrivacyRepositoryDecorator) SetDirectMessagesAllowed(
+	ctx context.Context, userId uint, allowed bool,
+) error {
+	start := time.Now()
+	err := d.repository.SetDirectMessagesAllowed(ctx, userId, allowed)
+	observe(users_privacyRepository, "SetDirectMessagesAllowed", start, err)
+
+	return err
+}
+
+func (d UsersPrivacyRepositoryDecorator) DirectMessagesAllowed(
+	ctx context.Context, senderId, recipientId uint,
+) (bool, error) {
+	start := time.Now()
+	allowed, err := d.repository.DirectMessagesAllowed(ctx, senderId, recipientId)
+	observe(users_privacyRepository, "DirectMessagesAllowed", start, err)
+
+	return allowed, err
+}
@@ -0,0 +1,60 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+type AttachmentsRepositoryDecorator struct {
+	repository interfaces.AttachmentsRepository
+	timeout    time.Duration
+}
+
+func NewAttachmentsRepositoryDecorator(
+	repository interfaces.AttachmentsRepository, timeout time.Duration,
+) AttachmentsRepositoryDecorator {
+	return AttachmentsRepositoryDecorator{repository, timeout}
+}
+
+func (d AttachmentsRepositoryDecorator) SaveAttachment(
+	ctx context.Context, attachment models.Attachment,
+) (models.Attachment, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.SaveAttachment(ctx, attachment)
+}
+
+func (d AttachmentsRepositoryDecorator) GetAttachment(
+	ctx context.Context, attachmentId uint,
+) (models.Attachment, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetAttachment(ctx, attachmentId)
+}
+
+func (d AttachmentsRepositoryDecorator) IsChatMember(ctx context.Context, chatId, userId uint) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.IsChatMember(ctx, chatId, userId)
+}
+
+func (d AttachmentsRepositoryDecorator) GetOrphanAttachments(
+	ctx context.Context, olderThan time.Duration,
+) ([]models.Attachment, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetOrphanAttachments(ctx, olderThan)
+}
+
+func (d AttachmentsRepositoryDecorator) DeleteAttachments(ctx context.Context, attachmentIds []uint) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.DeleteAttachments(ctx, attachmentIds)
+}
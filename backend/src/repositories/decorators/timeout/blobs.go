@@ -0,0 +1,36 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"io"
+	"time"
+)
+
+type BlobStoreDecorator struct {
+	store   interfaces.BlobStore
+	timeout time.Duration
+}
+
+func NewBlobStoreDecorator(store interfaces.BlobStore, timeout time.Duration) BlobStoreDecorator {
+	return BlobStoreDecorator{store, timeout}
+}
+
+func (d BlobStoreDecorator) Save(ctx context.Context, blobId string, content io.Reader) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.store.Save(ctx, blobId, content)
+}
+
+// content is read by caller after return, so opening is limited only by cancellation of request
+func (d BlobStoreDecorator) Open(ctx context.Context, blobId string) (io.ReadCloser, error) {
+	return d.store.Open(ctx, blobId)
+}
+
+func (d BlobStoreDecorator) Delete(ctx context.Context, blobId string) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.store.Delete(ctx, blobId)
+}
@@ -0,0 +1,100 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+type ChatRepositoryDecorator struct {
+	repository interfaces.FullChatsRepository
+	timeout    time.Duration
+}
+
+func NewChatRepositoryDecorator(
+	repository interfaces.FullChatsRepository, timeout time.Duration,
+) ChatRepositoryDecorator {
+	return ChatRepositoryDecorator{repository, timeout}
+}
+
+func (d ChatRepositoryDecorator) GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetMeetingChat(ctx, meetingId)
+}
+
+func (d ChatRepositoryDecorator) GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetUserChats(ctx, userId)
+}
+
+func (d ChatRepositoryDecorator) GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetChatMembers(ctx, chatId, usersIds)
+}
+
+func (d ChatRepositoryDecorator) CreateChat(ctx context.Context, meetingId uint, chatType string) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.CreateChat(ctx, meetingId, chatType)
+}
+
+func (d ChatRepositoryDecorator) CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.CreateMeetingRequestChat(ctx, meetingId, applicantId)
+}
+
+func (d ChatRepositoryDecorator) CloseMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.CloseMeetingRequestChat(ctx, meetingId, applicantId)
+}
+
+func (d ChatRepositoryDecorator) SetChatStatus(ctx context.Context, chatId uint, status string) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.SetChatStatus(ctx, chatId, status)
+}
+
+func (d ChatRepositoryDecorator) GetDirectChat(
+	ctx context.Context, firstUserId, secondUserId uint,
+) (models.Chat, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetDirectChat(ctx, firstUserId, secondUserId)
+}
+
+func (d ChatRepositoryDecorator) CreateDirectChat(
+	ctx context.Context, firstUserId, secondUserId uint,
+) (models.Chat, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.CreateDirectChat(ctx, firstUserId, secondUserId)
+}
+
+func (d ChatRepositoryDecorator) GetChatMeetingAdminId(ctx context.Context, chatId uint) (uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetChatMeetingAdminId(ctx, chatId)
+}
+
+func (d ChatRepositoryDecorator) ExportMessages(
+	ctx context.Context, chatId uint, handle func(message models.TranscriptMessage) error) error {
+	// export streams whole history of chat and server write deadline is lifted for it (see api/chats),
+	// so it is limited only by cancellation of request
+	return d.repository.ExportMessages(ctx, chatId, handle)
+}
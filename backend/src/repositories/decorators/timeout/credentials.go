@@ -0,0 +1,49 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+type CredentialsRepositoryDecorator struct {
+	repository interfaces.CredentialsRepository
+	timeout    time.Duration
+}
+
+func NewCredentialsRepositoryDecorator(
+	repository interfaces.CredentialsRepository, timeout time.Duration,
+) CredentialsRepositoryDecorator {
+	return CredentialsRepositoryDecorator{repository, timeout}
+}
+
+func (d CredentialsRepositoryDecorator) CreateUser(ctx context.Context, user models.UserCredentials) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.CreateUser(ctx, user)
+}
+
+func (d CredentialsRepositoryDecorator) GetUserIdByCredentials(
+	ctx context.Context, user models.UserCredentials,
+) (uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetUserIdByCredentials(ctx, user)
+}
+
+func (d CredentialsRepositoryDecorator) UpdateUserPassword(ctx context.Context, user models.UserCredentials) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.UpdateUserPassword(ctx, user)
+}
+
+func (d CredentialsRepositoryDecorator) GetUserEmail(ctx context.Context, userId uint) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetUserEmail(ctx, userId)
+}
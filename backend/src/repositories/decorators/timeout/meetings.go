@@ -0,0 +1,82 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+type MeetingsRepositoryDecorator struct {
+	repository interfaces.FullMeetingsRepository
+	timeout    time.Duration
+}
+
+func NewMeetingsRepositoryDecorator(
+	repository interfaces.FullMeetingsRepository, timeout time.Duration,
+) MeetingsRepositoryDecorator {
+	return MeetingsRepositoryDecorator{repository, timeout}
+}
+
+func (d MeetingsRepositoryDecorator) GetFullMeetingInfo(
+	ctx context.Context, meetingId uint,
+) (models.PrivateMeeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetFullMeetingInfo(ctx, meetingId)
+}
+
+func (d MeetingsRepositoryDecorator) GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetPublicMeetings(ctx)
+}
+
+func (d MeetingsRepositoryDecorator) GetExtendedMeetings(
+	ctx context.Context, userStatusesData models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetExtendedMeetings(ctx, userStatusesData)
+}
+
+func (d MeetingsRepositoryDecorator) CreateMeeting(
+	ctx context.Context, adminId uint, settings models.AllSettings,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.CreateMeeting(ctx, adminId, settings)
+}
+
+func (d MeetingsRepositoryDecorator) DeleteMeeting(ctx context.Context, meetingId uint) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.DeleteMeeting(ctx, meetingId)
+}
+
+func (d MeetingsRepositoryDecorator) UpdateSettings(
+	ctx context.Context, meetingId uint, settings models.AllSettings,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.UpdateSettings(ctx, meetingId, settings)
+}
+
+func (d MeetingsRepositoryDecorator) AddUserToMeeting(ctx context.Context, meetingId, userId uint) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.AddUserToMeeting(ctx, meetingId, userId)
+}
+
+func (d MeetingsRepositoryDecorator) KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.KickUserFromMeeting(ctx, meetingId, userId)
+}
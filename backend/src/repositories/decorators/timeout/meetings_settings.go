@@ -0,0 +1,35 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+type MeetingsSettingsRepositoryDecorator struct {
+	repository interfaces.MeetingsSettingsRepository
+	timeout    time.Duration
+}
+
+func NewMeetingsSettingsRepositoryDecorator(
+	repository interfaces.MeetingsSettingsRepository, timeout time.Duration,
+) MeetingsSettingsRepositoryDecorator {
+	return MeetingsSettingsRepositoryDecorator{repository, timeout}
+}
+
+func (d MeetingsSettingsRepositoryDecorator) GetMeetingSettings(
+	ctx context.Context, meetingId uint) (models.ParticipationMeetingSettings, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetMeetingSettings(ctx, meetingId)
+}
+
+func (d MeetingsSettingsRepositoryDecorator) GetNearMeetings(
+	ctx context.Context, data models.UserTimeCheckData) ([]models.TimeMeetingParameters, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetNearMeetings(ctx, data)
+}
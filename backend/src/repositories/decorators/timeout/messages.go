@@ -0,0 +1,139 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+type MessagesRepositoryDecorator struct {
+	repository interfaces.FullMessagesRepository
+	timeout    time.Duration
+}
+
+func NewMessagesRepositoryDecorator(
+	repository interfaces.FullMessagesRepository, timeout time.Duration,
+) MessagesRepositoryDecorator {
+	return MessagesRepositoryDecorator{repository, timeout}
+}
+
+func (d MessagesRepositoryDecorator) Save(ctx context.Context, message models.Message) (models.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.Save(ctx, message)
+}
+
+func (d MessagesRepositoryDecorator) GetLastMessages(
+	ctx context.Context, chatId, count, viewerId uint,
+) ([]models.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetLastMessages(ctx, chatId, count, viewerId)
+}
+
+func (d MessagesRepositoryDecorator) GetLastMessagesAfter(
+	ctx context.Context, chatId, messageId, count, viewerId uint,
+) ([]models.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetLastMessagesAfter(ctx, chatId, messageId, count, viewerId)
+}
+
+func (d MessagesRepositoryDecorator) GetThread(
+	ctx context.Context, rootId, afterId, count, viewerId uint,
+) (models.Thread, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetThread(ctx, rootId, afterId, count, viewerId)
+}
+
+func (d MessagesRepositoryDecorator) GetManagedMessage(
+	ctx context.Context, messageId uint,
+) (models.ManagedMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetManagedMessage(ctx, messageId)
+}
+
+func (d MessagesRepositoryDecorator) EditMessage(
+	ctx context.Context, messageId uint, text string,
+) (models.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.EditMessage(ctx, messageId, text)
+}
+
+func (d MessagesRepositoryDecorator) DeleteMessage(ctx context.Context, messageId uint) (models.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.DeleteMessage(ctx, messageId)
+}
+
+func (d MessagesRepositoryDecorator) GetMessageEdits(
+	ctx context.Context, messageId uint,
+) ([]models.MessageEdit, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetMessageEdits(ctx, messageId)
+}
+
+func (d MessagesRepositoryDecorator) SetMessagePinned(
+	ctx context.Context, messageId uint, pinned bool,
+) (models.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.SetMessagePinned(ctx, messageId, pinned)
+}
+
+func (d MessagesRepositoryDecorator) GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetPinnedMessages(ctx, chatId)
+}
+
+func (d MessagesRepositoryDecorator) AddReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.AddReaction(ctx, reaction)
+}
+
+func (d MessagesRepositoryDecorator) RemoveReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.RemoveReaction(ctx, reaction)
+}
+
+func (d MessagesRepositoryDecorator) SearchMessages(
+	ctx context.Context, query models.SearchQuery,
+) ([]models.SearchHit, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.SearchMessages(ctx, query)
+}
+
+func (d MessagesRepositoryDecorator) GetNotifications(
+	ctx context.Context, userId, count uint,
+) ([]models.Notification, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetNotifications(ctx, userId, count)
+}
@@ -0,0 +1,26 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+type ModerationRepositoryDecorator struct {
+	repository interfaces.ModerationRepository
+	timeout    time.Duration
+}
+
+func NewModerationRepositoryDecorator(
+	repository interfaces.ModerationRepository, timeout time.Duration,
+) ModerationRepositoryDecorator {
+	return ModerationRepositoryDecorator{repository, timeout}
+}
+
+func (d ModerationRepositoryDecorator) FlagContent(ctx context.Context, flag models.ContentFlag) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.FlagContent(ctx, flag)
+}
@@ -0,0 +1,35 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+type ReadReceiptsRepositoryDecorator struct {
+	repository interfaces.ReadReceiptsRepository
+	timeout    time.Duration
+}
+
+func NewReadReceiptsRepositoryDecorator(
+	repository interfaces.ReadReceiptsRepository, timeout time.Duration,
+) ReadReceiptsRepositoryDecorator {
+	return ReadReceiptsRepositoryDecorator{repository, timeout}
+}
+
+func (d ReadReceiptsRepositoryDecorator) MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.MarkAsRead(ctx, receipt)
+}
+
+func (d ReadReceiptsRepositoryDecorator) GetUnreadCounts(
+	ctx context.Context, userId uint,
+) ([]models.ChatUnreadCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetUnreadCounts(ctx, userId)
+}
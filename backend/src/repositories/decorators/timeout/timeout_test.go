@@ -0,0 +1,41 @@
+package timeout
+
+import (
+	"context"
+	"models"
+	"testing"
+	"time"
+	"utils"
+)
+
+type moderationRepositoryMock struct {
+	ctx context.Context
+}
+
+func (m *moderationRepositoryMock) FlagContent(ctx context.Context, _ models.ContentFlag) error {
+	m.ctx = ctx
+	return ctx.Err()
+}
+
+func TestDecorator_SetsDeadline(t *testing.T) {
+	repository := &moderationRepositoryMock{}
+	decorator := NewModerationRepositoryDecorator(repository, time.Minute)
+	err := decorator.FlagContent(context.Background(), models.ContentFlag{})
+
+	utils.AssertNil(err, t)
+	deadline, found := repository.ctx.Deadline()
+	utils.AssertTrue(found, t)
+	utils.AssertTrue(time.Until(deadline) <= time.Minute, t)
+	// context is canceled after call, so query can not outlive it
+	utils.AssertNotNil(repository.ctx.Err(), t)
+}
+
+func TestDecorator_KeepsCancellationOfRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	decorator := NewModerationRepositoryDecorator(&moderationRepositoryMock{}, time.Minute)
+	err := decorator.FlagContent(ctx, models.ContentFlag{})
+
+	utils.AssertErrorsEqual(context.Canceled, err, t)
+}
@@ -0,0 +1,37 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"time"
+)
+
+type UserSettingsRepositoryDecorator struct {
+	repository interfaces.UsersSettings
+	timeout    time.Duration
+}
+
+func NewUserSettingsRepositoryDecorator(
+	repository interfaces.UsersSettings, timeout time.Duration,
+) UserSettingsRepositoryDecorator {
+	return UserSettingsRepositoryDecorator{repository, timeout}
+}
+
+func (d UserSettingsRepositoryDecorator) GetUserSettings(
+	ctx context.Context, userId uint,
+) (models.FullUserInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.GetUserSettings(ctx, userId)
+}
+
+func (d UserSettingsRepositoryDecorator) UpdateUserSettings(
+	ctx context.Context, userId uint, info models.UserSettings,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.UpdateUserSettings(ctx, userId, info)
+}
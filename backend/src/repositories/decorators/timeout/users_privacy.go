@@ -0,0 +1,50 @@
+package timeout
+
+import (
+	"context"
+	"interfaces"
+	"time"
+)
+
+type UsersPrivacyRepositoryDecorator struct {
+	repository interfaces.UsersPrivacyRepository
+	timeout    time.Duration
+}
+
+func NewUsersPrivacyRepositoryDecorator(
+	repository interfaces.UsersPrivacyRepository, timeout time.Duration,
+) UsersPrivacyRepositoryDecorator {
+	return UsersPrivacyRepositoryDecorator{repository, timeout}
+}
+
+func (d UsersPrivacyRepositoryDecorator) BlockUser(ctx context.Context, userId, blockedUserId uint) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.BlockUser(ctx, userId, blockedUserId)
+}
+
+func (d UsersPrivacyRepositoryDecorator) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.UnblockUser(ctx, userId, blockedUserId)
+}
+
+func (d UsersPrivacyRepositoryDecorator) SetDirectMessagesAllowed(
+	ctx context.Context, userId uint, allowed bool,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.SetDirectMessagesAllowed(ctx, userId, allowed)
+}
+
+func (d UsersPrivacyRepositoryDecorator) DirectMessagesAllowed(
+	ctx context.Context, senderId, recipientId uint,
+) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.repository.DirectMessagesAllowed(ctx, senderId, recipientId)
+}
@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"io"
+	"models"
+	"plugins/tracer"
+	"time"
+)
+
+const (
+	attachmentsRepository = "attachments"
+	blobStore             = "blob_store"
+)
+
+type AttachmentsRepositoryDecorator struct {
+	repository interfaces.AttachmentsRepository
+}
+
+func NewAttachmentsRepositoryDecorator(repository interfaces.AttachmentsRepository) AttachmentsRepositoryDecorator {
+	return AttachmentsRepositoryDecorator{repository}
+}
+
+func (d AttachmentsRepositoryDecorator) SaveAttachment(
+	ctx context.Context, attachment models.Attachment,
+) (models.Attachment, error) {
+	ctx, span := start(ctx, attachmentsRepository, "SaveAttachment")
+	savedAttachment, err := d.repository.SaveAttachment(ctx, attachment)
+	tracer.End(span, err)
+
+	return savedAttachment, err
+}
+
+func (d AttachmentsRepositoryDecorator) GetAttachment(
+	ctx context.Context, attachmentId uint,
+) (models.Attachment, error) {
+	ctx, span := start(ctx, attachmentsRepository, "GetAttachment")
+	attachment, err := d.repository.GetAttachment(ctx, attachmentId)
+	tracer.End(span, err)
+
+	return attachment, err
+}
+
+func (d AttachmentsRepositoryDecorator) IsChatMember(ctx context.Context, chatId, userId uint) (bool, error) {
+	ctx, span := start(ctx, attachmentsRepository, "IsChatMember")
+	isMember, err := d.repository.IsChatMember(ctx, chatId, userId)
+	tracer.End(span, err)
+
+	return isMember, err
+}
+
+func (d AttachmentsRepositoryDecorator) GetOrphanAttachments(
+	ctx context.Context, olderThan time.Duration,
+) ([]models.Attachment, error) {
+	ctx, span := start(ctx, attachmentsRepository, "GetOrphanAttachments")
+	attachments, err := d.repository.GetOrphanAttachments(ctx, olderThan)
+	tracer.End(span, err)
+
+	return attachments, err
+}
+
+func (d AttachmentsRepositoryDecorator) DeleteAttachments(ctx context.Context, attachmentIds []uint) error {
+	ctx, span := start(ctx, attachmentsRepository, "DeleteAttachments")
+	err := d.repository.DeleteAttachments(ctx, attachmentIds)
+	tracer.End(span, err)
+
+	return err
+}
+
+type BlobStoreDecorator struct {
+	store interfaces.BlobStore
+}
+
+func NewBlobStoreDecorator(store interfaces.BlobStore) BlobStoreDecorator {
+	return BlobStoreDecorator{store}
+}
+
+func (d BlobStoreDecorator) Save(ctx context.Context, blobId string, content io.Reader) error {
+	ctx, span := start(ctx, blobStore, "Save")
+	err := d.store.Save(ctx, blobId, content)
+	tracer.End(span, err)
+
+	return err
+}
+
+// span covers only opening, reading of content is done by caller
+func (d BlobStoreDecorator) Open(ctx context.Context, blobId string) (io.ReadCloser, error) {
+	ctx, span := start(ctx, blobStore, "Open")
+	content, err := d.store.Open(ctx, blobId)
+	tracer.End(span, err)
+
+	return content, err
+}
+
+func (d BlobStoreDecorator) Delete(ctx context.Context, blobId string) error {
+	ctx, span := start(ctx, blobStore, "Delete")
+	err := d.store.Delete(ctx, blobId)
+	tracer.End(span, err)
+
+	return err
+}
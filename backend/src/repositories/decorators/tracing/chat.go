@@ -0,0 +1,111 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/tracer"
+)
+
+const chatRepository = "chat"
+
+type ChatRepositoryDecorator struct {
+	repository interfaces.FullChatsRepository
+}
+
+func NewChatRepositoryDecorator(repository interfaces.FullChatsRepository) ChatRepositoryDecorator {
+	return ChatRepositoryDecorator{repository}
+}
+
+func (d ChatRepositoryDecorator) GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error) {
+	ctx, span := start(ctx, chatRepository, "GetMeetingChat")
+	chat, err := d.repository.GetMeetingChat(ctx, meetingId)
+	tracer.End(span, err)
+
+	return chat, err
+}
+
+func (d ChatRepositoryDecorator) GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error) {
+	ctx, span := start(ctx, chatRepository, "GetUserChats")
+	chats, err := d.repository.GetUserChats(ctx, userId)
+	tracer.End(span, err)
+
+	return chats, err
+}
+
+func (d ChatRepositoryDecorator) GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error) {
+	ctx, span := start(ctx, chatRepository, "GetChatMembers")
+	members, err := d.repository.GetChatMembers(ctx, chatId, usersIds)
+	tracer.End(span, err)
+
+	return members, err
+}
+
+func (d ChatRepositoryDecorator) CreateChat(ctx context.Context, meetingId uint, chatType string) error {
+	ctx, span := start(ctx, chatRepository, "CreateChat")
+	err := d.repository.CreateChat(ctx, meetingId, chatType)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	ctx, span := start(ctx, chatRepository, "CreateMeetingRequestChat")
+	err := d.repository.CreateMeetingRequestChat(ctx, meetingId, applicantId)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) CloseMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	ctx, span := start(ctx, chatRepository, "CloseMeetingRequestChat")
+	err := d.repository.CloseMeetingRequestChat(ctx, meetingId, applicantId)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) SetChatStatus(ctx context.Context, chatId uint, status string) error {
+	ctx, span := start(ctx, chatRepository, "SetChatStatus")
+	err := d.repository.SetChatStatus(ctx, chatId, status)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d ChatRepositoryDecorator) GetDirectChat(
+	ctx context.Context, firstUserId, secondUserId uint,
+) (models.Chat, error) {
+	ctx, span := start(ctx, chatRepository, "GetDirectChat")
+	chat, err := d.repository.GetDirectChat(ctx, firstUserId, secondUserId)
+	tracer.End(span, err)
+
+	return chat, err
+}
+
+func (d ChatRepositoryDecorator) CreateDirectChat(
+	ctx context.Context, firstUserId, secondUserId uint,
+) (models.Chat, error) {
+	ctx, span := start(ctx, chatRepository, "CreateDirectChat")
+	chat, err := d.repository.CreateDirectChat(ctx, firstUserId, secondUserId)
+	tracer.End(span, err)
+
+	return chat, err
+}
+
+func (d ChatRepositoryDecorator) GetChatMeetingAdminId(ctx context.Context, chatId uint) (uint, error) {
+	ctx, span := start(ctx, chatRepository, "GetChatMeetingAdminId")
+	adminId, err := d.repository.GetChatMeetingAdminId(ctx, chatId)
+	tracer.End(span, err)
+
+	return adminId, err
+}
+
+func (d ChatRepositoryDecorator) ExportMessages(
+	ctx context.Context, chatId uint, handle func(message models.TranscriptMessage) error) error {
+	ctx, span := start(ctx, chatRepository, "ExportMessages")
+	err := d.repository.ExportMessages(ctx, chatId, handle)
+	tracer.End(span, err)
+
+	return err
+}
@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/tracer"
+)
+
+const credentialsRepository = "credentials"
+
+type CredentialsRepositoryDecorator struct {
+	repository interfaces.CredentialsRepository
+}
+
+func NewCredentialsRepositoryDecorator(repository interfaces.CredentialsRepository) CredentialsRepositoryDecorator {
+	return CredentialsRepositoryDecorator{repository}
+}
+
+func (d CredentialsRepositoryDecorator) CreateUser(ctx context.Context, user models.UserCredentials) error {
+	ctx, span := start(ctx, credentialsRepository, "CreateUser")
+	err := d.repository.CreateUser(ctx, user)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d CredentialsRepositoryDecorator) GetUserIdByCredentials(
+	ctx context.Context, user models.UserCredentials,
+) (uint, error) {
+	ctx, span := start(ctx, credentialsRepository, "GetUserIdByCredentials")
+	userId, err := d.repository.GetUserIdByCredentials(ctx, user)
+	tracer.End(span, err)
+
+	return userId, err
+}
+
+func (d CredentialsRepositoryDecorator) UpdateUserPassword(ctx context.Context, user models.UserCredentials) error {
+	ctx, span := start(ctx, credentialsRepository, "UpdateUserPassword")
+	err := d.repository.UpdateUserPassword(ctx, user)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d CredentialsRepositoryDecorator) GetUserEmail(ctx context.Context, userId uint) (string, error) {
+	ctx, span := start(ctx, credentialsRepository, "GetUserEmail")
+	email, err := d.repository.GetUserEmail(ctx, userId)
+	tracer.End(span, err)
+
+	return email, err
+}
@@ -0,0 +1,89 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/tracer"
+)
+
+const meetingsRepository = "meetings"
+
+type MeetingsRepositoryDecorator struct {
+	repository interfaces.FullMeetingsRepository
+}
+
+func NewMeetingsRepositoryDecorator(repository interfaces.FullMeetingsRepository) MeetingsRepositoryDecorator {
+	return MeetingsRepositoryDecorator{repository}
+}
+
+func (d MeetingsRepositoryDecorator) GetFullMeetingInfo(
+	ctx context.Context, meetingId uint,
+) (models.PrivateMeeting, error) {
+	ctx, span := start(ctx, meetingsRepository, "GetFullMeetingInfo")
+	meeting, err := d.repository.GetFullMeetingInfo(ctx, meetingId)
+	tracer.End(span, err)
+
+	return meeting, err
+}
+
+func (d MeetingsRepositoryDecorator) GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error) {
+	ctx, span := start(ctx, meetingsRepository, "GetPublicMeetings")
+	meetings, err := d.repository.GetPublicMeetings(ctx)
+	tracer.End(span, err)
+
+	return meetings, err
+}
+
+func (d MeetingsRepositoryDecorator) GetExtendedMeetings(
+	ctx context.Context, userStatusesData models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error) {
+	ctx, span := start(ctx, meetingsRepository, "GetExtendedMeetings")
+	meetings, err := d.repository.GetExtendedMeetings(ctx, userStatusesData)
+	tracer.End(span, err)
+
+	return meetings, err
+}
+
+func (d MeetingsRepositoryDecorator) CreateMeeting(
+	ctx context.Context, adminId uint, settings models.AllSettings,
+) error {
+	ctx, span := start(ctx, meetingsRepository, "CreateMeeting")
+	err := d.repository.CreateMeeting(ctx, adminId, settings)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d MeetingsRepositoryDecorator) DeleteMeeting(ctx context.Context, meetingId uint) error {
+	ctx, span := start(ctx, meetingsRepository, "DeleteMeeting")
+	err := d.repository.DeleteMeeting(ctx, meetingId)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d MeetingsRepositoryDecorator) UpdateSettings(
+	ctx context.Context, meetingId uint, settings models.AllSettings,
+) error {
+	ctx, span := start(ctx, meetingsRepository, "UpdateSettings")
+	err := d.repository.UpdateSettings(ctx, meetingId, settings)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d MeetingsRepositoryDecorator) AddUserToMeeting(ctx context.Context, meetingId, userId uint) error {
+	ctx, span := start(ctx, meetingsRepository, "AddUserToMeeting")
+	err := d.repository.AddUserToMeeting(ctx, meetingId, userId)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d MeetingsRepositoryDecorator) KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error {
+	ctx, span := start(ctx, meetingsRepository, "KickUserFromMeeting")
+	err := d.repository.KickUserFromMeeting(ctx, meetingId, userId)
+	tracer.End(span, err)
+
+	return err
+}
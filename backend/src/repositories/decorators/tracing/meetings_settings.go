@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/tracer"
+)
+
+const meetings_settingsRepository = "meetings_settings"
+
+type MeetingsSettingsRepositoryDecorator struct {
+	repository interfaces.MeetingsSettingsRepository
+}
+
+func NewMeetingsSettingsRepositoryDecorator(
+	repository interfaces.MeetingsSettingsRepository,
+) MeetingsSettingsRepositoryDecorator {
+	return MeetingsSettingsRepositoryDecorator{repository}
+}
+
+func (d MeetingsSettingsRepositoryDecorator) GetMeetingSettings(
+	ctx context.Context, meetingId uint) (models.ParticipationMeetingSettings, error) {
+	ctx, span := start(ctx, meetings_settingsRepository, "GetMeetingSettings")
+	settings, err := d.repository.GetMeetingSettings(ctx, meetingId)
+	tracer.End(span, err)
+
+	return settings, err
+}
+
+func (d MeetingsSettingsRepositoryDecorator) GetNearMeetings(
+	ctx context.Context, data models.UserTimeCheckData) ([]models.TimeMeetingParameters, error) {
+	ctx, span := start(ctx, meetings_settingsRepository, "GetNearMeetings")
+	parameters, err := d.repository.GetNearMeetings(ctx, data)
+	tracer.End(span, err)
+
+	return parameters, err
+}
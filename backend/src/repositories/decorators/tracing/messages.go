@@ -0,0 +1,152 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/tracer"
+)
+
+const messagesRepository = "messages"
+
+type MessagesRepositoryDecorator struct {
+	repository interfaces.FullMessagesRepository
+}
+
+func NewMessagesRepositoryDecorator(repository interfaces.FullMessagesRepository) MessagesRepositoryDecorator {
+	return MessagesRepositoryDecorator{repository}
+}
+
+func (d MessagesRepositoryDecorator) Save(ctx context.Context, message models.Message) (models.Message, error) {
+	ctx, span := start(ctx, messagesRepository, "Save")
+	savedMessage, err := d.repository.Save(ctx, message)
+	tracer.End(span, err)
+
+	return savedMessage, err
+}
+
+func (d MessagesRepositoryDecorator) GetLastMessages(
+	ctx context.Context, chatId, count, viewerId uint,
+) ([]models.Message, error) {
+	ctx, span := start(ctx, messagesRepository, "GetLastMessages")
+	messages, err := d.repository.GetLastMessages(ctx, chatId, count, viewerId)
+	tracer.End(span, err)
+
+	return messages, err
+}
+
+func (d MessagesRepositoryDecorator) GetLastMessagesAfter(
+	ctx context.Context, chatId, messageId, count, viewerId uint,
+) ([]models.Message, error) {
+	ctx, span := start(ctx, messagesRepository, "GetLastMessagesAfter")
+	messages, err := d.repository.GetLastMessagesAfter(ctx, chatId, messageId, count, viewerId)
+	tracer.End(span, err)
+
+	return messages, err
+}
+
+func (d MessagesRepositoryDecorator) GetThread(
+	ctx context.Context, rootId, afterId, count, viewerId uint,
+) (models.Thread, error) {
+	ctx, span := start(ctx, messagesRepository, "GetThread")
+	thread, err := d.repository.GetThread(ctx, rootId, afterId, count, viewerId)
+	tracer.End(span, err)
+
+	return thread, err
+}
+
+func (d MessagesRepositoryDecorator) GetManagedMessage(
+	ctx context.Context, messageId uint,
+) (models.ManagedMessage, error) {
+	ctx, span := start(ctx, messagesRepository, "GetManagedMessage")
+	message, err := d.repository.GetManagedMessage(ctx, messageId)
+	tracer.End(span, err)
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) EditMessage(
+	ctx context.Context, messageId uint, text string,
+) (models.Message, error) {
+	ctx, span := start(ctx, messagesRepository, "EditMessage")
+	message, err := d.repository.EditMessage(ctx, messageId, text)
+	tracer.End(span, err)
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) DeleteMessage(ctx context.Context, messageId uint) (models.Message, error) {
+	ctx, span := start(ctx, messagesRepository, "DeleteMessage")
+	message, err := d.repository.DeleteMessage(ctx, messageId)
+	tracer.End(span, err)
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) GetMessageEdits(
+	ctx context.Context, messageId uint,
+) ([]models.MessageEdit, error) {
+	ctx, span := start(ctx, messagesRepository, "GetMessageEdits")
+	edits, err := d.repository.GetMessageEdits(ctx, messageId)
+	tracer.End(span, err)
+
+	return edits, err
+}
+
+func (d MessagesRepositoryDecorator) SetMessagePinned(
+	ctx context.Context, messageId uint, pinned bool,
+) (models.Message, error) {
+	ctx, span := start(ctx, messagesRepository, "SetMessagePinned")
+	message, err := d.repository.SetMessagePinned(ctx, messageId, pinned)
+	tracer.End(span, err)
+
+	return message, err
+}
+
+func (d MessagesRepositoryDecorator) GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error) {
+	ctx, span := start(ctx, messagesRepository, "GetPinnedMessages")
+	messages, err := d.repository.GetPinnedMessages(ctx, chatId)
+	tracer.End(span, err)
+
+	return messages, err
+}
+
+func (d MessagesRepositoryDecorator) AddReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	ctx, span := start(ctx, messagesRepository, "AddReaction")
+	change, err := d.repository.AddReaction(ctx, reaction)
+	tracer.End(span, err)
+
+	return change, err
+}
+
+func (d MessagesRepositoryDecorator) RemoveReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	ctx, span := start(ctx, messagesRepository, "RemoveReaction")
+	change, err := d.repository.RemoveReaction(ctx, reaction)
+	tracer.End(span, err)
+
+	return change, err
+}
+
+func (d MessagesRepositoryDecorator) SearchMessages(
+	ctx context.Context, query models.SearchQuery,
+) ([]models.SearchHit, error) {
+	ctx, span := start(ctx, messagesRepository, "SearchMessages")
+	hits, err := d.repository.SearchMessages(ctx, query)
+	tracer.End(span, err)
+
+	return hits, err
+}
+
+func (d MessagesRepositoryDecorator) GetNotifications(
+	ctx context.Context, userId, count uint,
+) ([]models.Notification, error) {
+	ctx, span := start(ctx, messagesRepository, "GetNotifications")
+	notifications, err := d.repository.GetNotifications(ctx, userId, count)
+	tracer.End(span, err)
+
+	return notifications, err
+}
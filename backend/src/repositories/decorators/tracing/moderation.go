@@ -0,0 +1,26 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/tracer"
+)
+
+const moderationRepository = "moderation"
+
+type ModerationRepositoryDecorator struct {
+	repository interfaces.ModerationRepository
+}
+
+func NewModerationRepositoryDecorator(repository interfaces.ModerationRepository) ModerationRepositoryDecorator {
+	return ModerationRepositoryDecorator{repository}
+}
+
+func (d ModerationRepositoryDecorator) FlagContent(ctx context.Context, flag models.ContentFlag) error {
+	ctx, span := start(ctx, moderationRepository, "FlagContent")
+	err := d.repository.FlagContent(ctx, flag)
+	tracer.End(span, err)
+
+	return err
+}
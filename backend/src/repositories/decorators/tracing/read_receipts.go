@@ -0,0 +1,36 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/tracer"
+)
+
+const read_receiptsRepository = "read_receipts"
+
+type ReadReceiptsRepositoryDecorator struct {
+	repository interfaces.ReadReceiptsRepository
+}
+
+func NewReadReceiptsRepositoryDecorator(repository interfaces.ReadReceiptsRepository) ReadReceiptsRepositoryDecorator {
+	return ReadReceiptsRepositoryDecorator{repository}
+}
+
+func (d ReadReceiptsRepositoryDecorator) MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error {
+	ctx, span := start(ctx, read_receiptsRepository, "MarkAsRead")
+	err := d.repository.MarkAsRead(ctx, receipt)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d ReadReceiptsRepositoryDecorator) GetUnreadCounts(
+	ctx context.Context, userId uint,
+) ([]models.ChatUnreadCount, error) {
+	ctx, span := start(ctx, read_receiptsRepository, "GetUnreadCounts")
+	counts, err := d.repository.GetUnreadCounts(ctx, userId)
+	tracer.End(span, err)
+
+	return counts, err
+}
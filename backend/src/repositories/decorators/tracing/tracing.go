@@ -0,0 +1,16 @@
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"plugins/tracer"
+)
+
+// repository spans are client spans, so time of storage is shown separately from time of application
+func start(ctx context.Context, repository, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "repository."+repository+"."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("repository", repository), attribute.String("method", method)),
+	)
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"mock/plugins"
+	"models"
+	"plugins/tracer"
+	"testing"
+	"utils"
+)
+
+type moderationRepositoryMock struct {
+	err error
+}
+
+func (m moderationRepositoryMock) FlagContent(context.Context, models.ContentFlag) error {
+	return m.err
+}
+
+func TestDecorator_StartsChildSpan(t *testing.T) {
+	spans := plugins.RecordSpans()
+	ctx, parent := tracer.Start(context.Background(), "parent")
+
+	err := NewModerationRepositoryDecorator(moderationRepositoryMock{}).FlagContent(ctx, models.ContentFlag{})
+	parent.End()
+
+	utils.AssertNil(err, t)
+	ended := spans.GetSpans()
+	utils.AssertEqual(2, len(ended), t)
+	utils.AssertEqual("repository.moderation.FlagContent", ended[0].Name, t)
+	utils.AssertEqual(trace.SpanKindClient, ended[0].SpanKind, t)
+	utils.AssertEqual(ended[1].SpanContext.SpanID(), ended[0].Parent.SpanID(), t)
+	utils.AssertEqual(codes.Unset, ended[0].Status.Code, t)
+}
+
+func TestDecorator_RecordsError(t *testing.T) {
+	spans := plugins.RecordSpans()
+	repositoryError := errors.New("connection refused")
+
+	decorator := NewModerationRepositoryDecorator(moderationRepositoryMock{repositoryError})
+	err := decorator.FlagContent(context.Background(), models.ContentFlag{})
+
+	utils.AssertErrorsEqual(repositoryError, err, t)
+	ended := spans.GetSpans()
+	utils.AssertEqual(1, len(ended), t)
+	utils.AssertEqual(codes.Error, ended[0].Status.Code, t)
+}
@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"plugins/tracer"
+)
+
+const user_settingsRepository = "user_settings"
+
+type UserSettingsRepositoryDecorator struct {
+	repository interfaces.UsersSettings
+}
+
+func NewUserSettingsRepositoryDecorator(repository interfaces.UsersSettings) UserSettingsRepositoryDecorator {
+	return UserSettingsRepositoryDecorator{repository}
+}
+
+func (d UserSettingsRepositoryDecorator) GetUserSettings(
+	ctx context.Context, userId uint,
+) (models.FullUserInfo, error) {
+	ctx, span := start(ctx, user_settingsRepository, "GetUserSettings")
+	info, err := d.repository.GetUserSettings(ctx, userId)
+	tracer.End(span, err)
+
+	return info, err
+}
+
+func (d UserSettingsRepositoryDecorator) UpdateUserSettings(
+	ctx context.Context, userId uint, info models.UserSettings,
+) error {
+	ctx, span := start(ctx, user_settingsRepository, "UpdateUserSettings")
+	err := d.repository.UpdateUserSettings(ctx, userId, info)
+	tracer.End(span, err)
+
+	return err
+}
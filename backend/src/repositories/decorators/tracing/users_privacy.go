@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"plugins/tracer"
+)
+
+const users_privacyRepository = "users_privacy"
+
+type UsersPrivacyRepositoryDecorator struct {
+	repository interfaces.UsersPrivacyRepository
+}
+
+func NewUsersPrivacyRepositoryDecorator(repository interfaces.UsersPrivacyRepository) UsersPrivacyRepositoryDecorator {
+	return UsersPrivacyRepositoryDecorator{repository}
+}
+
+func (d UsersPrivacyRepositoryDecorator) BlockUser(ctx context.Context, userId, blockedUserId uint) error {
+	ctx, span := start(ctx, users_privacyRepository, "BlockUser")
+	err := d.repository.BlockUser(ctx, userId, blockedUserId)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d UsersPrivacyRepositoryDecorator) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	ctx, span := start(ctx, users_privacyRepository, "UnblockUser")
+	err := d.repository.UnblockUser(ctx, userId, blockedUserId)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d UsersPrivacyRepositoryDecorator) SetDirectMessagesAllowed(
+	ctx context.Context, userId uint, allowed bool,
+) error {
+	ctx, span := start(ctx, users_privacyRepository, "SetDirectMessagesAllowed")
+	err := d.repository.SetDirectMessagesAllowed(ctx, userId, allowed)
+	tracer.End(span, err)
+
+	return err
+}
+
+func (d UsersPrivacyRepositoryDecorator) DirectMessagesAllowed(
+	ctx context.Context, senderId, recipientId uint,
+) (bool, error) {
+	ctx, span := start(ctx, users_privacyRepository, "DirectMessagesAllowed")
+	allowed, err := d.repository.DirectMessagesAllowed(ctx, senderId, recipientId)
+	tracer.End(span, err)
+
+	return allowed, err
+}
@@ -3,35 +3,109 @@ package repositories
 import (
 	"github.com/jmoiron/sqlx"
 	"interfaces"
+	"repositories/attachments"
+	"repositories/blobs"
 	"repositories/chat"
 	"repositories/credentials"
 	"repositories/decorators/logging"
+	"repositories/decorators/metrics"
+	"repositories/decorators/timeout"
+	"repositories/decorators/tracing"
 	"repositories/meetings"
 	"repositories/meetings_settings"
 	"repositories/messages"
+	"repositories/moderation"
+	"repositories/read_receipts"
 	"repositories/user_settings"
+	"repositories/users_privacy"
+	"time"
 )
 
-func Credentials(db *sqlx.DB) interfaces.CredentialsRepository {
-	return logging.NewCredentialsRepositoryDecorator(credentials.New(db))
+func Credentials(db *sqlx.DB, queryTimeout time.Duration) interfaces.CredentialsRepository {
+	var repository interfaces.CredentialsRepository = credentials.New(db)
+	repository = timeout.NewCredentialsRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewCredentialsRepositoryDecorator(repository)
+	repository = metrics.NewCredentialsRepositoryDecorator(repository)
+	return logging.NewCredentialsRepositoryDecorator(repository)
 }
 
-func Meetings(db *sqlx.DB) interfaces.FullMeetingsRepository {
-	return logging.NewMeetingsRepositoryDecorator(meetings.New(db))
+func Meetings(db *sqlx.DB, queryTimeout time.Duration) interfaces.FullMeetingsRepository {
+	var repository interfaces.FullMeetingsRepository = meetings.New(db)
+	repository = timeout.NewMeetingsRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewMeetingsRepositoryDecorator(repository)
+	repository = metrics.NewMeetingsRepositoryDecorator(repository)
+	return logging.NewMeetingsRepositoryDecorator(repository)
 }
 
-func MeetingsSettings(db *sqlx.DB) interfaces.MeetingsSettingsRepository {
-	return logging.NewMeetingsSettingsRepositoryDecorator(meetings_settings.New(db))
+func MeetingsSettings(db *sqlx.DB, queryTimeout time.Duration) interfaces.MeetingsSettingsRepository {
+	var repository interfaces.MeetingsSettingsRepository = meetings_settings.New(db)
+	repository = timeout.NewMeetingsSettingsRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewMeetingsSettingsRepositoryDecorator(repository)
+	repository = metrics.NewMeetingsSettingsRepositoryDecorator(repository)
+	return logging.NewMeetingsSettingsRepositoryDecorator(repository)
 }
 
-func UserSettings(db *sqlx.DB) interfaces.UsersSettings {
-	return logging.NewUserSettingsRepositoryDecorator(user_settings.New(db))
+func UserSettings(db *sqlx.DB, queryTimeout time.Duration) interfaces.UsersSettings {
+	var repository interfaces.UsersSettings = user_settings.New(db)
+	repository = timeout.NewUserSettingsRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewUserSettingsRepositoryDecorator(repository)
+	repository = metrics.NewUserSettingsRepositoryDecorator(repository)
+	return logging.NewUserSettingsRepositoryDecorator(repository)
 }
 
-func Chat(db *sqlx.DB) interfaces.FullChatsRepository {
-	return logging.NewChatRepositoryDecorator(chat.New(db))
+func Chat(db *sqlx.DB, queryTimeout time.Duration) interfaces.FullChatsRepository {
+	var repository interfaces.FullChatsRepository = chat.New(db)
+	repository = timeout.NewChatRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewChatRepositoryDecorator(repository)
+	repository = metrics.NewChatRepositoryDecorator(repository)
+	return logging.NewChatRepositoryDecorator(repository)
 }
 
-func Messages(db *sqlx.DB) interfaces.Messages {
-	return logging.NewMessagesRepositoryDecorator(messages.New(db))
+func Messages(db *sqlx.DB, queryTimeout time.Duration) interfaces.FullMessagesRepository {
+	var repository interfaces.FullMessagesRepository = messages.New(db)
+	repository = timeout.NewMessagesRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewMessagesRepositoryDecorator(repository)
+	repository = metrics.NewMessagesRepositoryDecorator(repository)
+	return logging.NewMessagesRepositoryDecorator(repository)
+}
+
+func UsersPrivacy(db *sqlx.DB, queryTimeout time.Duration) interfaces.UsersPrivacyRepository {
+	var repository interfaces.UsersPrivacyRepository = users_privacy.New(db)
+	repository = timeout.NewUsersPrivacyRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewUsersPrivacyRepositoryDecorator(repository)
+	repository = metrics.NewUsersPrivacyRepositoryDecorator(repository)
+	return logging.NewUsersPrivacyRepositoryDecorator(repository)
+}
+
+func ReadReceipts(db *sqlx.DB, queryTimeout time.Duration) interfaces.ReadReceiptsRepository {
+	var repository interfaces.ReadReceiptsRepository = read_receipts.New(db)
+	repository = timeout.NewReadReceiptsRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewReadReceiptsRepositoryDecorator(repository)
+	repository = metrics.NewReadReceiptsRepositoryDecorator(repository)
+	return logging.NewReadReceiptsRepositoryDecorator(repository)
+}
+
+func Attachments(db *sqlx.DB, queryTimeout time.Duration) interfaces.AttachmentsRepository {
+	var repository interfaces.AttachmentsRepository = attachments.New(db)
+	repository = timeout.NewAttachmentsRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewAttachmentsRepositoryDecorator(repository)
+	repository = metrics.NewAttachmentsRepositoryDecorator(repository)
+	return logging.NewAttachmentsRepositoryDecorator(repository)
+}
+
+func Moderation(db *sqlx.DB, queryTimeout time.Duration) interfaces.ModerationRepository {
+	var repository interfaces.ModerationRepository = moderation.New(db)
+	repository = timeout.NewModerationRepositoryDecorator(repository, queryTimeout)
+	repository = tracing.NewModerationRepositoryDecorator(repository)
+	repository = metrics.NewModerationRepositoryDecorator(repository)
+	return logging.NewModerationRepositoryDecorator(repository)
+}
+
+// blobs are stored in root directory of local file system
+func LocalBlobStore(root string, queryTimeout time.Duration) interfaces.BlobStore {
+	var store interfaces.BlobStore = blobs.New(root)
+	store = timeout.NewBlobStoreDecorator(store, queryTimeout)
+	store = tracing.NewBlobStoreDecorator(store)
+	store = metrics.NewBlobStoreDecorator(store)
+	return logging.NewBlobStoreDecorator(store)
 }
@@ -1,6 +1,7 @@
 package meetings
 
 import (
+	"context"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"internal_errors"
@@ -54,9 +55,21 @@ const (
   duration = :duration, min_age = :min_age, gender = :gender, request_description_required = :request_description_required
   WHERE meeting_id = :meeting_id`
 
-	AddUserIdToMeetingQuery  = `UPDATE meetings SET user_ids = array_append(user_ids, :user_id) WHERE id = :meeting_id`
-	MeetingHasUserQuery      = `SELECT 1 FROM meetings WHERE id = :meeting_id AND :user_id = ANY(user_ids)`
-	KickUserFromMeetingQuery = `UPDATE meetings SET user_ids = array_remove(user_ids, :user_id) WHERE id = :meeting_id`
+	AddUserIdToMeetingQuery   = `UPDATE meetings SET user_ids = array_append(user_ids, :user_id) WHERE id = :meeting_id`
+	MeetingHasUserQuery       = `SELECT 1 FROM meetings WHERE id = :meeting_id AND :user_id = ANY(user_ids)`
+	KickUserFromMeetingQuery  = `UPDATE meetings SET user_ids = array_remove(user_ids, :user_id) WHERE id = :meeting_id`
+	AddMeetingChatMemberQuery = `
+	WITH request_chat AS (
+		UPDATE chats SET status = 'archived'
+		WHERE meeting_id = :meeting_id AND applicant_id = :user_id AND type = 'meeting_request' AND status != 'archived'
+	)
+	INSERT INTO chats_members(chat_id, user_id)
+	SELECT id, :user_id FROM chats WHERE meeting_id = :meeting_id AND type = 'meeting'
+	ON CONFLICT (chat_id, user_id) DO NOTHING`
+	RemoveMeetingChatMemberQuery = `
+	DELETE FROM chats_members WHERE user_id = :user_id AND chat_id IN (
+		SELECT id FROM chats WHERE meeting_id = :meeting_id AND type = 'meeting'
+	)`
 )
 
 type Repository struct {
@@ -67,11 +80,11 @@ func New(db *sqlx.DB) Repository {
 	return Repository{db}
 }
 
-func (r Repository) GetFullMeetingInfo(meetingId uint) (models.PrivateMeeting, error) {
+func (r Repository) GetFullMeetingInfo(ctx context.Context, meetingId uint) (models.PrivateMeeting, error) {
 	var info = models.PrivateMeeting{
 		LabeledPlace: &models.LabeledPlace{},
 	}
-	rows, err := r.db.Query(FullMeetingInfoQuery, meetingId)
+	rows, err := r.db.QueryContext(ctx, FullMeetingInfoQuery, meetingId)
 	if err != nil {
 		return info, err
 	}
@@ -91,9 +104,9 @@ func (r Repository) GetFullMeetingInfo(meetingId uint) (models.PrivateMeeting, e
 	return info, nil
 }
 
-func (r Repository) GetPublicMeetings() ([]models.PublicMeeting, error) {
+func (r Repository) GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error) {
 	var meetings []models.PublicMeeting
-	rows, err := r.db.Query(PublicMeetingInfoQuery)
+	rows, err := r.db.QueryContext(ctx, PublicMeetingInfoQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -116,9 +129,9 @@ func (r Repository) GetPublicMeetings() ([]models.PublicMeeting, error) {
 }
 
 func (r Repository) GetExtendedMeetings(
-	userStatusesData models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error) {
+	ctx context.Context, userStatusesData models.UserMeetingStatusesData) ([]models.ExtendedMeeting, error) {
 	var meetings []models.ExtendedMeeting
-	rows, err := r.db.NamedQuery(ExtendedMeetingInfoQuery, userStatusesData)
+	rows, err := r.db.NamedQueryContext(ctx, ExtendedMeetingInfoQuery, userStatusesData)
 	if err != nil {
 		return nil, err
 	}
@@ -141,18 +154,18 @@ func (r Repository) GetExtendedMeetings(
 	return meetings, nil
 }
 
-func (r Repository) CreateMeeting(adminId uint, settings models.AllSettings) error {
-	addedMeetingId, err := r.addMeeting(adminId)
+func (r Repository) CreateMeeting(ctx context.Context, adminId uint, settings models.AllSettings) error {
+	addedMeetingId, err := r.addMeeting(ctx, adminId)
 	if err != nil {
 		return err
 	}
 
-	return r.addMeetingSettings(addedMeetingId, settings)
+	return r.addMeetingSettings(ctx, addedMeetingId, settings)
 }
 
-func (r Repository) addMeeting(adminId uint) (uint, error) {
+func (r Repository) addMeeting(ctx context.Context, adminId uint) (uint, error) {
 	var addedMeetingId uint
-	err := r.db.QueryRow(AddMeetingQuery, adminId, pq.Array([]uint{adminId})).Scan(&addedMeetingId)
+	err := r.db.QueryRowContext(ctx, AddMeetingQuery, adminId, pq.Array([]uint{adminId})).Scan(&addedMeetingId)
 
 	switch {
 	case err == nil:
@@ -164,18 +177,18 @@ func (r Repository) addMeeting(adminId uint) (uint, error) {
 	}
 }
 
-func (r Repository) addMeetingSettings(meetingId uint, settings models.AllSettings) error {
+func (r Repository) addMeetingSettings(ctx context.Context, meetingId uint, settings models.AllSettings) error {
 	meeting := r.meetingSettingsToMap(meetingId, settings)
-	tx, err := r.db.Beginx()
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = tx.NamedExec(AddMeetingSettingsQuery, meeting)
+	_, err = tx.NamedExecContext(ctx, AddMeetingSettingsQuery, meeting)
 	if err != nil {
 		return err
 	}
-	_, err = tx.NamedExec(AddMeetingPlaceQuery, meeting)
+	_, err = tx.NamedExecContext(ctx, AddMeetingPlaceQuery, meeting)
 	if err != nil {
 		return err
 	}
@@ -201,9 +214,9 @@ func (r Repository) meetingSettingsToMap(meetingId uint, settings models.AllSett
 	}
 }
 
-func (r Repository) DeleteMeeting(meetingId uint) error {
+func (r Repository) DeleteMeeting(ctx context.Context, meetingId uint) error {
 	var deletedId uint
-	err := r.db.QueryRow(DeleteMeetingQuery, meetingId).Scan(&deletedId)
+	err := r.db.QueryRowContext(ctx, DeleteMeetingQuery, meetingId).Scan(&deletedId)
 
 	switch {
 	case err == nil:
@@ -215,9 +228,9 @@ func (r Repository) DeleteMeeting(meetingId uint) error {
 	}
 }
 
-func (r Repository) UpdateSettings(meetingId uint, settings models.AllSettings) error {
+func (r Repository) UpdateSettings(ctx context.Context, meetingId uint, settings models.AllSettings) error {
 	meeting := r.meetingSettingsToMap(meetingId, settings)
-	res, err := r.db.NamedExec(UpdateMeetingSettingsQuery, meeting)
+	res, err := r.db.NamedExecContext(ctx, UpdateMeetingSettingsQuery, meeting)
 	if err != nil {
 		return err
 	}
@@ -234,8 +247,9 @@ func (r Repository) UpdateSettings(meetingId uint, settings models.AllSettings)
 	return nil
 }
 
-func (r Repository) AddUserToMeeting(meetingId, userId uint) error {
-	userInMeeting, err := r.meetingHasUser(meetingId, userId)
+// adding user to meeting accepts participation request, so meeting request chat of user is closed
+func (r Repository) AddUserToMeeting(ctx context.Context, meetingId, userId uint) error {
+	userInMeeting, err := r.meetingHasUser(ctx, meetingId, userId)
 	if err != nil {
 		return err
 	}
@@ -243,11 +257,11 @@ func (r Repository) AddUserToMeeting(meetingId, userId uint) error {
 		return internal_errors.UserAlreadyInMeeting
 	}
 
-	return r.updateMeetingUserIds(AddUserIdToMeetingQuery, meetingId, userId)
+	return r.updateMeetingUserIds(ctx, AddUserIdToMeetingQuery, AddMeetingChatMemberQuery, meetingId, userId)
 }
 
-func (r Repository) meetingHasUser(meetingId, userId uint) (bool, error) {
-	rows, err := r.db.NamedQuery(MeetingHasUserQuery, r.getNamedArguments(meetingId, userId))
+func (r Repository) meetingHasUser(ctx context.Context, meetingId, userId uint) (bool, error) {
+	rows, err := r.db.NamedQueryContext(ctx, MeetingHasUserQuery, r.getNamedArguments(meetingId, userId))
 	if err != nil {
 		return false, err
 	}
@@ -262,25 +276,43 @@ func (r Repository) getNamedArguments(meetingId, userId uint) map[string]interfa
 	}
 }
 
-func (r Repository) updateMeetingUserIds(query string, meetingId, userId uint) error {
-	res, err := r.db.NamedExec(query, r.getNamedArguments(meetingId, userId))
+// meeting chat members are updated in the same transaction to keep them equal to meeting users
+func (r Repository) updateMeetingUserIds(
+	ctx context.Context, query, chatMembersQuery string, meetingId, userId uint,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
+	arguments := r.getNamedArguments(meetingId, userId)
+	res, err := tx.NamedExecContext(ctx, query, arguments)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
+		_ = tx.Rollback()
 		return err
 	}
 	if rowsAffected == 0 {
+		_ = tx.Rollback()
 		return internal_errors.UnableToFindMeetingById
 	}
 
-	return nil
+	_, err = tx.NamedExecContext(ctx, chatMembersQuery, arguments)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func (r Repository) KickUserFromMeeting(meetingId, userId uint) error {
-	userInMeeting, err := r.meetingHasUser(meetingId, userId)
+func (r Repository) KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error {
+	userInMeeting, err := r.meetingHasUser(ctx, meetingId, userId)
 	if err != nil {
 		return err
 	}
@@ -288,5 +320,5 @@ func (r Repository) KickUserFromMeeting(meetingId, userId uint) error {
 		return internal_errors.UserNotInMeeting
 	}
 
-	return r.updateMeetingUserIds(KickUserFromMeetingQuery, meetingId, userId)
+	return r.updateMeetingUserIds(ctx, KickUserFromMeetingQuery, RemoveMeetingChatMemberQuery, meetingId, userId)
 }
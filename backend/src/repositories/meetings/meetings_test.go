@@ -1,6 +1,7 @@
 package meetings
 
 import (
+	"context"
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
@@ -44,7 +45,7 @@ func TestRepository_GetFullMeetingInfoSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	info, err := repository.GetFullMeetingInfo(1)
+	info, err := repository.GetFullMeetingInfo(context.Background(), 1)
 	utils.AssertNil(err, t)
 	utils.AssertEqual(mock.GetFirstLabeledPlace().Label, info.LabeledPlace.Label, t)
 	utils.AssertEqual(mock.GetFirstLabeledPlace().GetLatitude(), info.LabeledPlace.GetLatitude(), t)
@@ -55,14 +56,14 @@ func TestRepository_GetFullMeetingInfoMeetingNotFoundError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	_, err := repository.GetFullMeetingInfo(mock.GetNotExistsMeetingId())
+	_, err := repository.GetFullMeetingInfo(context.Background(), mock.GetNotExistsMeetingId())
 	utils.AssertErrorsEqual(internal_errors.UnableToFindMeetingById, err, t)
 }
 
 func TestRepository_GetFullMeetingInfoNoTableError(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetFullMeetingInfo(mock.GetNotExistsMeetingId())
+	_, err := repository.GetFullMeetingInfo(context.Background(), mock.GetNotExistsMeetingId())
 	utils.AssertNotNil(err, t)
 }
 
@@ -70,7 +71,7 @@ func TestRepository_GetPublicMeetingsSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	meetings, err := repository.GetPublicMeetings()
+	meetings, err := repository.GetPublicMeetings(context.Background())
 	utils.AssertNil(err, t)
 	for idx, meeting := range meetings {
 		utils.AssertEqual(mock.GetPlaceLongitudeById(idx), meeting.PublicPlace.Longitude, t)
@@ -81,7 +82,7 @@ func TestRepository_GetPublicMeetingsSuccess(t *testing.T) {
 func TestRepository_GetPublicMeetingsNoTableError(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetPublicMeetings()
+	_, err := repository.GetPublicMeetings(context.Background())
 	utils.AssertNotNil(err, t)
 }
 
@@ -89,7 +90,7 @@ func TestRepository_GetExtendedMeetingsSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	meetings, err := repository.GetExtendedMeetings(models.UserMeetingStatusesData{
+	meetings, err := repository.GetExtendedMeetings(context.Background(), models.UserMeetingStatusesData{
 		UserId:     1,
 		Invited:    "invited",
 		NotInvited: "not-invited",
@@ -103,7 +104,7 @@ func TestRepository_GetExtendedMeetingsSuccess(t *testing.T) {
 func TestRepository_GetExtendedMeetingsNoTableError(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetExtendedMeetings(models.UserMeetingStatusesData{
+	_, err := repository.GetExtendedMeetings(context.Background(), models.UserMeetingStatusesData{
 		UserId:     1,
 		Invited:    "invited",
 		NotInvited: "not-invited",
@@ -115,9 +116,9 @@ func TestRepository_CreateMeetingSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.CreateMeeting(1, servicesMock.NewMeetingSettings)
+	err := repository.CreateMeeting(context.Background(), 1, servicesMock.NewMeetingSettings)
 	utils.AssertNil(err, t)
-	meeting, _ := repository.GetFullMeetingInfo(uint(len(mock.Meetings) + 1))
+	meeting, _ := repository.GetFullMeetingInfo(context.Background(), uint(len(mock.Meetings)+1))
 	utils.AssertEqual(servicesMock.NewMeetingSettings.Label, meeting.LabeledPlace.Label, t)
 	utils.AssertEqual((&servicesMock.NewMeetingSettings).GetLatitude(), meeting.LabeledPlace.GetLatitude(), t)
 	utils.AssertEqual((&servicesMock.NewMeetingSettings).GetLongitude(), meeting.LabeledPlace.GetLongitude(), t)
@@ -127,14 +128,14 @@ func TestRepository_CreateMeetingAdminNotFoundError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.CreateMeeting(mock.GetNotExistsUserId(), servicesMock.NewMeetingSettings)
+	err := repository.CreateMeeting(context.Background(), mock.GetNotExistsUserId(), servicesMock.NewMeetingSettings)
 	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
 }
 
 func TestRepository_CreateMeetingNoTableError(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.CreateMeeting(mock.GetNotExistsMeetingId(), servicesMock.NewMeetingSettings)
+	err := repository.CreateMeeting(context.Background(), mock.GetNotExistsMeetingId(), servicesMock.NewMeetingSettings)
 	utils.AssertNotNil(err, t)
 }
 
@@ -142,9 +143,9 @@ func TestRepository_DeleteMeetingSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.DeleteMeeting(1)
+	err := repository.DeleteMeeting(context.Background(), 1)
 	utils.AssertNil(err, t)
-	_, err = repository.GetFullMeetingInfo(1)
+	_, err = repository.GetFullMeetingInfo(context.Background(), 1)
 	utils.AssertErrorsEqual(internal_errors.UnableToFindMeetingById, err, t)
 }
 
@@ -152,14 +153,14 @@ func TestRepository_DeleteMeetingMeetingNotFoundError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.DeleteMeeting(mock.GetNotExistsMeetingId())
+	err := repository.DeleteMeeting(context.Background(), mock.GetNotExistsMeetingId())
 	utils.AssertErrorsEqual(internal_errors.UnableToFindMeetingById, err, t)
 }
 
 func TestRepository_DeleteMeetingNoTableError(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.DeleteMeeting(mock.GetNotExistsMeetingId())
+	err := repository.DeleteMeeting(context.Background(), mock.GetNotExistsMeetingId())
 	utils.AssertNotNil(err, t)
 }
 
@@ -167,9 +168,9 @@ func TestRepository_UpdatedSettingsSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.UpdateSettings(2, servicesMock.NewMeetingSettings)
+	err := repository.UpdateSettings(context.Background(), 2, servicesMock.NewMeetingSettings)
 	utils.AssertNil(err, t)
-	meeting, _ := repository.GetFullMeetingInfo(2)
+	meeting, _ := repository.GetFullMeetingInfo(context.Background(), 2)
 	utils.AssertEqual(servicesMock.NewMeetingSettings.Title, meeting.Title, t)
 }
 
@@ -177,14 +178,16 @@ func TestRepository_UpdatedSettingsMeetingNotFoundError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.UpdateSettings(mock.GetNotExistsMeetingId(), servicesMock.NewMeetingSettings)
+	err := repository.UpdateSettings(
+		context.Background(), mock.GetNotExistsMeetingId(), servicesMock.NewMeetingSettings)
 	utils.AssertErrorsEqual(internal_errors.UnableToFindMeetingById, err, t)
 }
 
 func TestRepository_UpdatedSettingsNoTableError(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.UpdateSettings(mock.GetNotExistsMeetingId(), servicesMock.NewMeetingSettings)
+	err := repository.UpdateSettings(
+		context.Background(), mock.GetNotExistsMeetingId(), servicesMock.NewMeetingSettings)
 	utils.AssertNotNil(err, t)
 }
 
@@ -192,18 +195,42 @@ func TestRepository_AddUserToMeetingSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.AddUserToMeeting(1, mock.UserIdThatNotInFirstMeeting)
-	userInMeeting, _ := repository.meetingHasUser(1, mock.UserIdThatNotInFirstMeeting)
+	err := repository.AddUserToMeeting(context.Background(), 1, mock.UserIdThatNotInFirstMeeting)
+	userInMeeting, _ := repository.meetingHasUser(context.Background(), 1, mock.UserIdThatNotInFirstMeeting)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(userInMeeting, t)
+	utils.AssertTrue(isMeetingChatMember(1, mock.UserIdThatNotInFirstMeeting), t)
+}
+
+func TestRepository_AddUserToMeetingClosesRequestChat(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.AddUserToMeeting(context.Background(), 1, mock.FirstMeetingApplicantId)
+
+	utils.AssertNil(err, t)
+	utils.AssertFalse(hasOpenedRequestChat(1, mock.FirstMeetingApplicantId), t)
+}
+
+func hasOpenedRequestChat(meetingId, applicantId uint) bool {
+	var count int
+	err := db.Get(&count, `
+	SELECT COUNT(*) FROM chats
+	WHERE meeting_id = $1 AND applicant_id = $2 AND type = 'meeting_request' AND status != 'archived'`,
+		meetingId, applicantId)
+	if err != nil {
+		panic(err)
+	}
+
+	return count != 0
 }
 
 func TestRepository_AddUserToMeetingUserAlreadyInMeetingError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.AddUserToMeeting(1, 1)
+	err := repository.AddUserToMeeting(context.Background(), 1, 1)
 	utils.AssertErrorsEqual(internal_errors.UserAlreadyInMeeting, err, t)
 }
 
@@ -211,7 +238,7 @@ func TestRepository_AddUserToMeetingNotExistsError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.AddUserToMeeting(0, 1)
+	err := repository.AddUserToMeeting(context.Background(), 0, 1)
 
 	utils.AssertErrorsEqual(internal_errors.UnableToFindMeetingById, err, t)
 }
@@ -219,7 +246,7 @@ func TestRepository_AddUserToMeetingNotExistsError(t *testing.T) {
 func TestRepository_AddUserToMeetingInternalError(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.AddUserToMeeting(1, 1)
+	err := repository.AddUserToMeeting(context.Background(), 1, 1)
 	utils.AssertNotNil(err, t)
 }
 
@@ -227,18 +254,32 @@ func TestRepository_KickUserFromMeetingSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.KickUserFromMeeting(1, 1)
-	userInMeeting, _ := repository.meetingHasUser(1, 1)
+	err := repository.KickUserFromMeeting(context.Background(), 1, 1)
+	userInMeeting, _ := repository.meetingHasUser(context.Background(), 1, 1)
 
 	utils.AssertNil(err, t)
 	utils.AssertFalse(userInMeeting, t)
+	utils.AssertFalse(isMeetingChatMember(1, 1), t)
+}
+
+func isMeetingChatMember(meetingId, userId uint) bool {
+	var count int
+	err := db.Get(&count, `
+	SELECT COUNT(*) FROM chats_members cm JOIN chats c ON c.id = cm.chat_id
+	WHERE c.meeting_id = $1 AND c.type = 'meeting' AND cm.user_id = $2`, meetingId, userId)
+	if err != nil {
+		panic(err)
+	}
+
+	return count != 0
 }
 
 func TestRepository_KickUserFromMeetingNotExistsError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.updateMeetingUserIds(KickUserFromMeetingQuery, 0, 1)
+	err := repository.updateMeetingUserIds(
+		context.Background(), KickUserFromMeetingQuery, RemoveMeetingChatMemberQuery, 0, 1)
 
 	utils.AssertErrorsEqual(internal_errors.UnableToFindMeetingById, err, t)
 }
@@ -247,7 +288,7 @@ func TestRepository_KickUserFromMeetingUserNotInMeetingError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.KickUserFromMeeting(1, mock.UserIdThatNotInFirstMeeting)
+	err := repository.KickUserFromMeeting(context.Background(), 1, mock.UserIdThatNotInFirstMeeting)
 
 	utils.AssertErrorsEqual(internal_errors.UserNotInMeeting, err, t)
 }
@@ -255,13 +296,14 @@ func TestRepository_KickUserFromMeetingUserNotInMeetingError(t *testing.T) {
 func TestRepository_KickUserFromMeetingInternalError(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.KickUserFromMeeting(1, 1)
+	err := repository.KickUserFromMeeting(context.Background(), 1, 1)
 	utils.AssertNotNil(err, t)
 }
 
 func TestRepository_UpdateMeetingUserIdsInternalError(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.updateMeetingUserIds(AddUserIdToMeetingQuery, 1, 1)
+	err := repository.updateMeetingUserIds(
+		context.Background(), AddUserIdToMeetingQuery, AddMeetingChatMemberQuery, 1, 1)
 	utils.AssertNotNil(err, t)
 }
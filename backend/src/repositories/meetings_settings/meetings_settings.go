@@ -1,6 +1,7 @@
 package meetings_settings
 
 import (
+	"context"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"internal_errors"
@@ -33,9 +34,11 @@ func New(db *sqlx.DB) Repository {
 	return Repository{db}
 }
 
-func (r Repository) GetMeetingSettings(meetingId uint) (models.ParticipationMeetingSettings, error) {
+func (r Repository) GetMeetingSettings(
+	ctx context.Context, meetingId uint,
+) (models.ParticipationMeetingSettings, error) {
 	var settings models.ParticipationMeetingSettings
-	rows, err := r.db.Query(GetMeetingSettingsQuery, meetingId)
+	rows, err := r.db.QueryContext(ctx, GetMeetingSettingsQuery, meetingId)
 	if err != nil {
 		return models.ParticipationMeetingSettings{}, err
 	}
@@ -51,9 +54,11 @@ func (r Repository) GetMeetingSettings(meetingId uint) (models.ParticipationMeet
 	return settings, err
 }
 
-func (r Repository) GetNearMeetings(data models.UserTimeCheckData) ([]models.TimeMeetingParameters, error) {
+func (r Repository) GetNearMeetings(
+	ctx context.Context, data models.UserTimeCheckData,
+) ([]models.TimeMeetingParameters, error) {
 	var meetings []models.TimeMeetingParameters
-	rows, err := r.db.NamedQuery(GetNearMeetingsQuery, data)
+	rows, err := r.db.NamedQueryContext(ctx, GetNearMeetingsQuery, data)
 	if err != nil {
 		return nil, err
 	}
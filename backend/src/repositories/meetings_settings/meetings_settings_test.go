@@ -1,6 +1,7 @@
 package meetings_settings
 
 import (
+	"context"
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
@@ -42,7 +43,7 @@ func TestRepository_GetMeetingSettingsSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	settings, err := repository.GetMeetingSettings(1)
+	settings, err := repository.GetMeetingSettings(context.Background(), 1)
 	utils.AssertNil(err, t)
 	utils.AssertEqual(uint(1), settings.UsersCount, t)
 }
@@ -51,14 +52,14 @@ func TestRepository_GetMeetingSettingsMeetingNotFoundError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	_, err := repository.GetMeetingSettings(mock.GetNotExistsMeetingId())
+	_, err := repository.GetMeetingSettings(context.Background(), mock.GetNotExistsMeetingId())
 	utils.AssertErrorsEqual(internal_errors.UnableToFindMeetingById, err, t)
 }
 
 func TestRepository_GetMeetingSettingsTableNotFoundError(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetMeetingSettings(1)
+	_, err := repository.GetMeetingSettings(context.Background(), 1)
 	utils.AssertNotNil(err, t)
 }
 
@@ -66,7 +67,7 @@ func TestRepository_GetBeforeMeetingsSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	meetings, err := repository.GetNearMeetings(mock.TimeCheckData)
+	meetings, err := repository.GetNearMeetings(context.Background(), mock.TimeCheckData)
 	utils.AssertNil(err, t)
 	utils.AssertTrue(meetings[0].DateTime.Equal(mock.ExpectedDate), t)
 }
@@ -74,6 +75,6 @@ func TestRepository_GetBeforeMeetingsSuccess(t *testing.T) {
 func TestRepository_GetNearMeetingsTableNotFoundError(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetNearMeetings(mock.TimeCheckData)
+	_, err := repository.GetNearMeetings(context.Background(), mock.TimeCheckData)
 	utils.AssertNotNil(err, t)
 }
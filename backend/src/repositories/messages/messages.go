@@ -1,26 +1,167 @@
 package messages
 
 import (
+	"context"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"internal_errors"
 	"models"
+	"repositories/users_privacy"
+	"sort"
 )
 
 const (
-	SaveMessageQuery     = `INSERT INTO messages(chat_id, sender_id, text) VALUES(:chat_id, :sender_id, :text)`
+	// chat status can not be changed until message is saved,
+	// recipient of direct chat is 0 if sender is not one of its users
+	GetChatStatusQuery = `
+	SELECT c.status, c.type, COALESCE(m.admin_id, 0) AS meeting_admin_id, COALESCE(
+		CASE $2::INTEGER WHEN dc.first_user_id THEN dc.second_user_id WHEN dc.second_user_id THEN dc.first_user_id END, 0
+	) AS direct_recipient_id
+	FROM chats c
+	LEFT JOIN meetings m ON m.id = c.meeting_id AND c.type = 'meeting'
+	LEFT JOIN direct_chats dc ON dc.chat_id = c.id AND c.type = 'direct'
+	WHERE c.id = $1 FOR SHARE OF c`
+	// replied message must be not deleted message of the same chat, nothing is inserted otherwise
+	SaveMessageQuery = `
+	INSERT INTO messages(chat_id, sender_id, text, reply_to, thread_root_id, announcement)
+	SELECT :chat_id, :sender_id, :text, p.id, COALESCE(p.thread_root_id, p.id), :announcement
+	FROM (SELECT CAST(:reply_to AS INTEGER) AS reply_to) r
+	LEFT JOIN messages p ON p.id = r.reply_to AND p.chat_id = :chat_id AND p.deleted_at IS NULL
+	WHERE r.reply_to IS NULL OR p.id IS NOT NULL
+	RETURNING id, sending_time, thread_root_id`
+	// deleted messages are returned as tombstones with empty text
 	GetLastMessagesQuery = `
-	SELECT chat_id, sender_id, text, sending_time FROM messages
-	WHERE chat_id = $1 ORDER BY sending_time DESC LIMIT $2`
+	SELECT id, chat_id, sender_id, text, sending_time, edited_at, deleted_at, reply_to, thread_root_id,
+	announcement, pinned_at
+	FROM messages WHERE chat_id = $1 ORDER BY sending_time DESC LIMIT $2`
 	GetLastMessagesAfterQuery = `
-	SELECT chat_id, sender_id, text, sending_time FROM messages
-	WHERE chat_id = $1 AND sending_time >= (
+	SELECT id, chat_id, sender_id, text, sending_time, edited_at, deleted_at, reply_to, thread_root_id,
+	announcement, pinned_at
+	FROM messages WHERE chat_id = $1 AND sending_time >= (
 		SELECT sending_time FROM messages WHERE id = $2
 	) ORDER BY sending_time DESC LIMIT $3`
+	GetMessageQuery = `
+	SELECT id, chat_id, sender_id, text, sending_time, edited_at, deleted_at, reply_to, thread_root_id,
+	announcement, pinned_at
+	FROM messages WHERE id = $1`
+	GetThreadRepliesQuery = `
+	SELECT id, chat_id, sender_id, text, sending_time, edited_at, deleted_at, reply_to, thread_root_id,
+	announcement, pinned_at
+	FROM messages WHERE thread_root_id = $1 AND id > $2 ORDER BY id LIMIT $3`
+	GetQuotedMessagesQuery = `
+	SELECT id, sender_id, LEFT(text, 100) AS text, deleted_at IS NOT NULL AS deleted
+	FROM messages WHERE id = ANY($1)`
+	// age is calculated by DB because sending time is stored in DB time zone
+	GetManagedMessageQuery = `
+	SELECT m.id, m.chat_id, m.sender_id, m.text, m.sending_time, m.edited_at, m.deleted_at,
+	m.reply_to, m.thread_root_id, m.announcement, m.pinned_at,
+	COALESCE(mt.admin_id, 0) AS meeting_admin_id,
+	EXTRACT(EPOCH FROM LOCALTIMESTAMP - m.sending_time)::BIGINT AS age_seconds,
+	c.status = 'archived' AS chat_archived
+	FROM messages m
+	JOIN chats c ON c.id = m.chat_id
+	LEFT JOIN meetings mt ON mt.id = c.meeting_id AND c.type = 'meeting'
+	WHERE m.id = $1`
+	AddMessageEditQuery = `
+	INSERT INTO messages_edits(message_id, text)
+	SELECT id, text FROM messages WHERE id = $1 AND deleted_at IS NULL`
+	EditMessageQuery = `
+	UPDATE messages SET text = $2, edited_at = LOCALTIMESTAMP WHERE id = $1 AND deleted_at IS NULL
+	RETURNING id, chat_id, sender_id, text, sending_time, edited_at, deleted_at, reply_to, thread_root_id,
+	announcement, pinned_at`
+	// edits history of deleted message is kept, but it is not returned by GetMessageEditsQuery
+	DeleteMessageQuery = `
+	UPDATE messages SET text = '', deleted_at = LOCALTIMESTAMP, pinned_at = NULL
+	WHERE id = $1 AND deleted_at IS NULL
+	RETURNING id, chat_id, sender_id, text, sending_time, edited_at, deleted_at, reply_to, thread_root_id,
+	announcement, pinned_at`
+	// message keeps its pinning time when it is pinned again
+	SetMessagePinnedQuery = `
+	UPDATE messages SET pinned_at = CASE WHEN $2 THEN COALESCE(pinned_at, LOCALTIMESTAMP) END
+	WHERE id = $1 AND deleted_at IS NULL
+	RETURNING id, chat_id, sender_id, text, sending_time, edited_at, deleted_at, reply_to, thread_root_id,
+	announcement, pinned_at`
+	GetPinnedMessagesQuery = `
+	SELECT id, chat_id, sender_id, text, sending_time, edited_at, deleted_at, reply_to, thread_root_id,
+	announcement, pinned_at
+	FROM messages WHERE chat_id = $1 AND pinned_at IS NOT NULL ORDER BY pinned_at DESC, id DESC`
+	// sender of announcement is not notified about it
+	AddAnnouncementNotificationsQuery = `
+	INSERT INTO notifications(user_id, message_id)
+	SELECT user_id, $2 FROM chats_members WHERE chat_id = $1 AND user_id != $3`
+	// notifications about deleted announcements are not shown
+	GetNotificationsQuery = `
+	SELECT n.id, n.message_id, m.chat_id, m.sender_id, m.text, m.sending_time
+	FROM notifications n JOIN messages m ON m.id = n.message_id
+	WHERE n.user_id = $1 AND m.deleted_at IS NULL
+	ORDER BY n.id DESC LIMIT $2`
+	GetMessageEditsQuery = `
+	SELECT e.message_id, e.text, e.edited_at FROM messages_edits e
+	JOIN messages m ON m.id = e.message_id AND m.deleted_at IS NULL
+	WHERE e.message_id = $1 ORDER BY e.edited_at, e.id`
+	// reactions are ordered by the first time emoji was used on message
+	GetMessagesReactionsQuery = `
+	SELECT message_id, emoji, COUNT(*) AS count, BOOL_OR(user_id = $2) AS reacted_by_me
+	FROM messages_reactions WHERE message_id = ANY($1)
+	GROUP BY message_id, emoji ORDER BY message_id, MIN(id)`
+	GetReactionMessageChatQuery = `
+	SELECT m.chat_id, c.status = 'archived' AS chat_archived FROM messages m
+	JOIN chats c ON c.id = m.chat_id
+	WHERE m.id = $1 AND m.deleted_at IS NULL
+	FOR SHARE OF c`
+	AddReactionQuery = `
+	INSERT INTO messages_reactions(message_id, user_id, emoji) VALUES($1, $2, $3)
+	ON CONFLICT (message_id, user_id, emoji) DO NOTHING`
+	RemoveReactionQuery = `DELETE FROM messages_reactions WHERE message_id = $1 AND user_id = $2 AND emoji = $3`
+	CountReactionsQuery = `SELECT COUNT(*) FROM messages_reactions WHERE message_id = $1 AND emoji = $2`
+	// only not sent attachments of sender can be linked to message
+	LinkAttachmentsQuery = `
+	UPDATE attachments SET message_id = $1
+	WHERE id = ANY($2) AND uploader_id = $3 AND message_id IS NULL
+	RETURNING id, uploader_id, message_id, file_name, mime_type, size, blob_id, has_thumbnail, created_at`
+	GetMessagesAttachmentsQuery = `
+	SELECT id, uploader_id, message_id, file_name, mime_type, size, blob_id, has_thumbnail, created_at
+	FROM attachments WHERE message_id = ANY($1) ORDER BY id`
+	// text expression must match the expression of messages_text_search_idx index,
+	// deleted messages have empty text, so they are never found
+	SearchMessagesQuery = `
+	SELECT m.id AS message_id, m.chat_id, m.sender_id, m.sending_time,
+	ts_headline('russian', m.text, q, '` + searchHeadlineOptions + `') AS context
+	FROM messages m
+	JOIN chats_members cm ON cm.chat_id = m.chat_id AND cm.user_id = $1
+	CROSS JOIN websearch_to_tsquery('russian', $2) q
+	WHERE to_tsvector('russian', m.text) @@ q AND ($3 = 0 OR m.chat_id = $3) AND ($4 = 0 OR m.id < $4)
+	ORDER BY m.id DESC LIMIT $5`
+	// markers are not HTML, so text of message is never rendered as markup
+	searchHeadlineOptions = "MaxFragments=2, MaxWords=20, MinWords=5, " +
+		"StartSel=" + models.SearchHighlightStart + ", StopSel=" + models.SearchHighlightStop
 
+	messageNotFound            = `sql: no rows in result set`
+	chatNotFound               = `sql: no rows in result set`
+	archivedChatStatus         = "archived"
+	directChatType             = "direct"
 	chatIdNotFoundErrorMessage = `pq: insert or update on table "messages" violates foreign key constraint "messages_chat_id_fkey"`
 	userIdNotFoundErrorMessage = `pq: insert or update on table "messages" violates foreign key constraint "messages_sender_id_fkey"`
+	reactionUserIdNotFound     = `pq: insert or update on table "messages_reactions" violates foreign key constraint "messages_reactions_user_id_fkey"`
 )
 
+type messageChat struct {
+	Status            string `db:"status"`
+	Type              string `db:"type"`
+	MeetingAdminId    uint   `db:"meeting_admin_id"`
+	DirectRecipientId uint   `db:"direct_recipient_id"`
+}
+
+type reactionMessageChat struct {
+	ChatId       uint `db:"chat_id"`
+	ChatArchived bool `db:"chat_archived"`
+}
+
+type messageReaction struct {
+	MessageId uint `db:"message_id"`
+	models.Reaction
+}
+
 type Repository struct {
 	db *sqlx.DB
 }
@@ -29,30 +170,427 @@ func New(db *sqlx.DB) Repository {
 	return Repository{db}
 }
 
-func (r Repository) Save(message models.Message) error {
-	_, err := r.db.NamedExec(SaveMessageQuery, message)
+func (r Repository) Save(ctx context.Context, message models.Message) (models.Message, error) {
+	err := r.saveMessage(ctx, &message)
 	switch {
 	case err == nil:
-		return nil
+		return message, nil
 	case err.Error() == chatIdNotFoundErrorMessage:
-		return internal_errors.UnableToFindChatById
+		return models.Message{}, internal_errors.UnableToFindChatById
 	case err.Error() == userIdNotFoundErrorMessage:
-		return internal_errors.UnableToFindUserById
+		return models.Message{}, internal_errors.UnableToFindUserById
+	default:
+		return models.Message{}, err
+	}
+}
+
+// message is saved with its attachments and notifications in one transaction,
+// archived chat does not accept messages
+func (r Repository) saveMessage(ctx context.Context, message *models.Message) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = checkChat(ctx, tx, message)
+	if err == nil {
+		err = insertMessage(ctx, tx, message)
+	}
+	if err == nil && len(message.AttachmentIds) != 0 {
+		err = linkAttachments(ctx, tx, message)
+	}
+	if err == nil && message.Announcement {
+		_, err = tx.ExecContext(ctx, AddAnnouncementNotificationsQuery, message.ChatId, message.Id, message.SenderId)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// announcement can be sent only by admin to meeting chat,
+// direct message is checked here as well because frame may refer to direct chat by its id
+func checkChat(ctx context.Context, tx *sqlx.Tx, message *models.Message) error {
+	var chat messageChat
+	err := tx.GetContext(ctx, &chat, GetChatStatusQuery, message.ChatId, message.SenderId)
+	switch {
+	case err != nil && err.Error() == chatNotFound:
+		return internal_errors.UnableToFindChatById
+	case err != nil:
+		return err
+	case chat.Status == archivedChatStatus:
+		return internal_errors.ChatIsArchived
+	case message.Announcement && (chat.MeetingAdminId == 0 || chat.MeetingAdminId != message.SenderId):
+		return internal_errors.AnnouncementNotAllowed
+	case chat.Type == directChatType:
+		return checkDirectMessageAllowed(ctx, tx, message.SenderId, chat.DirectRecipientId)
+	default:
+		return nil
+	}
+}
+
+// other users do not see direct chat, so it is not found for them
+func checkDirectMessageAllowed(ctx context.Context, tx *sqlx.Tx, senderId, recipientId uint) error {
+	if recipientId == 0 {
+		return internal_errors.UnableToFindChatById
+	}
+
+	var allowed bool
+	err := tx.GetContext(ctx, &allowed, users_privacy.DirectMessagesAllowedQuery, senderId, recipientId)
+	switch {
+	case err != nil:
+		return err
+	case !allowed:
+		return internal_errors.DirectMessagesNotAllowed
 	default:
+		return nil
+	}
+}
+
+// fills id, sending time and thread root id generated by DB
+func insertMessage(ctx context.Context, tx *sqlx.Tx, message *models.Message) error {
+	rows, err := sqlx.NamedQueryContext(ctx, tx, SaveMessageQuery, message)
+	if err != nil {
 		return err
 	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		return internal_errors.UnableToFindReplyMessage
+	}
+	err = rows.Scan(&message.Id, &message.SendingTime, &message.ThreadRootId)
+	if err != nil {
+		return err
+	}
+
+	// rows must be closed before next query of transaction
+	return rows.Close()
+}
+
+func linkAttachments(ctx context.Context, tx *sqlx.Tx, message *models.Message) error {
+	uniqueIds := make(map[uint]bool)
+	var ids []int64
+	for _, id := range message.AttachmentIds {
+		if !uniqueIds[id] {
+			uniqueIds[id] = true
+			ids = append(ids, int64(id))
+		}
+	}
+
+	err := tx.SelectContext(
+		ctx, &message.Attachments, LinkAttachmentsQuery, message.Id, pq.Array(ids), message.SenderId)
+	if err != nil {
+		return err
+	}
+	if len(message.Attachments) != len(ids) {
+		return internal_errors.UnableToFindAttachment
+	}
+
+	sort.Slice(message.Attachments, func(i, j int) bool {
+		return message.Attachments[i].Id < message.Attachments[j].Id
+	})
+	return nil
 }
 
-func (r Repository) GetLastMessages(chatId, count uint) ([]models.Message, error) {
+func (r Repository) GetLastMessages(ctx context.Context, chatId, count, viewerId uint) ([]models.Message, error) {
 	var messages []models.Message
-	err := r.db.Select(&messages, GetLastMessagesQuery, chatId, count)
+	err := r.db.SelectContext(ctx, &messages, GetLastMessagesQuery, chatId, count)
+	if err != nil {
+		return nil, err
+	}
 
-	return messages, err
+	return messages, r.attachDetails(ctx, messages, viewerId)
 }
 
-func (r Repository) GetLastMessagesAfter(chatId, messageId, count uint) ([]models.Message, error) {
+func (r Repository) GetLastMessagesAfter(
+	ctx context.Context, chatId, messageId, count, viewerId uint,
+) ([]models.Message, error) {
 	var messages []models.Message
-	err := r.db.Select(&messages, GetLastMessagesAfterQuery, chatId, messageId, count)
+	err := r.db.SelectContext(ctx, &messages, GetLastMessagesAfterQuery, chatId, messageId, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, r.attachDetails(ctx, messages, viewerId)
+}
+
+func (r Repository) GetThread(ctx context.Context, rootId, afterId, count, viewerId uint) (models.Thread, error) {
+	var thread models.Thread
+	err := r.db.GetContext(ctx, &thread.Root, GetMessageQuery, rootId)
+	if err != nil {
+		if err.Error() == messageNotFound {
+			err = internal_errors.UnableToFindMessageById
+		}
+		return models.Thread{}, err
+	}
+
+	err = r.db.SelectContext(ctx, &thread.Replies, GetThreadRepliesQuery, rootId, afterId, count)
+	if err != nil {
+		return models.Thread{}, err
+	}
+
+	messages := append([]models.Message{thread.Root}, thread.Replies...)
+	err = r.attachDetails(ctx, messages, viewerId)
+	if err != nil {
+		return models.Thread{}, err
+	}
+
+	thread.Root, thread.Replies = messages[0], messages[1:]
+	return thread, nil
+}
+
+// attaches aggregated reactions and summaries of replied messages
+func (r Repository) attachDetails(ctx context.Context, messages []models.Message, viewerId uint) error {
+	err := r.attachReactions(ctx, messages, viewerId)
+	if err != nil {
+		return err
+	}
+
+	err = r.attachParents(ctx, messages)
+	if err != nil {
+		return err
+	}
+
+	return r.attachAttachments(ctx, messages)
+}
+
+func (r Repository) attachReactions(ctx context.Context, messages []models.Message, viewerId uint) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(messages))
+	for i, message := range messages {
+		ids[i] = int64(message.Id)
+	}
+
+	var reactions []messageReaction
+	err := r.db.SelectContext(ctx, &reactions, GetMessagesReactionsQuery, pq.Array(ids), viewerId)
+	if err != nil {
+		return err
+	}
+
+	id2Reactions := make(map[uint][]models.Reaction)
+	for _, reaction := range reactions {
+		id2Reactions[reaction.MessageId] = append(id2Reactions[reaction.MessageId], reaction.Reaction)
+	}
+	for i := range messages {
+		messages[i].Reactions = id2Reactions[messages[i].Id]
+	}
+
+	return nil
+}
+
+func (r Repository) GetManagedMessage(ctx context.Context, messageId uint) (models.ManagedMessage, error) {
+	var message models.ManagedMessage
+	err := r.db.GetContext(ctx, &message, GetManagedMessageQuery, messageId)
+	if err != nil && err.Error() == messageNotFound {
+		err = internal_errors.UnableToFindMessageById
+	}
+
+	return message, err
+}
+
+// previous text is saved to edits history
+func (r Repository) EditMessage(ctx context.Context, messageId uint, text string) (models.Message, error) {
+	return r.changeMessage(ctx, AddMessageEditQuery, EditMessageQuery, messageId, text)
+}
+
+// text of deleted message is removed, its edits history is hidden
+func (r Repository) DeleteMessage(ctx context.Context, messageId uint) (models.Message, error) {
+	var message models.Message
+	err := r.db.GetContext(ctx, &message, DeleteMessageQuery, messageId)
+	if err != nil && err.Error() == messageNotFound {
+		err = internal_errors.UnableToFindMessageById
+	}
+
+	return message, err
+}
+
+func (r Repository) changeMessage(
+	ctx context.Context, historyQuery, changeQuery string, messageId uint, args ...interface{},
+) (models.Message, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, historyQuery, messageId)
+	if err != nil {
+		_ = tx.Rollback()
+		return models.Message{}, err
+	}
+
+	var message models.Message
+	err = tx.GetContext(ctx, &message, changeQuery, append([]interface{}{messageId}, args...)...)
+	if err != nil {
+		_ = tx.Rollback()
+		if err.Error() == messageNotFound {
+			err = internal_errors.UnableToFindMessageById
+		}
+		return models.Message{}, err
+	}
+
+	return message, tx.Commit()
+}
+
+func (r Repository) SetMessagePinned(ctx context.Context, messageId uint, pinned bool) (models.Message, error) {
+	var message models.Message
+	err := r.db.GetContext(ctx, &message, SetMessagePinnedQuery, messageId, pinned)
+	if err != nil && err.Error() == messageNotFound {
+		err = internal_errors.UnableToFindMessageById
+	}
+
+	return message, err
+}
+
+// the last pinned message is the first
+func (r Repository) GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error) {
+	var messages []models.Message
+	err := r.db.SelectContext(ctx, &messages, GetPinnedMessagesQuery, chatId)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, r.attachDetails(ctx, messages, 0)
+}
+
+func (r Repository) GetMessageEdits(ctx context.Context, messageId uint) ([]models.MessageEdit, error) {
+	var edits []models.MessageEdit
+	err := r.db.SelectContext(ctx, &edits, GetMessageEditsQuery, messageId)
+
+	return edits, err
+}
+
+func (r Repository) attachParents(ctx context.Context, messages []models.Message) error {
+	var ids []int64
+	for _, message := range messages {
+		if message.ReplyTo != nil {
+			ids = append(ids, int64(*message.ReplyTo))
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var parents []models.QuotedMessage
+	err := r.db.SelectContext(ctx, &parents, GetQuotedMessagesQuery, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+
+	id2Parent := make(map[uint]models.QuotedMessage)
+	for _, parent := range parents {
+		id2Parent[parent.Id] = parent
+	}
+	for i := range messages {
+		if messages[i].ReplyTo == nil {
+			continue
+		}
+		if parent, found := id2Parent[*messages[i].ReplyTo]; found {
+			messages[i].Parent = &parent
+		}
+	}
+
+	return nil
+}
+
+// attachments of deleted messages are not returned
+func (r Repository) attachAttachments(ctx context.Context, messages []models.Message) error {
+	var ids []int64
+	for _, message := range messages {
+		if message.DeletedAt == nil {
+			ids = append(ids, int64(message.Id))
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var attachments []models.Attachment
+	err := r.db.SelectContext(ctx, &attachments, GetMessagesAttachmentsQuery, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+
+	id2Attachments := make(map[uint][]models.Attachment)
+	for _, attachment := range attachments {
+		id2Attachments[*attachment.MessageId] = append(id2Attachments[*attachment.MessageId], attachment)
+	}
+	for i := range messages {
+		messages[i].Attachments = id2Attachments[messages[i].Id]
+	}
+
+	return nil
+}
+
+// reactions to deleted messages and messages of archived chats are not allowed
+func (r Repository) AddReaction(ctx context.Context, reaction models.MessageReaction) (models.ReactionChange, error) {
+	return r.changeReaction(ctx, AddReactionQuery, reaction)
+}
+
+func (r Repository) RemoveReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	return r.changeReaction(ctx, RemoveReactionQuery, reaction)
+}
+
+func (r Repository) changeReaction(
+	ctx context.Context, query string, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.ReactionChange{}, err
+	}
+
+	var chat reactionMessageChat
+	err = tx.GetContext(ctx, &chat, GetReactionMessageChatQuery, reaction.MessageId)
+	if err == nil && chat.ChatArchived {
+		err = internal_errors.ChatIsArchived
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		if err.Error() == messageNotFound {
+			err = internal_errors.UnableToFindMessageById
+		}
+		return models.ReactionChange{}, err
+	}
+
+	change := models.ReactionChange{MessageReaction: reaction, ChatId: chat.ChatId}
+	_, err = tx.ExecContext(ctx, query, reaction.MessageId, reaction.UserId, reaction.Emoji)
+	if err != nil {
+		_ = tx.Rollback()
+		if err.Error() == reactionUserIdNotFound {
+			err = internal_errors.UnableToFindUserById
+		}
+		return models.ReactionChange{}, err
+	}
+
+	err = tx.GetContext(ctx, &change.Count, CountReactionsQuery, reaction.MessageId, reaction.Emoji)
+	if err != nil {
+		_ = tx.Rollback()
+		return models.ReactionChange{}, err
+	}
+
+	return change, tx.Commit()
+}
+
+func (r Repository) SearchMessages(ctx context.Context, query models.SearchQuery) ([]models.SearchHit, error) {
+	var hits []models.SearchHit
+	err := r.db.SelectContext(
+		ctx, &hits, SearchMessagesQuery, query.UserId, query.Text, query.ChatId, query.BeforeId, query.Count)
+
+	return hits, err
+}
+
+func (r Repository) GetNotifications(ctx context.Context, userId, count uint) ([]models.Notification, error) {
+	var notifications []models.Notification
+	err := r.db.SelectContext(ctx, &notifications, GetNotificationsQuery, userId, count)
 
-	return messages, err
+	return notifications, err
 }
@@ -1,12 +1,15 @@
 package messages
 
 import (
+	"context"
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	"internal_errors"
 	mock "mock/repositories"
+	"models"
 	"os"
 	"plugins/config"
+	"strings"
 	"testing"
 	"utils"
 )
@@ -41,16 +44,17 @@ func TestRepository_SaveSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.Save(mock.GetAllMessages()[0])
+	message, err := repository.Save(context.Background(), mock.GetAllMessages()[0])
 
 	utils.AssertNil(err, t)
+	utils.AssertEqual(uint(len(mock.ChatsMessages)+1), message.Id, t)
 }
 
 func TestRepository_SaveChatNotFound(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.Save(mock.GetMessageWithNotExistsChatId())
+	_, err := repository.Save(context.Background(), mock.GetMessageWithNotExistsChatId())
 
 	utils.AssertErrorsEqual(internal_errors.UnableToFindChatById, err, t)
 }
@@ -59,15 +63,53 @@ func TestRepository_SaveUserIdNotFound(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.Save(mock.GetMessageWithNotExistsUserId())
+	_, err := repository.Save(context.Background(), mock.GetMessageWithNotExistsUserId())
 
 	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
 }
 
+func TestRepository_SaveDirectMessageSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	senderId, _ := mock.GetFirstDirectChatUsers()
+	message := models.Message{ChatId: mock.FirstDirectChatId, SenderId: senderId, Text: "hello"}
+	_, err := repository.Save(context.Background(), message)
+
+	utils.AssertNil(err, t)
+}
+
+func TestRepository_SaveDirectMessageOfBlockedUser(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	senderId, recipientId := mock.GetFirstDirectChatUsers()
+	_, err := db.NamedExec(mock.CreateUserBlockQuery, map[string]interface{}{
+		"user_id": recipientId, "blocked_user_id": senderId,
+	})
+	utils.AssertNil(err, t)
+
+	// frame refers to known direct chat by id, so direct chat is not looked up by users
+	message := models.Message{ChatId: mock.FirstDirectChatId, SenderId: senderId, Text: "hello"}
+	_, err = repository.Save(context.Background(), message)
+
+	utils.AssertErrorsEqual(internal_errors.DirectMessagesNotAllowed, err, t)
+}
+
+func TestRepository_SaveDirectMessageOfOtherUser(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	message := models.Message{ChatId: mock.FirstDirectChatId, SenderId: mock.UserIdWithoutDirectChats, Text: "hello"}
+	_, err := repository.Save(context.Background(), message)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindChatById, err, t)
+}
+
 func TestRepository_SaveSomeError(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.Save(mock.GetAllMessages()[0])
+	_, err := repository.Save(context.Background(), mock.GetAllMessages()[0])
 
 	utils.AssertNotNil(err, t)
 }
@@ -77,7 +119,7 @@ func TestRepository_GetLastSuccess(t *testing.T) {
 	defer mock.DropTables(db)
 
 	messagesCount := 2
-	messages, err := repository.GetLastMessages(1, uint(messagesCount))
+	messages, err := repository.GetLastMessages(context.Background(), 1, uint(messagesCount), 1)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(len(messages) <= messagesCount, t)
@@ -94,7 +136,7 @@ func TestRepository_GetLastSuccess(t *testing.T) {
 func TestRepository_GetLastSomeError(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetLastMessages(1, 1)
+	_, err := repository.GetLastMessages(context.Background(), 1, 1, 1)
 
 	utils.AssertNotNil(err, t)
 }
@@ -104,7 +146,7 @@ func TestRepository_GetLastMessagesAfter(t *testing.T) {
 	defer mock.DropTables(db)
 
 	messagesCount := 10
-	messages, err := repository.GetLastMessagesAfter(1, 1, uint(messagesCount))
+	messages, err := repository.GetLastMessagesAfter(context.Background(), 1, 1, uint(messagesCount), 1)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(len(messages) <= messagesCount, t)
@@ -112,3 +154,447 @@ func TestRepository_GetLastMessagesAfter(t *testing.T) {
 		utils.AssertTrue(message.SendingTime.After(mock.GetFirstMessageSendingTime()), t)
 	}
 }
+
+func TestRepository_GetManagedMessageSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	expectedMessage := mock.GetAllMessages()[0]
+	message, err := repository.GetManagedMessage(context.Background(), expectedMessage.Id)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(expectedMessage.Text, message.Text, t)
+	utils.AssertEqual(mock.GetChatMeetingAdminId(expectedMessage.ChatId), message.MeetingAdminId, t)
+	utils.AssertTrue(message.AgeSeconds > 0, t)
+}
+
+func TestRepository_GetManagedMessageNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.GetManagedMessage(context.Background(), mock.GetNotExistsMessageId())
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindMessageById, err, t)
+}
+
+func TestRepository_EditMessageSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	originalMessage := mock.GetAllMessages()[0]
+	message, err := repository.EditMessage(context.Background(), originalMessage.Id, "edited")
+	edits, _ := repository.GetMessageEdits(context.Background(), originalMessage.Id)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual("edited", message.Text, t)
+	utils.AssertNotNil(message.EditedAt, t)
+	utils.AssertEqual(1, len(edits), t)
+	utils.AssertEqual(originalMessage.Text, edits[0].Text, t)
+}
+
+func TestRepository_EditMessageNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.EditMessage(context.Background(), mock.GetNotExistsMessageId(), "edited")
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindMessageById, err, t)
+}
+
+func TestRepository_DeleteMessageSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	originalMessage := mock.GetAllMessages()[0]
+	_, _ = repository.EditMessage(context.Background(), originalMessage.Id, "edited")
+	message, err := repository.DeleteMessage(context.Background(), originalMessage.Id)
+	edits, _ := repository.GetMessageEdits(context.Background(), originalMessage.Id)
+	messages, _ := repository.GetLastMessages(
+		context.Background(), originalMessage.ChatId, uint(len(mock.ChatsMessages)), originalMessage.SenderId)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual("", message.Text, t)
+	utils.AssertNotNil(message.DeletedAt, t)
+	utils.AssertEqual(0, len(edits), t)
+
+	var tombstoneFound bool
+	for _, chatMessage := range messages {
+		if chatMessage.Id == originalMessage.Id {
+			tombstoneFound = chatMessage.DeletedAt != nil && chatMessage.Text == ""
+		}
+	}
+	utils.AssertTrue(tombstoneFound, t)
+}
+
+func TestRepository_DeleteMessageTwice(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	messageId := mock.GetAllMessages()[0].Id
+	_, _ = repository.DeleteMessage(context.Background(), messageId)
+	_, err := repository.DeleteMessage(context.Background(), messageId)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindMessageById, err, t)
+}
+
+func TestRepository_GetMessageEditsOfDeletedMessage(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	messageId := mock.GetAllMessages()[0].Id
+	_, _ = repository.EditMessage(context.Background(), messageId, "edited")
+	editsBeforeDeletion, _ := repository.GetMessageEdits(context.Background(), messageId)
+	_, _ = repository.DeleteMessage(context.Background(), messageId)
+	edits, err := repository.GetMessageEdits(context.Background(), messageId)
+
+	utils.AssertEqual(1, len(editsBeforeDeletion), t)
+	utils.AssertNil(err, t)
+	utils.AssertEqual(0, len(edits), t)
+}
+
+func TestRepository_GetMessageEditsSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	_, err := repository.GetMessageEdits(context.Background(), 1)
+
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_AddReactionSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	message := mock.GetAllMessages()[0]
+	reaction := models.MessageReaction{MessageId: message.Id, UserId: 1, Emoji: "👍"}
+	_, _ = repository.AddReaction(
+		context.Background(), models.MessageReaction{MessageId: message.Id, UserId: 2, Emoji: "👍"})
+	change, err := repository.AddReaction(context.Background(), reaction)
+	messages, _ := repository.GetLastMessages(context.Background(), message.ChatId, uint(len(mock.ChatsMessages)), 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(message.ChatId, change.ChatId, t)
+	utils.AssertEqual(uint(2), change.Count, t)
+
+	for _, chatMessage := range messages {
+		if chatMessage.Id == message.Id {
+			utils.AssertEqual(1, len(chatMessage.Reactions), t)
+			utils.AssertEqual(models.Reaction{Emoji: "👍", Count: 2, ReactedByMe: true}, chatMessage.Reactions[0], t)
+		} else {
+			utils.AssertEqual(0, len(chatMessage.Reactions), t)
+		}
+	}
+}
+
+func TestRepository_AddReactionTwice(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	reaction := models.MessageReaction{MessageId: mock.GetAllMessages()[0].Id, UserId: 1, Emoji: "👍"}
+	_, _ = repository.AddReaction(context.Background(), reaction)
+	change, err := repository.AddReaction(context.Background(), reaction)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(uint(1), change.Count, t)
+}
+
+func TestRepository_AddReactionToDeletedMessage(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	messageId := mock.GetAllMessages()[0].Id
+	_, _ = repository.DeleteMessage(context.Background(), messageId)
+	_, err := repository.AddReaction(
+		context.Background(), models.MessageReaction{MessageId: messageId, UserId: 1, Emoji: "👍"})
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindMessageById, err, t)
+}
+
+func TestRepository_AddReactionNotExistsUser(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.AddReaction(context.Background(), models.MessageReaction{
+		MessageId: mock.GetAllMessages()[0].Id, UserId: mock.GetNextUserId(), Emoji: "👍",
+	})
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
+}
+
+func TestRepository_RemoveReactionSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	reaction := models.MessageReaction{MessageId: mock.GetAllMessages()[0].Id, UserId: 1, Emoji: "👍"}
+	_, _ = repository.AddReaction(context.Background(), reaction)
+	change, err := repository.RemoveReaction(context.Background(), reaction)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(uint(0), change.Count, t)
+}
+
+func TestRepository_RemoveReactionNotExistsMessage(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.RemoveReaction(context.Background(), models.MessageReaction{
+		MessageId: mock.GetNotExistsMessageId(), UserId: 1, Emoji: "👍",
+	})
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindMessageById, err, t)
+}
+
+func TestRepository_SaveReplySuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	replyMessage := mock.GetReplyMessage()
+	reply, err := repository.Save(context.Background(), replyMessage)
+	messages, _ := repository.GetLastMessages(context.Background(), replyMessage.ChatId, 1, 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertNotNil(reply.ThreadRootId, t)
+	utils.AssertEqual(*replyMessage.ReplyTo, *reply.ThreadRootId, t)
+	utils.AssertEqual(reply.Id, messages[0].Id, t)
+	utils.AssertNotNil(messages[0].Parent, t)
+	utils.AssertEqual(*replyMessage.ReplyTo, messages[0].Parent.Id, t)
+	utils.AssertFalse(messages[0].Parent.Deleted, t)
+}
+
+func TestRepository_SaveReplyToMessageFromAnotherChat(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.Save(context.Background(), mock.GetReplyToMessageFromAnotherChat())
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindReplyMessage, err, t)
+}
+
+func TestRepository_GetThread(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	replyMessage := mock.GetReplyMessage()
+	reply, _ := repository.Save(context.Background(), replyMessage)
+	replyMessage.ReplyTo = &reply.Id
+	replyToReply, _ := repository.Save(context.Background(), replyMessage)
+
+	thread, err := repository.GetThread(context.Background(), *reply.ThreadRootId, 0, 10, 1)
+	utils.AssertNil(err, t)
+	utils.AssertEqual(*reply.ThreadRootId, thread.Root.Id, t)
+	utils.AssertEqual(2, len(thread.Replies), t)
+	utils.AssertEqual(*reply.ThreadRootId, *replyToReply.ThreadRootId, t)
+
+	thread, err = repository.GetThread(context.Background(), *reply.ThreadRootId, reply.Id, 10, 1)
+	utils.AssertNil(err, t)
+	utils.AssertEqual(1, len(thread.Replies), t)
+	utils.AssertEqual(replyToReply.Id, thread.Replies[0].Id, t)
+}
+
+func TestRepository_GetThreadNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.GetThread(context.Background(), mock.GetNotExistsMessageId(), 0, 10, 1)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindMessageById, err, t)
+}
+
+func TestRepository_SaveWithAttachments(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	message := mock.GetAllMessages()[0]
+	var attachmentId uint
+	_ = db.Get(&attachmentId, `
+	INSERT INTO attachments(uploader_id, file_name, mime_type, size, blob_id)
+	VALUES($1, 'notes.txt', 'text/plain', 5, 'blob') RETURNING id`, message.SenderId)
+
+	message.AttachmentIds = []uint{attachmentId}
+	savedMessage, err := repository.Save(context.Background(), message)
+	messages, _ := repository.GetLastMessages(context.Background(), message.ChatId, 1, message.SenderId)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(1, len(savedMessage.Attachments), t)
+	utils.AssertEqual(savedMessage.Id, *savedMessage.Attachments[0].MessageId, t)
+	utils.AssertEqual(1, len(messages[0].Attachments), t)
+
+	// attachment can not be sent twice
+	_, err = repository.Save(context.Background(), message)
+	utils.AssertErrorsEqual(internal_errors.UnableToFindAttachment, err, t)
+}
+
+func TestRepository_SearchMessages(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	query := mock.GetFirstUserSearchQuery()
+	hits, err := repository.SearchMessages(context.Background(), query)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(1, len(hits), t)
+	utils.AssertEqual(mock.GetAllMessages()[0].Id, hits[0].MessageId, t)
+	highlighted := models.SearchHighlightStart + "world" + models.SearchHighlightStop
+	utils.AssertTrue(strings.Contains(hits[0].Context, highlighted), t)
+}
+
+func TestRepository_SearchMessagesWordForms(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	query := mock.GetFirstUserSearchQuery()
+	query.Text = "worlds"
+	hits, err := repository.SearchMessages(context.Background(), query)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(len(hits) > 0, t)
+}
+
+func TestRepository_SearchMessagesBeforeId(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	query := mock.GetFirstUserSearchQuery()
+	query.BeforeId = mock.GetAllMessages()[0].Id
+	hits, err := repository.SearchMessages(context.Background(), query)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(0, len(hits), t)
+}
+
+func TestRepository_SearchMessagesNotChatMember(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	query := mock.GetFirstUserSearchQuery()
+	query.UserId = mock.UserIdNotInFirstChat
+	hits, err := repository.SearchMessages(context.Background(), query)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(0, len(hits), t)
+}
+
+func TestRepository_SearchMessagesDeletedMessage(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	query := mock.GetFirstUserSearchQuery()
+	_, _ = repository.DeleteMessage(context.Background(), mock.GetAllMessages()[0].Id)
+	hits, err := repository.SearchMessages(context.Background(), query)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(0, len(hits), t)
+}
+
+func TestRepository_SearchMessagesSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	_, err := repository.SearchMessages(context.Background(), mock.GetFirstUserSearchQuery())
+
+	utils.AssertNotNil(err, t)
+}
+
+func archiveChat(chatId uint) {
+	_, _ = db.Exec(`UPDATE chats SET status = 'archived' WHERE id = $1`, chatId)
+}
+
+func TestRepository_SaveToArchivedChat(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	message := mock.GetAllMessages()[0]
+	archiveChat(message.ChatId)
+	_, err := repository.Save(context.Background(), message)
+
+	utils.AssertErrorsEqual(internal_errors.ChatIsArchived, err, t)
+}
+
+func TestRepository_AddReactionToArchivedChat(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	message := mock.GetAllMessages()[0]
+	archiveChat(message.ChatId)
+	_, err := repository.AddReaction(
+		context.Background(), models.MessageReaction{MessageId: message.Id, UserId: 1, Emoji: "👍"})
+
+	utils.AssertErrorsEqual(internal_errors.ChatIsArchived, err, t)
+}
+
+func TestRepository_GetManagedMessageOfArchivedChat(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	message := mock.GetAllMessages()[0]
+	archiveChat(message.ChatId)
+	managedMessage, err := repository.GetManagedMessage(context.Background(), message.Id)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(managedMessage.ChatArchived, t)
+}
+
+func TestRepository_SetMessagePinned(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	message := mock.GetAllMessages()[0]
+	pinnedMessage, err := repository.SetMessagePinned(context.Background(), message.Id, true)
+	pinnedMessages, _ := repository.GetPinnedMessages(context.Background(), message.ChatId)
+
+	utils.AssertNil(err, t)
+	utils.AssertNotNil(pinnedMessage.PinnedAt, t)
+	utils.AssertEqual(1, len(pinnedMessages), t)
+	utils.AssertEqual(message.Id, pinnedMessages[0].Id, t)
+
+	unpinnedMessage, err := repository.SetMessagePinned(context.Background(), message.Id, false)
+	pinnedMessages, _ = repository.GetPinnedMessages(context.Background(), message.ChatId)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(unpinnedMessage.PinnedAt == nil, t)
+	utils.AssertEqual(0, len(pinnedMessages), t)
+}
+
+func TestRepository_SetDeletedMessagePinned(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	messageId := mock.GetAllMessages()[0].Id
+	_, _ = repository.DeleteMessage(context.Background(), messageId)
+	_, err := repository.SetMessagePinned(context.Background(), messageId, true)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindMessageById, err, t)
+}
+
+func TestRepository_SaveAnnouncement(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	// chat of second meeting, its admin is the second user and the fourth user is a member
+	announcement := models.Message{ChatId: 3, SenderId: 2, Text: "announcement", Announcement: true}
+	message, err := repository.Save(context.Background(), announcement)
+	memberNotifications, _ := repository.GetNotifications(context.Background(), 4, 10)
+	senderNotifications, _ := repository.GetNotifications(context.Background(), announcement.SenderId, 10)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(message.Announcement, t)
+	utils.AssertEqual(1, len(memberNotifications), t)
+	utils.AssertEqual(message.Id, memberNotifications[0].MessageId, t)
+	utils.AssertEqual(0, len(senderNotifications), t)
+}
+
+func TestRepository_SaveAnnouncementNotByAdmin(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	_, err := repository.Save(
+		context.Background(), models.Message{ChatId: 3, SenderId: 4, Text: "announcement", Announcement: true})
+
+	utils.AssertErrorsEqual(internal_errors.AnnouncementNotAllowed, err, t)
+}
+
+func TestRepository_GetNotificationsSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	_, err := repository.GetNotifications(context.Background(), 1, 10)
+
+	utils.AssertNotNil(err, t)
+}
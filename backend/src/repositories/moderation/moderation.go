@@ -0,0 +1,27 @@
+package moderation
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"models"
+)
+
+const (
+	FlagContentQuery = `INSERT INTO moderation_flags(kind, source_id, text, reasons) VALUES($1, $2, $3, $4)`
+	// used only in tests, moderators read flags directly from DB
+	GetContentFlagsQuery = `SELECT kind, source_id, text, reasons FROM moderation_flags ORDER BY id`
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) Repository {
+	return Repository{db}
+}
+
+func (r Repository) FlagContent(ctx context.Context, flag models.ContentFlag) error {
+	_, err := r.db.ExecContext(ctx, FlagContentQuery, flag.Kind, flag.SourceId, flag.Text, pq.Array(flag.Reasons))
+	return err
+}
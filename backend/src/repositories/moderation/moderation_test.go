@@ -0,0 +1,80 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	mock "mock/repositories"
+	"models"
+	"os"
+	"plugins/config"
+	"testing"
+	"utils"
+)
+
+var (
+	db         *sqlx.DB
+	repository Repository
+)
+
+func init() {
+	utils.SkipInShortMode()
+
+	var err error
+	db, err = config.GetConfiguredConnection()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	repository = New(db)
+}
+
+func getContentFlags() []models.ContentFlag {
+	rows, err := db.Query(GetContentFlagsQuery)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+
+	var flags []models.ContentFlag
+	for rows.Next() {
+		var flag models.ContentFlag
+		if err := rows.Scan(&flag.Kind, &flag.SourceId, &flag.Text, pq.Array(&flag.Reasons)); err != nil {
+			panic(err)
+		}
+		flags = append(flags, flag)
+	}
+
+	return flags
+}
+
+// we need this function to avoiding DB errors due parallel queries
+func TestMain(t *testing.M) {
+	res := t.Run()
+	mock.DropTables(db)
+	os.Exit(res)
+}
+
+func TestRepository_FlagContentSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	flag := models.ContentFlag{Kind: "message", SourceId: 1, Text: "spam", Reasons: []string{"link-spam"}}
+	err := repository.FlagContent(context.Background(), flag)
+	flags := getContentFlags()
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(1, len(flags), t)
+	utils.AssertEqual(flag.Text, flags[0].Text, t)
+	utils.AssertEqual(1, len(flags[0].Reasons), t)
+}
+
+func TestRepository_FlagContentSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	err := repository.FlagContent(context.Background(), models.ContentFlag{Kind: "message", SourceId: 1, Text: "spam"})
+
+	utils.AssertNotNil(err, t)
+}
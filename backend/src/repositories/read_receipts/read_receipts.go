@@ -0,0 +1,56 @@
+package read_receipts
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+	"internal_errors"
+	"models"
+)
+
+const (
+	// read cursor never moves back, so receipts delivered out of order are harmless
+	MarkAsReadQuery = `
+	UPDATE chats_members cm
+	SET last_read_message_id = GREATEST(COALESCE(cm.last_read_message_id, 0), m.id)
+	FROM messages m
+	WHERE cm.chat_id = :chat_id AND cm.user_id = :user_id AND m.id = :message_id AND m.chat_id = cm.chat_id`
+	GetUnreadCountsQuery = `
+	SELECT cm.chat_id, COUNT(m.id) AS count FROM chats_members cm
+	JOIN chats c ON c.id = cm.chat_id
+	LEFT JOIN messages m ON m.chat_id = cm.chat_id
+		AND m.sender_id != cm.user_id AND m.id > COALESCE(cm.last_read_message_id, 0)
+	WHERE cm.user_id = $1 AND c.status != 'archived'
+	GROUP BY cm.chat_id ORDER BY cm.chat_id`
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) Repository {
+	return Repository{db}
+}
+
+func (r Repository) MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error {
+	res, err := r.db.NamedExecContext(ctx, MarkAsReadQuery, receipt)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return internal_errors.UnableToFindChatMessage
+	}
+
+	return nil
+}
+
+func (r Repository) GetUnreadCounts(ctx context.Context, userId uint) ([]models.ChatUnreadCount, error) {
+	var counts []models.ChatUnreadCount
+	err := r.db.SelectContext(ctx, &counts, GetUnreadCountsQuery, userId)
+
+	return counts, err
+}
@@ -0,0 +1,120 @@
+package read_receipts
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"internal_errors"
+	mock "mock/repositories"
+	"models"
+	"os"
+	"plugins/config"
+	"testing"
+	"utils"
+)
+
+var (
+	db         *sqlx.DB
+	repository Repository
+)
+
+func init() {
+	utils.SkipInShortMode()
+
+	var err error
+	db, err = config.GetConfiguredConnection()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	repository = New(db)
+}
+
+func getChatUnreadCount(counts []models.ChatUnreadCount, chatId uint) uint {
+	for _, count := range counts {
+		if count.ChatId == chatId {
+			return count.Count
+		}
+	}
+
+	return 0
+}
+
+// we need this function to avoiding DB errors due parallel queries
+func TestMain(t *testing.M) {
+	res := t.Run()
+	mock.DropTables(db)
+	os.Exit(res)
+}
+
+func TestRepository_MarkAsReadSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	receipt := mock.GetFirstChatReadReceipt()
+	err := repository.MarkAsRead(context.Background(), receipt)
+	counts, _ := repository.GetUnreadCounts(context.Background(), receipt.UserId)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(uint(0), getChatUnreadCount(counts, receipt.ChatId), t)
+}
+
+func TestRepository_MarkAsReadCursorNotMovedBack(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	receipt := mock.GetFirstChatReadReceipt()
+	_ = repository.MarkAsRead(context.Background(), receipt)
+	receipt.MessageId = mock.GetAllMessages()[0].Id
+	err := repository.MarkAsRead(context.Background(), receipt)
+	counts, _ := repository.GetUnreadCounts(context.Background(), receipt.UserId)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(uint(0), getChatUnreadCount(counts, receipt.ChatId), t)
+}
+
+func TestRepository_MarkAsReadMessageFromAnotherChat(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.MarkAsRead(context.Background(), mock.GetReadReceiptWithMessageFromAnotherChat())
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindChatMessage, err, t)
+}
+
+func TestRepository_MarkAsReadNotChatMember(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.MarkAsRead(context.Background(), mock.GetReadReceiptOfNotChatMember())
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindChatMessage, err, t)
+}
+
+func TestRepository_MarkAsReadSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	err := repository.MarkAsRead(context.Background(), mock.GetFirstChatReadReceipt())
+
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_GetUnreadCountsSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	counts, err := repository.GetUnreadCounts(context.Background(), 1)
+	_, unreadCount := mock.GetChatLastMessageAndUnreadCount(1, 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(unreadCount, getChatUnreadCount(counts, 1), t)
+}
+
+func TestRepository_GetUnreadCountsSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	_, err := repository.GetUnreadCounts(context.Background(), 1)
+
+	utils.AssertNotNil(err, t)
+}
@@ -1,6 +1,7 @@
 package user_settings
 
 import (
+	"context"
 	"github.com/jmoiron/sqlx"
 	"internal_errors"
 	"models"
@@ -32,17 +33,17 @@ func New(db *sqlx.DB) Repository {
 	return Repository{db}
 }
 
-func (r Repository) GetUserSettings(userId uint) (models.FullUserInfo, error) {
+func (r Repository) GetUserSettings(ctx context.Context, userId uint) (models.FullUserInfo, error) {
 	var (
 		err          error
 		fullUserInfo models.FullUserInfo
 	)
-	fullUserInfo.UserSettings, err = r.getUserInfo(userId)
+	fullUserInfo.UserSettings, err = r.getUserInfo(ctx, userId)
 	if err != nil {
 		return models.FullUserInfo{}, err
 	}
 
-	fullUserInfo.Rating, err = r.getUserRating(userId)
+	fullUserInfo.Rating, err = r.getUserRating(ctx, userId)
 	if err != nil {
 		return models.FullUserInfo{}, err
 	}
@@ -50,9 +51,9 @@ func (r Repository) GetUserSettings(userId uint) (models.FullUserInfo, error) {
 	return fullUserInfo, nil
 }
 
-func (r Repository) getUserInfo(userId uint) (models.UserSettings, error) {
+func (r Repository) getUserInfo(ctx context.Context, userId uint) (models.UserSettings, error) {
 	var info models.UserSettings
-	err := r.db.Get(&info, GetUserInfoQuery, userId)
+	err := r.db.GetContext(ctx, &info, GetUserInfoQuery, userId)
 
 	switch {
 	case err == nil:
@@ -64,9 +65,9 @@ func (r Repository) getUserInfo(userId uint) (models.UserSettings, error) {
 	}
 }
 
-func (r Repository) getUserRating(userId uint) ([]models.Rating, error) {
+func (r Repository) getUserRating(ctx context.Context, userId uint) ([]models.Rating, error) {
 	var rating []models.Rating
-	rows, err := r.db.Queryx(GetUserRatingQuery, userId)
+	rows, err := r.db.QueryxContext(ctx, GetUserRatingQuery, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -83,8 +84,8 @@ func (r Repository) getUserRating(userId uint) ([]models.Rating, error) {
 	return rating, nil
 }
 
-func (r Repository) UpdateUserSettings(userId uint, info models.UserSettings) error {
-	res, err := r.db.NamedExec(UpdateInfoQuery, UpdateInfo{
+func (r Repository) UpdateUserSettings(ctx context.Context, userId uint, info models.UserSettings) error {
+	res, err := r.db.NamedExecContext(ctx, UpdateInfoQuery, UpdateInfo{
 		ID:           userId,
 		UserSettings: info,
 	})
@@ -1,6 +1,7 @@
 package user_settings
 
 import (
+	"context"
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
@@ -41,7 +42,7 @@ func TestRepository_GetUserInfoSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	userSettings, err := repository.GetUserSettings(1)
+	userSettings, err := repository.GetUserSettings(context.Background(), 1)
 	utils.AssertNil(err, t)
 	utils.AssertTrue(mock.SettingsEqual(mock.GetFirstUserSettings(), userSettings), t)
 }
@@ -50,14 +51,14 @@ func TestRepository_GetUserInfoUserNoFoundError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	_, err := repository.GetUserSettings(mock.GetNotExistsUserId())
+	_, err := repository.GetUserSettings(context.Background(), mock.GetNotExistsUserId())
 	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
 }
 
 func TestRepository_GetUserInfoErrorNoTable(t *testing.T) {
 	mock.DropTables(db)
 
-	_, err := repository.GetUserSettings(mock.GetNotExistsUserId())
+	_, err := repository.GetUserSettings(context.Background(), mock.GetNotExistsUserId())
 	utils.AssertNotNil(err, t)
 }
 
@@ -65,10 +66,10 @@ func TestRepository_UpdateUserInfoSuccess(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.UpdateUserSettings(1, mock.TestInfo)
+	err := repository.UpdateUserSettings(context.Background(), 1, mock.TestInfo)
 	utils.AssertNil(err, t)
 
-	userSettings, _ := repository.GetUserSettings(1)
+	userSettings, _ := repository.GetUserSettings(context.Background(), 1)
 	utils.AssertEqual(mock.TestInfo, userSettings.UserSettings, t)
 }
 
@@ -76,13 +77,13 @@ func TestRepository_UpdateUserInfoUserNoFoundError(t *testing.T) {
 	mock.InitTables(db)
 	defer mock.DropTables(db)
 
-	err := repository.UpdateUserSettings(mock.GetNotExistsUserId(), mock.TestInfo)
+	err := repository.UpdateUserSettings(context.Background(), mock.GetNotExistsUserId(), mock.TestInfo)
 	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
 }
 
 func TestRepository_UpdateUserInfoErrorNoTable(t *testing.T) {
 	mock.DropTables(db)
 
-	err := repository.UpdateUserSettings(1, mock.TestInfo)
+	err := repository.UpdateUserSettings(context.Background(), 1, mock.TestInfo)
 	utils.AssertNotNil(err, t)
 }
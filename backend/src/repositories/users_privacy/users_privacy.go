@@ -0,0 +1,86 @@
+package users_privacy
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+	"internal_errors"
+	"strings"
+)
+
+const (
+	BlockUserQuery = `
+	INSERT INTO users_blocks(user_id, blocked_user_id) VALUES(:user_id, :blocked_user_id)
+	ON CONFLICT (user_id, blocked_user_id) DO NOTHING`
+	UnblockUserQuery                 = `DELETE FROM users_blocks WHERE user_id = :user_id AND blocked_user_id = :blocked_user_id`
+	UpdateDirectMessagesAllowedQuery = `
+	UPDATE users_info SET direct_messages_allowed = :direct_messages_allowed WHERE user_id = :user_id`
+	// user without info is allowed to receive direct messages by default
+	DirectMessagesAllowedQuery = `
+	SELECT COALESCE(
+		(SELECT direct_messages_allowed FROM users_info WHERE user_id = $2), TRUE
+	) AND NOT EXISTS(
+		SELECT 1 FROM users_blocks
+		WHERE (user_id = $2 AND blocked_user_id = $1) OR (user_id = $1 AND blocked_user_id = $2)
+	)`
+
+	userIdNotFoundPrefix = `pq: insert or update on table "users_blocks" violates foreign key constraint`
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) Repository {
+	return Repository{db}
+}
+
+func (r Repository) BlockUser(ctx context.Context, userId, blockedUserId uint) error {
+	_, err := r.db.NamedExecContext(ctx, BlockUserQuery, r.getBlockArguments(userId, blockedUserId))
+
+	switch {
+	case err == nil:
+		return nil
+	case strings.HasPrefix(err.Error(), userIdNotFoundPrefix):
+		return internal_errors.UnableToFindUserById
+	default:
+		return err
+	}
+}
+
+func (r Repository) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	_, err := r.db.NamedExecContext(ctx, UnblockUserQuery, r.getBlockArguments(userId, blockedUserId))
+
+	return err
+}
+
+func (r Repository) getBlockArguments(userId, blockedUserId uint) map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":         userId,
+		"blocked_user_id": blockedUserId,
+	}
+}
+
+func (r Repository) SetDirectMessagesAllowed(ctx context.Context, userId uint, allowed bool) error {
+	res, err := r.db.NamedExecContext(ctx, UpdateDirectMessagesAllowedQuery, map[string]interface{}{
+		"user_id": userId, "direct_messages_allowed": allowed,
+	})
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	} else if affectedRows == 0 {
+		return internal_errors.UnableToFindUserById
+	}
+
+	return nil
+}
+
+func (r Repository) DirectMessagesAllowed(ctx context.Context, senderId, recipientId uint) (bool, error) {
+	var allowed bool
+	err := r.db.GetContext(ctx, &allowed, DirectMessagesAllowedQuery, senderId, recipientId)
+
+	return allowed, err
+}
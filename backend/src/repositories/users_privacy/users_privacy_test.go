@@ -0,0 +1,150 @@
+package users_privacy
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"internal_errors"
+	mock "mock/repositories"
+	"os"
+	"plugins/config"
+	"testing"
+	"utils"
+)
+
+var (
+	db         *sqlx.DB
+	repository Repository
+)
+
+func init() {
+	utils.SkipInShortMode()
+
+	var err error
+	db, err = config.GetConfiguredConnection()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	repository = New(db)
+}
+
+// we need this function to avoiding DB errors due parallel queries
+func TestMain(t *testing.M) {
+	res := t.Run()
+	mock.DropTables(db)
+	os.Exit(res)
+}
+
+func TestRepository_BlockUserSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.BlockUser(context.Background(), 1, 2)
+	allowed, _ := repository.DirectMessagesAllowed(context.Background(), 2, 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertFalse(allowed, t)
+}
+
+func TestRepository_BlockUserAlreadyBlocked(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.BlockUser(context.Background(), mock.UserIdThatBlockedFirstUser, 1)
+
+	utils.AssertNil(err, t)
+}
+
+func TestRepository_BlockUserIdNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.BlockUser(context.Background(), 1, mock.GetNotExistsUserId())
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
+}
+
+func TestRepository_BlockUserSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	err := repository.BlockUser(context.Background(), 1, 2)
+
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_UnblockUserSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.UnblockUser(context.Background(), mock.UserIdThatBlockedFirstUser, 1)
+	allowed, _ := repository.DirectMessagesAllowed(context.Background(), 1, mock.UserIdThatBlockedFirstUser)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(allowed, t)
+}
+
+func TestRepository_UnblockUserSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	err := repository.UnblockUser(context.Background(), 1, 2)
+
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_SetDirectMessagesAllowedSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.SetDirectMessagesAllowed(context.Background(), 2, false)
+	allowed, _ := repository.DirectMessagesAllowed(context.Background(), 1, 2)
+
+	utils.AssertNil(err, t)
+	utils.AssertFalse(allowed, t)
+}
+
+func TestRepository_SetDirectMessagesAllowedUserNotFound(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	err := repository.SetDirectMessagesAllowed(context.Background(), mock.GetNotExistsUserId(), false)
+
+	utils.AssertErrorsEqual(internal_errors.UnableToFindUserById, err, t)
+}
+
+func TestRepository_SetDirectMessagesAllowedSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	err := repository.SetDirectMessagesAllowed(context.Background(), 1, false)
+
+	utils.AssertNotNil(err, t)
+}
+
+func TestRepository_DirectMessagesAllowedSuccess(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	allowed, err := repository.DirectMessagesAllowed(context.Background(), 1, 2)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(allowed, t)
+}
+
+func TestRepository_DirectMessagesAllowedBlocked(t *testing.T) {
+	mock.InitTables(db)
+	defer mock.DropTables(db)
+
+	allowed, err := repository.DirectMessagesAllowed(context.Background(), 1, mock.UserIdThatBlockedFirstUser)
+
+	utils.AssertNil(err, t)
+	utils.AssertFalse(allowed, t)
+}
+
+func TestRepository_DirectMessagesAllowedSomeError(t *testing.T) {
+	mock.DropTables(db)
+
+	_, err := repository.DirectMessagesAllowed(context.Background(), 1, 2)
+
+	utils.AssertNotNil(err, t)
+}
@@ -0,0 +1,246 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"interfaces"
+	"internal_errors"
+	"io"
+	"mime"
+	"models"
+	"net/http"
+	"plugins/thumbnail"
+	"services/errors"
+	"strings"
+	"time"
+)
+
+const (
+	// count of bytes used for detecting of content type
+	sniffLength      = 512
+	blobIdLength     = 16
+	thumbnailSuffix  = "_thumb"
+	thumbnailMaxSize = 256
+	imageTypePrefix  = "image/"
+)
+
+type Service struct {
+	repository interfaces.AttachmentsRepository
+	store      interfaces.BlobStore
+	limits     models.AttachmentLimits
+}
+
+func New(
+	repository interfaces.AttachmentsRepository,
+	store interfaces.BlobStore,
+	limits models.AttachmentLimits,
+) Service {
+	return Service{repository, store, limits}
+}
+
+// content is streamed to blob store, so size is known only after saving
+func (s Service) Upload(ctx context.Context, upload models.AttachmentUpload) (models.Attachment, error) {
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(upload.Content, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return models.Attachment{}, errors.InternalError
+	}
+	head = head[:n]
+
+	mimeType := detectType(head)
+	if !s.isAllowedType(mimeType) {
+		return models.Attachment{}, errors.AttachmentNotAllowed
+	}
+
+	blobId, err := newBlobId()
+	if err != nil {
+		return models.Attachment{}, errors.InternalError
+	}
+
+	// one extra byte is read to find out that content exceeds limit
+	content := &countingReader{
+		reader: io.LimitReader(io.MultiReader(bytes.NewReader(head), upload.Content), s.limits.MaxSize+1),
+	}
+	err = s.store.Save(ctx, blobId, content)
+	if err != nil {
+		return models.Attachment{}, errors.InternalError
+	}
+
+	attachment := models.Attachment{
+		UploaderId: upload.UploaderId,
+		FileName:   upload.FileName,
+		MimeType:   mimeType,
+		Size:       content.count,
+		BlobId:     blobId,
+	}
+	if attachment.Size > s.limits.MaxSize {
+		s.deleteBlobs(ctx, attachment)
+		return models.Attachment{}, errors.AttachmentTooLarge
+	}
+	if strings.HasPrefix(mimeType, imageTypePrefix) {
+		attachment.HasThumbnail = s.saveThumbnail(ctx, blobId)
+	}
+
+	savedAttachment, err := s.repository.SaveAttachment(ctx, attachment)
+	switch err {
+	case nil:
+		return savedAttachment, nil
+	case internal_errors.UnableToFindUserById:
+		s.deleteBlobs(ctx, attachment)
+		return models.Attachment{}, errors.UserIdNotFound
+	default:
+		s.deleteBlobs(ctx, attachment)
+		return models.Attachment{}, errors.InternalError
+	}
+}
+
+func (s Service) Download(
+	ctx context.Context, attachmentId, userId uint, withThumbnail bool,
+) (models.AttachmentContent, error) {
+	attachment, err := s.repository.GetAttachment(ctx, attachmentId)
+	switch {
+	case err == internal_errors.UnableToFindAttachment:
+		return models.AttachmentContent{}, errors.AttachmentNotFound
+	case err != nil:
+		return models.AttachmentContent{}, errors.InternalError
+	case withThumbnail && !attachment.HasThumbnail:
+		return models.AttachmentContent{}, errors.AttachmentNotFound
+	}
+
+	err = s.checkAccess(ctx, attachment, userId)
+	if err != nil {
+		return models.AttachmentContent{}, err
+	}
+
+	blobId, mimeType := attachment.BlobId, attachment.MimeType
+	if withThumbnail {
+		blobId, mimeType = getThumbnailId(blobId), thumbnail.MimeType
+	}
+
+	content, err := s.store.Open(ctx, blobId)
+	switch err {
+	case nil:
+		return models.AttachmentContent{FileName: attachment.FileName, MimeType: mimeType, Content: content}, nil
+	case internal_errors.UnableToFindBlob:
+		return models.AttachmentContent{}, errors.AttachmentNotFound
+	default:
+		return models.AttachmentContent{}, errors.InternalError
+	}
+}
+
+// attachment is available for its uploader and for members of chat where it is sent,
+// for other users it is not found, so existence of attachments can not be found out by walking ids
+func (s Service) checkAccess(ctx context.Context, attachment models.Attachment, userId uint) error {
+	if attachment.UploaderId == userId {
+		return nil
+	}
+	if attachment.ChatId == nil {
+		return errors.AttachmentNotFound
+	}
+
+	isMember, err := s.repository.IsChatMember(ctx, *attachment.ChatId, userId)
+	switch {
+	case err != nil:
+		return errors.InternalError
+	case !isMember:
+		return errors.AttachmentNotFound
+	default:
+		return nil
+	}
+}
+
+// blobs are deleted before records, so blobs are never left without records
+func (s Service) DeleteOrphans(ctx context.Context, olderThan time.Duration) (uint, error) {
+	attachments, err := s.repository.GetOrphanAttachments(ctx, olderThan)
+	if err != nil {
+		return 0, errors.InternalError
+	}
+
+	var deletedIds []uint
+	for _, attachment := range attachments {
+		if s.deleteBlobs(ctx, attachment) {
+			deletedIds = append(deletedIds, attachment.Id)
+		}
+	}
+	if len(deletedIds) == 0 {
+		return 0, nil
+	}
+
+	err = s.repository.DeleteAttachments(ctx, deletedIds)
+	if err != nil {
+		return 0, errors.InternalError
+	}
+
+	return uint(len(deletedIds)), nil
+}
+
+// attachment is saved without thumbnail if image can not be decoded
+func (s Service) saveThumbnail(ctx context.Context, blobId string) bool {
+	source, err := s.store.Open(ctx, blobId)
+	if err != nil {
+		return false
+	}
+	defer source.Close()
+
+	preview, err := thumbnail.Make(source, thumbnailMaxSize)
+	if err != nil {
+		return false
+	}
+
+	return s.store.Save(ctx, getThumbnailId(blobId), bytes.NewReader(preview)) == nil
+}
+
+func (s Service) deleteBlobs(ctx context.Context, attachment models.Attachment) bool {
+	if attachment.HasThumbnail && s.store.Delete(ctx, getThumbnailId(attachment.BlobId)) != nil {
+		return false
+	}
+
+	return s.store.Delete(ctx, attachment.BlobId) == nil
+}
+
+func (s Service) isAllowedType(mimeType string) bool {
+	for _, allowedType := range s.limits.AllowedTypes {
+		if allowedType == mimeType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// type is detected by content, parameters like charset are dropped
+func detectType(head []byte) string {
+	mimeType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return ""
+	}
+
+	return mimeType
+}
+
+func newBlobId() (string, error) {
+	id := make([]byte, blobIdLength)
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
+
+func getThumbnailId(blobId string) string {
+	return blobId + thumbnailSuffix
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,137 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	repositoriesMock "mock/repositories"
+	mock "mock/services"
+	"models"
+	"plugins/thumbnail"
+	"services/errors"
+	"strings"
+	"testing"
+	"time"
+	"utils"
+)
+
+var service = New(&mock.AttachmentsRepository, &mock.BlobStore, mock.AttachmentLimits)
+
+func resetState() {
+	mock.AttachmentsRepository.ResetState()
+	mock.BlobStore.ResetState()
+}
+
+func getTextUpload(text string) models.AttachmentUpload {
+	return models.AttachmentUpload{UploaderId: 1, FileName: "notes.txt", Content: strings.NewReader(text)}
+}
+
+func TestService_UploadTextSuccess(t *testing.T) {
+	defer resetState()
+
+	attachment, err := service.Upload(context.Background(), getTextUpload("hello"))
+	content, _ := service.Download(context.Background(), attachment.Id, 1, false)
+	data, _ := ioutil.ReadAll(content.Content)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual("text/plain", attachment.MimeType, t)
+	utils.AssertEqual(int64(5), attachment.Size, t)
+	utils.AssertFalse(attachment.HasThumbnail, t)
+	utils.AssertEqual("hello", string(data), t)
+}
+
+func TestService_UploadImageWithThumbnail(t *testing.T) {
+	defer resetState()
+
+	attachment, err := service.Upload(context.Background(), models.AttachmentUpload{
+		UploaderId: 1, FileName: "image.png", Content: bytes.NewReader(mock.GetPNGContent()),
+	})
+	content, thumbnailErr := service.Download(context.Background(), attachment.Id, 1, true)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual("image/png", attachment.MimeType, t)
+	utils.AssertTrue(attachment.HasThumbnail, t)
+	utils.AssertNil(thumbnailErr, t)
+	utils.AssertEqual(thumbnail.MimeType, content.MimeType, t)
+}
+
+func TestService_UploadTooLarge(t *testing.T) {
+	defer resetState()
+
+	_, err := service.Upload(
+		context.Background(), getTextUpload(strings.Repeat("a", int(mock.AttachmentLimits.MaxSize)+1)))
+
+	utils.AssertErrorsEqual(errors.AttachmentTooLarge, err, t)
+	utils.AssertEqual(0, mock.BlobStore.Count(), t)
+}
+
+func TestService_UploadNotAllowedType(t *testing.T) {
+	defer resetState()
+
+	_, err := service.Upload(context.Background(), getTextUpload("%PDF-1.4 document"))
+
+	utils.AssertErrorsEqual(errors.AttachmentNotAllowed, err, t)
+}
+
+func TestService_UploadUserNotFound(t *testing.T) {
+	defer resetState()
+
+	upload := getTextUpload("hello")
+	upload.UploaderId = repositoriesMock.GetNotExistsUserId()
+	_, err := service.Upload(context.Background(), upload)
+
+	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
+	utils.AssertEqual(0, mock.BlobStore.Count(), t)
+}
+
+func TestService_DownloadNotFound(t *testing.T) {
+	defer resetState()
+
+	_, err := service.Download(context.Background(), 1, 1, false)
+
+	utils.AssertErrorsEqual(errors.AttachmentNotFound, err, t)
+}
+
+func TestService_DownloadThumbnailOfText(t *testing.T) {
+	defer resetState()
+
+	attachment, _ := service.Upload(context.Background(), getTextUpload("hello"))
+	_, err := service.Download(context.Background(), attachment.Id, 1, true)
+
+	utils.AssertErrorsEqual(errors.AttachmentNotFound, err, t)
+}
+
+func TestService_DownloadSentAttachment(t *testing.T) {
+	defer resetState()
+
+	attachment, _ := service.Upload(context.Background(), getTextUpload("hello"))
+	mock.AttachmentsRepository.Send(attachment.Id, 1, 1)
+	ctx := context.Background()
+	_, memberErr := service.Download(ctx, attachment.Id, 2, false)
+	_, notMemberErr := service.Download(ctx, attachment.Id, repositoriesMock.UserIdNotInFirstChat, false)
+
+	utils.AssertNil(memberErr, t)
+	utils.AssertErrorsEqual(errors.AttachmentNotFound, notMemberErr, t)
+}
+
+func TestService_DownloadNotSentAttachmentOfAnotherUser(t *testing.T) {
+	defer resetState()
+
+	attachment, _ := service.Upload(context.Background(), getTextUpload("hello"))
+	_, err := service.Download(context.Background(), attachment.Id, 2, false)
+
+	utils.AssertErrorsEqual(errors.AttachmentNotFound, err, t)
+}
+
+func TestService_DeleteOrphans(t *testing.T) {
+	defer resetState()
+
+	attachment, _ := service.Upload(context.Background(), getTextUpload("hello"))
+	count, err := service.DeleteOrphans(context.Background(), time.Hour)
+	_, downloadErr := service.Download(context.Background(), attachment.Id, 1, false)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(uint(1), count, t)
+	utils.AssertEqual(0, mock.BlobStore.Count(), t)
+	utils.AssertErrorsEqual(errors.AttachmentNotFound, downloadErr, t)
+}
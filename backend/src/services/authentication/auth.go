@@ -1,6 +1,7 @@
 package authentication
 
 import (
+	"context"
 	"interfaces"
 	"internal_errors"
 	"models"
@@ -16,10 +17,10 @@ func New(repository interfaces.CredentialsRepository) Service {
 	return Service{repository}
 }
 
-func (s Service) RegisterUser(credentials models.UserCredentials) error {
+func (s Service) RegisterUser(ctx context.Context, credentials models.UserCredentials) error {
 	credentials.Password = s.generatePassword(credentials)
 
-	switch err := s.repository.CreateUser(credentials); err {
+	switch err := s.repository.CreateUser(ctx, credentials); err {
 	case nil:
 		return nil
 	case internal_errors.UnableToRegisterUserEmailExists:
@@ -33,9 +34,9 @@ func (s Service) generatePassword(credentials models.UserCredentials) string {
 	return utils.GetHash(credentials.Email + credentials.Password)
 }
 
-func (s Service) Login(credentials models.UserCredentials) (models.UserSession, error) {
+func (s Service) Login(ctx context.Context, credentials models.UserCredentials) (models.UserSession, error) {
 	credentials.Password = s.generatePassword(credentials)
-	userId, err := s.repository.GetUserIdByCredentials(credentials)
+	userId, err := s.repository.GetUserIdByCredentials(ctx, credentials)
 
 	switch err {
 	case nil:
@@ -47,15 +48,15 @@ func (s Service) Login(credentials models.UserCredentials) (models.UserSession,
 	}
 }
 
-func (s Service) ChangePassword(userId uint, password string) error {
-	email, err := s.getUserEmail(userId)
+func (s Service) ChangePassword(ctx context.Context, userId uint, password string) error {
+	email, err := s.getUserEmail(ctx, userId)
 	if err != nil {
 		return err
 	}
 
 	user := models.UserCredentials{Email: email, Password: password}
 	user.Password = s.generatePassword(user)
-	switch err := s.repository.UpdateUserPassword(user); err {
+	switch err := s.repository.UpdateUserPassword(ctx, user); err {
 	case nil:
 		return nil
 	default:
@@ -63,8 +64,8 @@ func (s Service) ChangePassword(userId uint, password string) error {
 	}
 }
 
-func (s Service) getUserEmail(userId uint) (string, error) {
-	email, err := s.repository.GetUserEmail(userId)
+func (s Service) getUserEmail(ctx context.Context, userId uint) (string, error) {
+	email, err := s.repository.GetUserEmail(ctx, userId)
 
 	switch err {
 	case nil:
@@ -1,6 +1,7 @@
 package authentication
 
 import (
+	"context"
 	mock "mock/services"
 	"services/errors"
 	"testing"
@@ -12,10 +13,10 @@ var authService = New(&mock.CredentialsRepo)
 func TestAuthService_RegisterUserSuccess(t *testing.T) {
 	defer mock.CredentialsRepo.ResetState()
 
-	err := authService.RegisterUser(mock.NewUser)
+	err := authService.RegisterUser(context.Background(), mock.NewUser)
 	utils.AssertNil(err, t)
 
-	userSession, err := authService.Login(mock.NewUser)
+	userSession, err := authService.Login(context.Background(), mock.NewUser)
 	utils.AssertNil(err, t)
 	utils.AssertEqual(int(userSession.Id), len(mock.CredentialsRepo.Users), t)
 }
@@ -23,32 +24,32 @@ func TestAuthService_RegisterUserSuccess(t *testing.T) {
 func TestAuthService_RegisterUserEmailExistsError(t *testing.T) {
 	defer mock.CredentialsRepo.ResetState()
 
-	err := authService.RegisterUser(mock.FirstUserCredentials())
+	err := authService.RegisterUser(context.Background(), mock.FirstUserCredentials())
 	utils.AssertErrorsEqual(errors.EmailExists, err, t)
 }
 
 func TestAuthService_RegisterUserInternalError(t *testing.T) {
 	defer mock.CredentialsRepo.ResetState()
 
-	err := authService.RegisterUser(mock.BadUser)
+	err := authService.RegisterUser(context.Background(), mock.BadUser)
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestAuthService_LoginSuccess(t *testing.T) {
-	userSession, err := authService.Login(mock.FirstUserCredentials())
+	userSession, err := authService.Login(context.Background(), mock.FirstUserCredentials())
 
 	utils.AssertNil(err, t)
 	utils.AssertEqual(1, int(userSession.Id), t)
 }
 
 func TestAuthService_LoginCredentialsNotFoundError(t *testing.T) {
-	_, err := authService.Login(mock.NewUser)
+	_, err := authService.Login(context.Background(), mock.NewUser)
 
 	utils.AssertErrorsEqual(errors.CredentialsNotFound, err, t)
 }
 
 func TestAuthService_LoginInternalError(t *testing.T) {
-	_, err := authService.Login(mock.BadUser)
+	_, err := authService.Login(context.Background(), mock.BadUser)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
@@ -56,8 +57,8 @@ func TestAuthService_LoginInternalError(t *testing.T) {
 func TestAuthService_ChangePasswordSuccess(t *testing.T) {
 	defer mock.CredentialsRepo.ResetState()
 
-	err := authService.ChangePassword(1, "new_password")
-	email, _ := mock.CredentialsRepo.GetUserEmail(1)
+	err := authService.ChangePassword(context.Background(), 1, "new_password")
+	email, _ := mock.CredentialsRepo.GetUserEmail(context.Background(), 1)
 	expectedPassword := utils.GetHash(email + "new_password")
 
 	utils.AssertNil(err, t)
@@ -65,19 +66,19 @@ func TestAuthService_ChangePasswordSuccess(t *testing.T) {
 }
 
 func TestAuthService_ChangePasswordUserNotFoundError(t *testing.T) {
-	err := authService.ChangePassword(11, "")
+	err := authService.ChangePassword(context.Background(), 11, "")
 
 	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
 }
 
 func TestAuthService_ChangePasswordInternalError_1(t *testing.T) {
-	err := authService.ChangePassword(1, mock.BadUser.Password)
+	err := authService.ChangePassword(context.Background(), 1, mock.BadUser.Password)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestAuthService_ChangePasswordInternalError_2(t *testing.T) {
-	err := authService.ChangePassword(mock.BadUserId, "")
+	err := authService.ChangePassword(context.Background(), mock.BadUserId, "")
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
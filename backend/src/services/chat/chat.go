@@ -1,27 +1,29 @@
 package chat
 
 import (
+	"context"
 	"interfaces"
 	"internal_errors"
 	"services/errors"
 )
 
 const (
-	meetingChatType        = "meeting"
-	meetingRequestChatType = "meeting_request"
-	archivedChatStatus     = "archived"
+	meetingChatType    = "meeting"
+	archivedChatStatus = "archived"
+	chattingChatStatus = "chatting"
 )
 
 type Service struct {
-	repository interfaces.ChatRepository
+	repository            interfaces.ChatRepository
+	requestChatRepository interfaces.MeetingRequestChatRepository
 }
 
-func New(repository interfaces.ChatRepository) Service {
-	return Service{repository}
+func New(repository interfaces.ChatRepository, requestChatRepository interfaces.MeetingRequestChatRepository) Service {
+	return Service{repository, requestChatRepository}
 }
 
-func (s Service) CreateMeetingChat(meetingId uint) error {
-	switch err := s.repository.CreateChat(meetingId, meetingChatType); err {
+func (s Service) CreateMeetingChat(ctx context.Context, meetingId uint) error {
+	switch err := s.repository.CreateChat(ctx, meetingId, meetingChatType); err {
 	case nil:
 		return nil
 	default:
@@ -29,17 +31,44 @@ func (s Service) CreateMeetingChat(meetingId uint) error {
 	}
 }
 
-func (s Service) CreateMeetingRequestChat(meetingId uint) error {
-	switch err := s.repository.CreateChat(meetingId, meetingRequestChatType); err {
+func (s Service) CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	switch err := s.requestChatRepository.CreateMeetingRequestChat(ctx, meetingId, applicantId); err {
 	case nil:
 		return nil
+	case internal_errors.MeetingRequestChatAlreadyExists:
+		return errors.RequestChatExists
+	case internal_errors.UnableToFindMeetingById:
+		return errors.MeetingIdNotFound
+	case internal_errors.UnableToFindUserById:
+		return errors.UserIdNotFound
 	default:
 		return errors.InternalError
 	}
 }
 
-func (s Service) CloseChat(chatId uint) error {
-	switch err := s.repository.SetChatStatus(chatId, archivedChatStatus); err {
+func (s Service) CloseChat(ctx context.Context, chatId uint) error {
+	switch err := s.repository.SetChatStatus(ctx, chatId, archivedChatStatus); err {
+	case nil:
+		return nil
+	case internal_errors.UnableToFindChatById:
+		return errors.ChatIdNotFound
+	default:
+		return errors.InternalError
+	}
+}
+
+func (s Service) ReopenChat(ctx context.Context, chatId, adminId uint) error {
+	meetingAdminId, err := s.repository.GetChatMeetingAdminId(ctx, chatId)
+	switch {
+	case err == internal_errors.UnableToFindChatById:
+		return errors.ChatIdNotFound
+	case err != nil:
+		return errors.InternalError
+	case meetingAdminId == 0 || meetingAdminId != adminId:
+		return errors.ChatReopenForbidden
+	}
+
+	switch err := s.repository.SetChatStatus(ctx, chatId, chattingChatStatus); err {
 	case nil:
 		return nil
 	case internal_errors.UnableToFindChatById:
@@ -1,6 +1,7 @@
 package chat
 
 import (
+	"context"
 	repositoriesMock "mock/repositories"
 	mock "mock/services"
 	"services/errors"
@@ -8,13 +9,13 @@ import (
 	"utils"
 )
 
-var service = New(&mock.ChatRepository)
+var service = New(&mock.ChatRepository, &mock.ChatRepository)
 
 func TestService_CreateMeetingChatSuccess(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	err := service.CreateMeetingChat(repositoriesMock.MeetingIdWithoutMeetingChat)
-	chat, _ := mock.ChatRepository.GetMeetingChat(repositoriesMock.MeetingIdWithoutMeetingChat)
+	err := service.CreateMeetingChat(context.Background(), repositoriesMock.MeetingIdWithoutMeetingChat)
+	chat, _ := mock.ChatRepository.GetMeetingChat(context.Background(), repositoriesMock.MeetingIdWithoutMeetingChat)
 
 	utils.AssertNil(err, t)
 	utils.AssertEqual(meetingChatType, chat.Type, t)
@@ -23,7 +24,7 @@ func TestService_CreateMeetingChatSuccess(t *testing.T) {
 func TestService_CreateMeetingChatInternalError(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	err := service.CreateMeetingChat(mock.BadMeetingId)
+	err := service.CreateMeetingChat(context.Background(), mock.BadMeetingId)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
@@ -31,17 +32,34 @@ func TestService_CreateMeetingChatInternalError(t *testing.T) {
 func TestService_CreateMeetingRequestChatSuccess(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	err := service.CreateMeetingRequestChat(repositoriesMock.MeetingIdWithoutMeetingChat)
-	chat, _ := mock.ChatRepository.GetMeetingChat(repositoriesMock.MeetingIdWithoutMeetingChat)
+	err := service.CreateMeetingRequestChat(context.Background(), 1, repositoriesMock.UserIdWithoutFirstRequestChat)
 
 	utils.AssertNil(err, t)
-	utils.AssertEqual(meetingRequestChatType, chat.Type, t)
+	utils.AssertTrue(mock.ChatRepository.HasOpenedRequestChat(1, repositoriesMock.UserIdWithoutFirstRequestChat), t)
+}
+
+func TestService_CreateMeetingRequestChatAlreadyExists(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	err := service.CreateMeetingRequestChat(context.Background(), 1, repositoriesMock.FirstMeetingApplicantId)
+
+	utils.AssertErrorsEqual(errors.RequestChatExists, err, t)
+}
+
+func TestService_CreateMeetingRequestChatMeetingNotFound(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	err := service.CreateMeetingRequestChat(
+		context.Background(), repositoriesMock.GetNotExistsMeetingId(), repositoriesMock.UserIdWithoutFirstRequestChat)
+
+	utils.AssertErrorsEqual(errors.MeetingIdNotFound, err, t)
 }
 
 func TestService_CreateMeetingRequestChatInternalError(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	err := service.CreateMeetingRequestChat(mock.BadMeetingId)
+	err := service.CreateMeetingRequestChat(
+		context.Background(), mock.BadMeetingId, repositoriesMock.UserIdWithoutFirstRequestChat)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
@@ -49,8 +67,8 @@ func TestService_CreateMeetingRequestChatInternalError(t *testing.T) {
 func TestService_CloseChatSuccess(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	err := service.CloseChat(1)
-	chat, _ := mock.ChatRepository.GetMeetingChat(1)
+	err := service.CloseChat(context.Background(), 1)
+	chat, _ := mock.ChatRepository.GetMeetingChat(context.Background(), 1)
 
 	utils.AssertNil(err, t)
 	utils.AssertEqual(archivedChatStatus, chat.Status, t)
@@ -59,7 +77,7 @@ func TestService_CloseChatSuccess(t *testing.T) {
 func TestService_CloseChatNotFound(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	err := service.CloseChat(repositoriesMock.NotExistsChatId)
+	err := service.CloseChat(context.Background(), repositoriesMock.NotExistsChatId)
 
 	utils.AssertErrorsEqual(errors.ChatIdNotFound, err, t)
 }
@@ -67,7 +85,51 @@ func TestService_CloseChatNotFound(t *testing.T) {
 func TestService_CloseChatInternalError(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	err := service.CloseChat(mock.BadChatId)
+	err := service.CloseChat(context.Background(), mock.BadChatId)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_ReopenChatSuccess(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	_ = service.CloseChat(context.Background(), 1)
+	err := service.ReopenChat(context.Background(), 1, repositoriesMock.GetChatMeetingAdminId(1))
+	chat, _ := mock.ChatRepository.GetMeetingChat(context.Background(), 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(chattingChatStatus, chat.Status, t)
+}
+
+func TestService_ReopenChatNotAdmin(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	err := service.ReopenChat(context.Background(), 1, repositoriesMock.GetChatMeetingAdminId(1)+1)
+
+	utils.AssertErrorsEqual(errors.ChatReopenForbidden, err, t)
+}
+
+func TestService_ReopenDirectChat(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	firstUserId, _ := repositoriesMock.GetFirstDirectChatUsers()
+	err := service.ReopenChat(context.Background(), repositoriesMock.FirstDirectChatId, firstUserId)
+
+	utils.AssertErrorsEqual(errors.ChatReopenForbidden, err, t)
+}
+
+func TestService_ReopenChatNotFound(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	err := service.ReopenChat(context.Background(), repositoriesMock.NotExistsChatId, 1)
+
+	utils.AssertErrorsEqual(errors.ChatIdNotFound, err, t)
+}
+
+func TestService_ReopenChatInternalError(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	err := service.ReopenChat(context.Background(), mock.BadChatId, 1)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
@@ -1,6 +1,7 @@
 package chat_accessor
 
 import (
+	"context"
 	"interfaces"
 	"internal_errors"
 	"models"
@@ -15,8 +16,8 @@ func New(repository interfaces.ChatAccessor) Service {
 	return Service{repository}
 }
 
-func (s Service) GetMeetingChat(meetingId uint) (models.Chat, error) {
-	chat, err := s.repository.GetMeetingChat(meetingId)
+func (s Service) GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error) {
+	chat, err := s.repository.GetMeetingChat(ctx, meetingId)
 
 	switch err {
 	case nil:
@@ -28,8 +29,8 @@ func (s Service) GetMeetingChat(meetingId uint) (models.Chat, error) {
 	}
 }
 
-func (s Service) GetUserChats(userId uint) ([]models.Chat, error) {
-	chats, err := s.repository.GetUserChats(userId)
+func (s Service) GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error) {
+	chats, err := s.repository.GetUserChats(ctx, userId)
 
 	switch err {
 	case nil:
@@ -38,3 +39,14 @@ func (s Service) GetUserChats(userId uint) ([]models.Chat, error) {
 		return nil, errors.InternalError
 	}
 }
+
+func (s Service) GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error) {
+	members, err := s.repository.GetChatMembers(ctx, chatId, usersIds)
+
+	switch err {
+	case nil:
+		return members, nil
+	default:
+		return nil, errors.InternalError
+	}
+}
@@ -1,6 +1,7 @@
 package chat_accessor
 
 import (
+	"context"
 	repositoriesMock "mock/repositories"
 	mock "mock/services"
 	"services/errors"
@@ -13,7 +14,7 @@ var service = New(&mock.ChatRepository)
 func TestService_GetMeetingChatSuccess(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	chat, err := service.GetMeetingChat(1)
+	chat, err := service.GetMeetingChat(context.Background(), 1)
 
 	utils.AssertNil(err, t)
 	utils.AssertEqual(repositoriesMock.MeetingType, chat.Type, t)
@@ -22,7 +23,7 @@ func TestService_GetMeetingChatSuccess(t *testing.T) {
 func TestService_GetMeetingChatMeetingNotFound(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	_, err := service.GetMeetingChat(repositoriesMock.GetNotExistsMeetingId())
+	_, err := service.GetMeetingChat(context.Background(), repositoriesMock.GetNotExistsMeetingId())
 
 	utils.AssertErrorsEqual(errors.MeetingIdNotFound, err, t)
 }
@@ -30,7 +31,7 @@ func TestService_GetMeetingChatMeetingNotFound(t *testing.T) {
 func TestService_GetMeetingChatInternalError(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	_, err := service.GetMeetingChat(mock.BadMeetingId)
+	_, err := service.GetMeetingChat(context.Background(), mock.BadMeetingId)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
@@ -38,7 +39,7 @@ func TestService_GetMeetingChatInternalError(t *testing.T) {
 func TestService_GetUserChatsSuccess(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	chats, err := service.GetUserChats(1)
+	chats, err := service.GetUserChats(context.Background(), 1)
 
 	utils.AssertNil(err, t)
 	utils.AssertNotNil(chats, t)
@@ -47,7 +48,26 @@ func TestService_GetUserChatsSuccess(t *testing.T) {
 func TestService_GetUserChatsInternalError(t *testing.T) {
 	defer mock.ChatRepository.ResetState()
 
-	_, err := service.GetUserChats(mock.BadUserId)
+	_, err := service.GetUserChats(context.Background(), mock.BadUserId)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_GetChatMembersSuccess(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	members, err := service.GetChatMembers(context.Background(), repositoriesMock.FirstRequestChatId,
+		[]uint{repositoriesMock.FirstMeetingApplicantId, repositoriesMock.UserIdWithoutFirstRequestChat})
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(1, len(members), t)
+	utils.AssertEqual(repositoriesMock.FirstMeetingApplicantId, members[0], t)
+}
+
+func TestService_GetChatMembersInternalError(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	_, err := service.GetChatMembers(context.Background(), mock.BadChatId, []uint{1})
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
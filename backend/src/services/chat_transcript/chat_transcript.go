@@ -0,0 +1,44 @@
+package chat_transcript
+
+import (
+	"context"
+	"interfaces"
+	"internal_errors"
+	"io"
+	"models"
+	"plugins/transcript"
+	"services/errors"
+)
+
+type Service struct {
+	adminRepository interfaces.ChatAdminRepository
+	repository      interfaces.ChatTranscriptRepository
+}
+
+func New(adminRepository interfaces.ChatAdminRepository, repository interfaces.ChatTranscriptRepository) Service {
+	return Service{adminRepository, repository}
+}
+
+// access is checked before anything is written, so writer receives nothing if export is not allowed
+func (s Service) ExportChat(ctx context.Context, request models.ChatExportRequest, w io.Writer) error {
+	adminId, err := s.adminRepository.GetChatMeetingAdminId(ctx, request.ChatId)
+	switch {
+	case err == internal_errors.UnableToFindChatById:
+		return errors.ChatIdNotFound
+	case err != nil:
+		return errors.InternalError
+	case adminId == 0 || adminId != request.AdminId:
+		return errors.ChatExportForbidden
+	}
+
+	writer := transcript.NewWriter(request.Format, w)
+	err = s.repository.ExportMessages(ctx, request.ChatId, writer.WriteMessage)
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		return errors.InternalError
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package chat_transcript
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	repositoriesMock "mock/repositories"
+	mock "mock/services"
+	"models"
+	"plugins/transcript"
+	"services/errors"
+	"strings"
+	"testing"
+	"utils"
+)
+
+var service = New(&mock.ChatRepository, &mock.ChatRepository)
+
+func TestService_ExportChatJSON(t *testing.T) {
+	var buffer bytes.Buffer
+	err := service.ExportChat(
+		context.Background(), repositoriesMock.GetFirstChatExportRequest(transcript.JSONFormat), &buffer)
+
+	utils.AssertNil(err, t)
+	var messages []models.TranscriptMessage
+	utils.AssertNil(json.Unmarshal(buffer.Bytes(), &messages), t)
+	utils.AssertEqual(repositoriesMock.GetChatMessagesCount(1), len(messages), t)
+	utils.AssertEqual(repositoriesMock.GetUserNickname(messages[0].SenderId), messages[0].SenderNickname, t)
+}
+
+func TestService_ExportChatCSV(t *testing.T) {
+	var buffer bytes.Buffer
+	err := service.ExportChat(
+		context.Background(), repositoriesMock.GetFirstChatExportRequest(transcript.CSVFormat), &buffer)
+
+	utils.AssertNil(err, t)
+	records, err := csv.NewReader(&buffer).ReadAll()
+	utils.AssertNil(err, t)
+	// header is the first record
+	utils.AssertEqual(repositoriesMock.GetChatMessagesCount(1)+1, len(records), t)
+}
+
+func TestService_ExportChatText(t *testing.T) {
+	var buffer bytes.Buffer
+	err := service.ExportChat(
+		context.Background(), repositoriesMock.GetFirstChatExportRequest(transcript.TextFormat), &buffer)
+
+	utils.AssertNil(err, t)
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	utils.AssertEqual(repositoriesMock.GetChatMessagesCount(1), len(lines), t)
+}
+
+func TestService_ExportChatNotAdmin(t *testing.T) {
+	var buffer bytes.Buffer
+	request := repositoriesMock.GetFirstChatExportRequest(transcript.JSONFormat)
+	request.AdminId++
+	err := service.ExportChat(context.Background(), request, &buffer)
+
+	utils.AssertErrorsEqual(errors.ChatExportForbidden, err, t)
+	utils.AssertEqual(0, buffer.Len(), t)
+}
+
+func TestService_ExportChatDirectChat(t *testing.T) {
+	var buffer bytes.Buffer
+	request := repositoriesMock.GetFirstChatExportRequest(transcript.JSONFormat)
+	request.ChatId = repositoriesMock.FirstDirectChatId
+	err := service.ExportChat(context.Background(), request, &buffer)
+
+	utils.AssertErrorsEqual(errors.ChatExportForbidden, err, t)
+}
+
+func TestService_ExportChatNotFound(t *testing.T) {
+	var buffer bytes.Buffer
+	request := repositoriesMock.GetFirstChatExportRequest(transcript.JSONFormat)
+	request.ChatId = repositoriesMock.NotExistsChatId
+	err := service.ExportChat(context.Background(), request, &buffer)
+
+	utils.AssertErrorsEqual(errors.ChatIdNotFound, err, t)
+}
+
+func TestService_ExportChatInternalError(t *testing.T) {
+	var buffer bytes.Buffer
+	request := repositoriesMock.GetFirstChatExportRequest(transcript.JSONFormat)
+	request.ChatId = mock.BadChatId
+	err := service.ExportChat(context.Background(), request, &buffer)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
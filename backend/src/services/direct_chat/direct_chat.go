@@ -0,0 +1,69 @@
+package direct_chat
+
+import (
+	"context"
+	"interfaces"
+	"internal_errors"
+	"models"
+	"services/errors"
+)
+
+type Service struct {
+	chatRepository    interfaces.DirectChatRepository
+	privacyRepository interfaces.UsersPrivacyRepository
+}
+
+func New(
+	chatRepository interfaces.DirectChatRepository,
+	privacyRepository interfaces.UsersPrivacyRepository,
+) Service {
+	return Service{chatRepository, privacyRepository}
+}
+
+func (s Service) GetDirectChat(ctx context.Context, userId, interlocutorId uint) (models.Chat, error) {
+	chat, err := s.chatRepository.GetDirectChat(ctx, userId, interlocutorId)
+
+	switch err {
+	case nil:
+		return chat, nil
+	case internal_errors.UnableToFindDirectChat:
+		return models.Chat{}, errors.DirectChatNotFound
+	default:
+		return models.Chat{}, errors.InternalError
+	}
+}
+
+func (s Service) GetOrCreateDirectChat(ctx context.Context, senderId, recipientId uint) (models.Chat, error) {
+	allowed, err := s.privacyRepository.DirectMessagesAllowed(ctx, senderId, recipientId)
+	if err != nil {
+		return models.Chat{}, errors.InternalError
+	} else if !allowed {
+		return models.Chat{}, errors.DirectMessagesDenied
+	}
+
+	chat, err := s.chatRepository.GetDirectChat(ctx, senderId, recipientId)
+	switch err {
+	case nil:
+		return chat, nil
+	case internal_errors.UnableToFindDirectChat:
+		return s.createDirectChat(ctx, senderId, recipientId)
+	default:
+		return models.Chat{}, errors.InternalError
+	}
+}
+
+func (s Service) createDirectChat(ctx context.Context, senderId, recipientId uint) (models.Chat, error) {
+	chat, err := s.chatRepository.CreateDirectChat(ctx, senderId, recipientId)
+
+	switch err {
+	case nil:
+		return chat, nil
+	case internal_errors.DirectChatAlreadyExists:
+		// chat was created by concurrent request between our get and create calls
+		return s.GetDirectChat(ctx, senderId, recipientId)
+	case internal_errors.UnableToFindUserById:
+		return models.Chat{}, errors.UserIdNotFound
+	default:
+		return models.Chat{}, errors.InternalError
+	}
+}
@@ -0,0 +1,99 @@
+package direct_chat
+
+import (
+	"context"
+	repositoriesMock "mock/repositories"
+	mock "mock/services"
+	"services/errors"
+	"testing"
+	"utils"
+)
+
+var service = New(&mock.ChatRepository, &mock.UsersPrivacyRepository)
+
+func resetState() {
+	mock.ChatRepository.ResetState()
+	mock.UsersPrivacyRepository.ResetState()
+}
+
+func TestService_GetDirectChatSuccess(t *testing.T) {
+	defer resetState()
+
+	firstUserId, secondUserId := repositoriesMock.GetFirstDirectChatUsers()
+	chat, err := service.GetDirectChat(context.Background(), firstUserId, secondUserId)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(repositoriesMock.FirstDirectChatId, chat.Id, t)
+	utils.AssertEqual(repositoriesMock.DirectType, chat.Type, t)
+}
+
+func TestService_GetDirectChatNotFound(t *testing.T) {
+	defer resetState()
+
+	_, err := service.GetDirectChat(context.Background(), 1, repositoriesMock.UserIdWithoutDirectChats)
+
+	utils.AssertErrorsEqual(errors.DirectChatNotFound, err, t)
+}
+
+func TestService_GetDirectChatInternalError(t *testing.T) {
+	defer resetState()
+
+	_, err := service.GetDirectChat(context.Background(), mock.BadUserId, 1)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_GetOrCreateDirectChatExisting(t *testing.T) {
+	defer resetState()
+
+	firstUserId, secondUserId := repositoriesMock.GetFirstDirectChatUsers()
+	chat, err := service.GetOrCreateDirectChat(context.Background(), secondUserId, firstUserId)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(repositoriesMock.FirstDirectChatId, chat.Id, t)
+}
+
+func TestService_GetOrCreateDirectChatCreated(t *testing.T) {
+	defer resetState()
+
+	chat, err := service.GetOrCreateDirectChat(context.Background(), 1, repositoriesMock.UserIdWithoutDirectChats)
+	foundChat, _ := service.GetDirectChat(context.Background(), repositoriesMock.UserIdWithoutDirectChats, 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(repositoriesMock.DirectType, chat.Type, t)
+	utils.AssertEqual(chat.Id, foundChat.Id, t)
+}
+
+func TestService_GetOrCreateDirectChatBlocked(t *testing.T) {
+	defer resetState()
+
+	_, err := service.GetOrCreateDirectChat(context.Background(), 1, repositoriesMock.UserIdThatBlockedFirstUser)
+
+	utils.AssertErrorsEqual(errors.DirectMessagesDenied, err, t)
+}
+
+func TestService_GetOrCreateDirectChatNotAllowedByPrivacy(t *testing.T) {
+	defer resetState()
+
+	_ = mock.UsersPrivacyRepository.SetDirectMessagesAllowed(
+		context.Background(), repositoriesMock.UserIdWithoutDirectChats, false)
+	_, err := service.GetOrCreateDirectChat(context.Background(), 1, repositoriesMock.UserIdWithoutDirectChats)
+
+	utils.AssertErrorsEqual(errors.DirectMessagesDenied, err, t)
+}
+
+func TestService_GetOrCreateDirectChatUserNotFound(t *testing.T) {
+	defer resetState()
+
+	_, err := service.GetOrCreateDirectChat(context.Background(), 1, repositoriesMock.GetNotExistsUserId())
+
+	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
+}
+
+func TestService_GetOrCreateDirectChatInternalError(t *testing.T) {
+	defer resetState()
+
+	_, err := service.GetOrCreateDirectChat(context.Background(), mock.BadUserId, 1)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
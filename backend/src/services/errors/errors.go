@@ -7,8 +7,25 @@ var (
 	UserIdNotFound       = errors.New("user-id-not-found")
 	UserAlreadyInMeeting = errors.New("user-already-in-meeting")
 	UserNotInMeeting     = errors.New("user-not-in-meeting")
+	RequestChatExists    = errors.New("meeting-request-chat-already-exists")
+	RequestNotFound      = errors.New("participation-request-not-found")
 	MeetingIdNotFound    = errors.New("meeting-id-not-found")
 	ChatIdNotFound       = errors.New("chat-id-not-found")
+	ChatArchived         = errors.New("chat-archived")
+	ChatReopenForbidden  = errors.New("chat-reopen-forbidden")
+	DirectChatNotFound   = errors.New("direct-chat-not-found")
+	DirectMessagesDenied = errors.New("direct-messages-denied")
+	ChatMessageNotFound  = errors.New("chat-message-not-found")
+	MessageNotFound      = errors.New("message-not-found")
+	ReplyMessageNotFound = errors.New("reply-message-not-found")
+	AttachmentNotFound   = errors.New("attachment-not-found")
+	AttachmentTooLarge   = errors.New("attachment-too-large")
+	AttachmentNotAllowed = errors.New("attachment-type-not-allowed")
+	MessageEditForbidden = errors.New("message-edit-forbidden")
+	ChatExportForbidden  = errors.New("chat-export-forbidden")
+	MessagePinForbidden  = errors.New("message-pin-forbidden")
+	AnnouncementDenied   = errors.New("announcement-forbidden")
+	EditWindowExpired    = errors.New("message-edit-window-expired")
 	EmailExists          = errors.New("email-exists")
 	CredentialsNotFound  = errors.New("credentials-not-found")
 	NoAuthCookie         = errors.New("no-auth-cookie")
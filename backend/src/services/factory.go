@@ -2,54 +2,183 @@ package services
 
 import (
 	"interfaces"
+	"models"
+	"services/attachments"
 	"services/authentication"
 	"services/chat"
 	"services/chat_accessor"
+	"services/chat_transcript"
+	"services/direct_chat"
 	"services/meetings"
 	"services/meetings_accessor"
 	"services/messages"
+	"services/messages_editor"
+	"services/notifications"
 	"services/participation"
+	"services/proxies/tracing"
 	"services/proxies/validation"
+	"services/proxies/validation/plugins/content_filter"
+	"services/reactions"
+	"services/read_receipts"
+	"services/search"
 	"services/session"
 	"services/user_settings"
+	"services/users_privacy"
+	"time"
 )
 
 func Authentication(repository interfaces.CredentialsRepository) interfaces.AuthenticationService {
-	return validation.NewAuthenticationServiceProxy(authentication.New(repository))
+	var service interfaces.AuthenticationService = authentication.New(repository)
+	service = tracing.NewAuthenticationServiceProxy(service, tracing.ServiceLayer)
+	service = validation.NewAuthenticationServiceProxy(service)
+	return tracing.NewAuthenticationServiceProxy(service, tracing.ValidationLayer)
 }
 
-func Meetings(repository interfaces.Meetings) interfaces.Meetings {
-	return validation.NewMeetingsServiceProxy(meetings.New(repository))
+func Meetings(repository interfaces.Meetings, content validation.ContentChecker) interfaces.Meetings {
+	var service interfaces.Meetings = meetings.New(repository)
+	service = tracing.NewMeetingsProxy(service, tracing.ServiceLayer)
+	service = validation.NewMeetingsServiceProxy(service, content)
+	return tracing.NewMeetingsProxy(service, tracing.ValidationLayer)
 }
 
 func MeetingsAccessor(repository interfaces.MeetingsAccessorRepository) interfaces.MeetingsAccessorService {
-	return validation.NewMeetingsAccessorServiceProxy(meetings_accessor.New(repository))
+	var service interfaces.MeetingsAccessorService = meetings_accessor.New(repository)
+	service = tracing.NewMeetingsAccessorServiceProxy(service, tracing.ServiceLayer)
+	service = validation.NewMeetingsAccessorServiceProxy(service)
+	return tracing.NewMeetingsAccessorServiceProxy(service, tracing.ValidationLayer)
 }
 
-func Messages(repository interfaces.Messages) interfaces.Messages {
-	return validation.NewMessagesProxy(messages.New(repository))
+func Messages(repository interfaces.Messages, content validation.ContentChecker) interfaces.Messages {
+	var service interfaces.Messages = messages.New(repository)
+	service = tracing.NewMessagesProxy(service, tracing.ServiceLayer)
+	service = validation.NewMessagesProxy(service, content)
+	return tracing.NewMessagesProxy(service, tracing.ValidationLayer)
+}
+
+func MessagesEditor(
+	repository interfaces.MessagesEditorRepository,
+	editWindow time.Duration,
+) interfaces.MessagesEditor {
+	var service interfaces.MessagesEditor = messages_editor.New(repository, editWindow)
+	service = tracing.NewMessagesEditorProxy(service, tracing.ServiceLayer)
+	service = validation.NewMessagesEditorProxy(service)
+	return tracing.NewMessagesEditorProxy(service, tracing.ValidationLayer)
 }
 
 func Participation(
 	userSettingsRepository interfaces.UsersSettings,
 	meetingsSettingsRepository interfaces.MeetingsSettingsRepository,
+	requestChatRepository interfaces.MeetingRequestChatRepository,
 ) interfaces.ParticipationService {
-	return validation.NewParticipationServiceProxy(
-		participation.New(userSettingsRepository, meetingsSettingsRepository))
+	var service interfaces.ParticipationService = participation.New(
+		userSettingsRepository, meetingsSettingsRepository, requestChatRepository)
+	service = tracing.NewParticipationServiceProxy(service, tracing.ServiceLayer)
+	service = validation.NewParticipationServiceProxy(service)
+	return tracing.NewParticipationServiceProxy(service, tracing.ValidationLayer)
 }
 
 func Session(key string) interfaces.SessionService {
 	return validation.NewSessionServiceProxy(session.New(key))
 }
 
-func UserSettings(repository interfaces.UsersSettings) interfaces.UsersSettings {
-	return validation.NewUserSettingsServiceProxy(user_settings.New(repository))
+func UserSettings(repository interfaces.UsersSettings, content validation.ContentChecker) interfaces.UsersSettings {
+	var service interfaces.UsersSettings = user_settings.New(repository)
+	service = tracing.NewUsersSettingsProxy(service, tracing.ServiceLayer)
+	service = validation.NewUserSettingsServiceProxy(service, content)
+	return tracing.NewUsersSettingsProxy(service, tracing.ValidationLayer)
+}
+
+// flagged content is saved to moderation repository
+func ContentChecker(
+	config models.ContentFilterConfig,
+	moderation interfaces.ModerationRepository,
+) validation.ContentChecker {
+	return validation.NewContentChecker(content_filter.New(config), moderation)
+}
+
+func SetValidationRules(rules models.ValidationRules) error {
+	return validation.SetRules(rules)
 }
 
 func ChatAccessor(repository interfaces.ChatAccessor) interfaces.ChatAccessor {
-	return validation.NewChatAccessorProxy(chat_accessor.New(repository))
+	var service interfaces.ChatAccessor = chat_accessor.New(repository)
+	service = tracing.NewChatAccessorProxy(service, tracing.ServiceLayer)
+	service = validation.NewChatAccessorProxy(service)
+	return tracing.NewChatAccessorProxy(service, tracing.ValidationLayer)
+}
+
+func Chat(
+	repository interfaces.ChatRepository,
+	requestChatRepository interfaces.MeetingRequestChatRepository,
+) interfaces.Chat {
+	var service interfaces.Chat = chat.New(repository, requestChatRepository)
+	service = tracing.NewChatProxy(service, tracing.ServiceLayer)
+	service = validation.NewChatProxy(service)
+	return tracing.NewChatProxy(service, tracing.ValidationLayer)
+}
+
+func ChatTranscript(
+	adminRepository interfaces.ChatAdminRepository,
+	repository interfaces.ChatTranscriptRepository,
+) interfaces.ChatTranscript {
+	var service interfaces.ChatTranscript = chat_transcript.New(adminRepository, repository)
+	service = tracing.NewChatTranscriptProxy(service, tracing.ServiceLayer)
+	service = validation.NewChatTranscriptProxy(service)
+	return tracing.NewChatTranscriptProxy(service, tracing.ValidationLayer)
+}
+
+func DirectChat(
+	chatRepository interfaces.DirectChatRepository,
+	privacyRepository interfaces.UsersPrivacyRepository,
+) interfaces.DirectChat {
+	var service interfaces.DirectChat = direct_chat.New(chatRepository, privacyRepository)
+	service = tracing.NewDirectChatProxy(service, tracing.ServiceLayer)
+	service = validation.NewDirectChatProxy(service)
+	return tracing.NewDirectChatProxy(service, tracing.ValidationLayer)
+}
+
+func UsersPrivacy(repository interfaces.UsersPrivacyRepository) interfaces.UsersPrivacy {
+	var service interfaces.UsersPrivacy = users_privacy.New(repository)
+	service = tracing.NewUsersPrivacyProxy(service, tracing.ServiceLayer)
+	service = validation.NewUsersPrivacyProxy(service)
+	return tracing.NewUsersPrivacyProxy(service, tracing.ValidationLayer)
+}
+
+func ReadReceipts(repository interfaces.ReadReceiptsRepository) interfaces.ReadReceipts {
+	var service interfaces.ReadReceipts = read_receipts.New(repository)
+	service = tracing.NewReadReceiptsProxy(service, tracing.ServiceLayer)
+	service = validation.NewReadReceiptsProxy(service)
+	return tracing.NewReadReceiptsProxy(service, tracing.ValidationLayer)
+}
+
+func Reactions(repository interfaces.Reactions) interfaces.Reactions {
+	var service interfaces.Reactions = reactions.New(repository)
+	service = tracing.NewReactionsProxy(service, tracing.ServiceLayer)
+	service = validation.NewReactionsProxy(service)
+	return tracing.NewReactionsProxy(service, tracing.ValidationLayer)
+}
+
+func Attachments(
+	repository interfaces.AttachmentsRepository,
+	store interfaces.BlobStore,
+	limits models.AttachmentLimits,
+) interfaces.Attachments {
+	var service interfaces.Attachments = attachments.New(repository, store, limits)
+	service = tracing.NewAttachmentsProxy(service, tracing.ServiceLayer)
+	service = validation.NewAttachmentsProxy(service)
+	return tracing.NewAttachmentsProxy(service, tracing.ValidationLayer)
+}
+
+func MessagesSearch(repository interfaces.MessagesSearch) interfaces.MessagesSearch {
+	var service interfaces.MessagesSearch = search.New(repository)
+	service = tracing.NewMessagesSearchProxy(service, tracing.ServiceLayer)
+	service = validation.NewMessagesSearchProxy(service)
+	return tracing.NewMessagesSearchProxy(service, tracing.ValidationLayer)
 }
 
-func Chat(repository interfaces.ChatRepository) interfaces.Chat {
-	return validation.NewChatProxy(chat.New(repository))
+func Notifications(repository interfaces.Notifications) interfaces.Notifications {
+	var service interfaces.Notifications = notifications.New(repository)
+	service = tracing.NewNotificationsProxy(service, tracing.ServiceLayer)
+	service = validation.NewNotificationsProxy(service)
+	return tracing.NewNotificationsProxy(service, tracing.ValidationLayer)
 }
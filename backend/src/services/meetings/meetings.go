@@ -1,6 +1,7 @@
 package meetings
 
 import (
+	"context"
 	"interfaces"
 	"internal_errors"
 	"models"
@@ -15,8 +16,8 @@ func New(repository interfaces.Meetings) Service {
 	return Service{repository}
 }
 
-func (s Service) CreateMeeting(adminId uint, settings models.AllSettings) error {
-	switch s.repository.CreateMeeting(adminId, settings) {
+func (s Service) CreateMeeting(ctx context.Context, adminId uint, settings models.AllSettings) error {
+	switch s.repository.CreateMeeting(ctx, adminId, settings) {
 	case nil:
 		return nil
 	case internal_errors.UnableToFindUserById:
@@ -26,8 +27,8 @@ func (s Service) CreateMeeting(adminId uint, settings models.AllSettings) error
 	}
 }
 
-func (s Service) DeleteMeeting(meetingId uint) error {
-	switch s.repository.DeleteMeeting(meetingId) {
+func (s Service) DeleteMeeting(ctx context.Context, meetingId uint) error {
+	switch s.repository.DeleteMeeting(ctx, meetingId) {
 	case nil:
 		return nil
 	case internal_errors.UnableToFindMeetingById:
@@ -37,8 +38,8 @@ func (s Service) DeleteMeeting(meetingId uint) error {
 	}
 }
 
-func (s Service) UpdateSettings(meetingId uint, settings models.AllSettings) error {
-	switch s.repository.UpdateSettings(meetingId, settings) {
+func (s Service) UpdateSettings(ctx context.Context, meetingId uint, settings models.AllSettings) error {
+	switch s.repository.UpdateSettings(ctx, meetingId, settings) {
 	case nil:
 		return nil
 	case internal_errors.UnableToFindMeetingById:
@@ -48,8 +49,8 @@ func (s Service) UpdateSettings(meetingId uint, settings models.AllSettings) err
 	}
 }
 
-func (s Service) AddUserToMeeting(meetingId, userId uint) error {
-	switch s.repository.AddUserToMeeting(meetingId, userId) {
+func (s Service) AddUserToMeeting(ctx context.Context, meetingId, userId uint) error {
+	switch s.repository.AddUserToMeeting(ctx, meetingId, userId) {
 	case nil:
 		return nil
 	case internal_errors.UserAlreadyInMeeting:
@@ -61,8 +62,8 @@ func (s Service) AddUserToMeeting(meetingId, userId uint) error {
 	}
 }
 
-func (s Service) KickUserFromMeeting(meetingId, userId uint) error {
-	switch s.repository.KickUserFromMeeting(meetingId, userId) {
+func (s Service) KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error {
+	switch s.repository.KickUserFromMeeting(ctx, meetingId, userId) {
 	case nil:
 		return nil
 	case internal_errors.UserNotInMeeting:
@@ -1,6 +1,7 @@
 package meetings
 
 import (
+	"context"
 	repositoriesMock "mock/repositories"
 	mock "mock/services"
 	"services/errors"
@@ -13,7 +14,7 @@ var service = New(&mock.MeetingsMockRepository)
 func TestService_DeleteMeetingSuccess(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.DeleteMeeting(1)
+	err := service.DeleteMeeting(context.Background(), 1)
 	utils.AssertNil(err, t)
 	_, found := mock.MeetingsMockRepository.Meetings[0]
 	utils.AssertTrue(!found, t)
@@ -22,21 +23,21 @@ func TestService_DeleteMeetingSuccess(t *testing.T) {
 func TestService_DeleteMeetingNotFoundError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.DeleteMeeting(repositoriesMock.GetNotExistsMeetingId())
+	err := service.DeleteMeeting(context.Background(), repositoriesMock.GetNotExistsMeetingId())
 	utils.AssertErrorsEqual(errors.MeetingIdNotFound, err, t)
 }
 
 func TestService_DeleteMeetingInternalError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.DeleteMeeting(mock.BadMeetingId)
+	err := service.DeleteMeeting(context.Background(), mock.BadMeetingId)
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestService_CreateMeetingSuccess(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.CreateMeeting(1, mock.NewMeetingSettings)
+	err := service.CreateMeeting(context.Background(), 1, mock.NewMeetingSettings)
 	utils.AssertNil(err, t)
 	meeting := mock.MeetingsMockRepository.Meetings[2]
 	utils.AssertEqual(mock.NewMeetingSettings.PublicPlace, meeting.AllSettings.PublicPlace, t)
@@ -45,21 +46,21 @@ func TestService_CreateMeetingSuccess(t *testing.T) {
 func TestService_CreateMeetingUserIdNotFoundError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.CreateMeeting(repositoriesMock.GetNotExistsUserId(), mock.NewMeetingSettings)
+	err := service.CreateMeeting(context.Background(), repositoriesMock.GetNotExistsUserId(), mock.NewMeetingSettings)
 	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
 }
 
 func TestService_CreateMeetingInternalError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.CreateMeeting(mock.BadUserId, mock.NewMeetingSettings)
+	err := service.CreateMeeting(context.Background(), mock.BadUserId, mock.NewMeetingSettings)
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestService_UpdateSettingsSuccess(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.UpdateSettings(1, mock.NewMeetingSettings)
+	err := service.UpdateSettings(context.Background(), 1, mock.NewMeetingSettings)
 	utils.AssertNil(err, t)
 	meeting := mock.MeetingsMockRepository.Meetings[1]
 	utils.AssertEqual(mock.NewMeetingSettings.PublicPlace, meeting.AllSettings.PublicPlace, t)
@@ -68,21 +69,22 @@ func TestService_UpdateSettingsSuccess(t *testing.T) {
 func TestService_UpdateSettingsMeetingNotFoundError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.UpdateSettings(repositoriesMock.GetNotExistsMeetingId(), mock.NewMeetingSettings)
+	err := service.UpdateSettings(
+		context.Background(), repositoriesMock.GetNotExistsMeetingId(), mock.NewMeetingSettings)
 	utils.AssertErrorsEqual(errors.MeetingIdNotFound, err, t)
 }
 
 func TestService_UpdateSettingsInternalError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.UpdateSettings(mock.BadMeetingId, mock.NewMeetingSettings)
+	err := service.UpdateSettings(context.Background(), mock.BadMeetingId, mock.NewMeetingSettings)
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestService_AddUserToMeetingSuccess(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.AddUserToMeeting(1, mock.UserIdThatNotInFirstMeeting)
+	err := service.AddUserToMeeting(context.Background(), 1, mock.UserIdThatNotInFirstMeeting)
 	utils.AssertNil(err, t)
 	utils.AssertTrue(mock.HasUser(mock.MeetingsMockRepository.MeetingsUsers[1], mock.UserIdThatNotInFirstMeeting), t)
 }
@@ -90,28 +92,28 @@ func TestService_AddUserToMeetingSuccess(t *testing.T) {
 func TestService_AddUserToMeetingAlreadyInMeetingError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.AddUserToMeeting(1, 1)
+	err := service.AddUserToMeeting(context.Background(), 1, 1)
 	utils.AssertErrorsEqual(errors.UserAlreadyInMeeting, err, t)
 }
 
 func TestService_AddUserToMeetingNotFound(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.AddUserToMeeting(repositoriesMock.GetNotExistsMeetingId(), 1)
+	err := service.AddUserToMeeting(context.Background(), repositoriesMock.GetNotExistsMeetingId(), 1)
 	utils.AssertErrorsEqual(errors.MeetingIdNotFound, err, t)
 }
 
 func TestService_AddUserToMeetingInternalError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.AddUserToMeeting(mock.BadMeetingId, 1)
+	err := service.AddUserToMeeting(context.Background(), mock.BadMeetingId, 1)
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestService_KickUserFromMeetingSuccess(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.KickUserFromMeeting(1, 1)
+	err := service.KickUserFromMeeting(context.Background(), 1, 1)
 	utils.AssertNil(err, t)
 	utils.AssertFalse(mock.HasUser(mock.MeetingsMockRepository.MeetingsUsers[1], 1), t)
 }
@@ -119,13 +121,13 @@ func TestService_KickUserFromMeetingSuccess(t *testing.T) {
 func TestService_KickUserFromMeetingUserNotInMeetingError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.KickUserFromMeeting(1, mock.UserIdThatNotInFirstMeeting)
+	err := service.KickUserFromMeeting(context.Background(), 1, mock.UserIdThatNotInFirstMeeting)
 	utils.AssertErrorsEqual(errors.UserNotInMeeting, err, t)
 }
 
 func TestService_KickUserFromMeetingInternalError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	err := service.KickUserFromMeeting(mock.BadMeetingId, 1)
+	err := service.KickUserFromMeeting(context.Background(), mock.BadMeetingId, 1)
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
@@ -1,6 +1,7 @@
 package meetings_accessor
 
 import (
+	"context"
 	"interfaces"
 	"internal_errors"
 	"models"
@@ -21,8 +22,8 @@ func New(repository interfaces.MeetingsAccessorRepository) Service {
 	return Service{repository}
 }
 
-func (s Service) GetFullMeetingInfo(meetingId uint) (models.PrivateMeeting, error) {
-	meeting, err := s.repository.GetFullMeetingInfo(meetingId)
+func (s Service) GetFullMeetingInfo(ctx context.Context, meetingId uint) (models.PrivateMeeting, error) {
+	meeting, err := s.repository.GetFullMeetingInfo(ctx, meetingId)
 
 	switch err {
 	case nil:
@@ -34,8 +35,8 @@ func (s Service) GetFullMeetingInfo(meetingId uint) (models.PrivateMeeting, erro
 	}
 }
 
-func (s Service) GetPublicMeetings() ([]models.PublicMeeting, error) {
-	meetings, err := s.repository.GetPublicMeetings()
+func (s Service) GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error) {
+	meetings, err := s.repository.GetPublicMeetings(ctx)
 
 	switch err {
 	case nil:
@@ -45,8 +46,8 @@ func (s Service) GetPublicMeetings() ([]models.PublicMeeting, error) {
 	}
 }
 
-func (s Service) GetExtendedMeetings(userId uint) ([]models.ExtendedMeeting, error) {
-	meetings, err := s.repository.GetExtendedMeetings(models.UserMeetingStatusesData{
+func (s Service) GetExtendedMeetings(ctx context.Context, userId uint) ([]models.ExtendedMeeting, error) {
+	meetings, err := s.repository.GetExtendedMeetings(ctx, models.UserMeetingStatusesData{
 		UserId:     userId,
 		Invited:    invitedStatus,
 		NotInvited: notInvitedStatus,
@@ -1,6 +1,7 @@
 package meetings_accessor
 
 import (
+	"context"
 	repositoriesMock "mock/repositories"
 	mock "mock/services"
 	"models"
@@ -14,9 +15,9 @@ var service = New(&mock.MeetingsMockRepository)
 func TestService_GetPublicMeetingsSuccess(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	meetings, err := service.GetPublicMeetings()
+	meetings, err := service.GetPublicMeetings(context.Background())
 	utils.AssertNil(err, t)
-	expectedMeetings, _ := mock.MeetingsMockRepository.GetPublicMeetings()
+	expectedMeetings, _ := mock.MeetingsMockRepository.GetPublicMeetings(context.Background())
 	utils.AssertEqual(expectedMeetings[0].PublicPlace.Latitude, meetings[0].PublicPlace.Latitude, t)
 	utils.AssertEqual(expectedMeetings[0].PublicPlace.Longitude, meetings[0].PublicPlace.Longitude, t)
 }
@@ -25,20 +26,21 @@ func TestService_GetPublicMeetingsInternalError(t *testing.T) {
 	mock.MeetingsMockRepository.Meetings = nil
 	defer mock.MeetingsMockRepository.ResetState()
 
-	_, err := service.GetPublicMeetings()
+	_, err := service.GetPublicMeetings(context.Background())
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestService_GetExtendedMeetingsSuccess(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	meetings, err := service.GetExtendedMeetings(1)
+	meetings, err := service.GetExtendedMeetings(context.Background(), 1)
 	utils.AssertNil(err, t)
-	expectedMeetings, _ := mock.MeetingsMockRepository.GetExtendedMeetings(models.UserMeetingStatusesData{
-		UserId:     1,
-		Invited:    "",
-		NotInvited: "",
-	})
+	expectedMeetings, _ := mock.MeetingsMockRepository.GetExtendedMeetings(
+		context.Background(), models.UserMeetingStatusesData{
+			UserId:     1,
+			Invited:    "",
+			NotInvited: "",
+		})
 	utils.AssertEqual(expectedMeetings[0].PublicPlace.Latitude, meetings[0].PublicPlace.Latitude, t)
 	utils.AssertEqual(expectedMeetings[0].PublicPlace.Longitude, meetings[0].PublicPlace.Longitude, t)
 }
@@ -46,29 +48,29 @@ func TestService_GetExtendedMeetingsSuccess(t *testing.T) {
 func TestService_GetExtendedMeetingsInternalError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	_, err := service.GetExtendedMeetings(mock.BadUserId)
+	_, err := service.GetExtendedMeetings(context.Background(), mock.BadUserId)
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestService_GetFullMeetingInfoSuccess(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	meeting, err := service.GetFullMeetingInfo(1)
+	meeting, err := service.GetFullMeetingInfo(context.Background(), 1)
 	utils.AssertNil(err, t)
-	expectedMeeting, _ := mock.MeetingsMockRepository.GetFullMeetingInfo(1)
+	expectedMeeting, _ := mock.MeetingsMockRepository.GetFullMeetingInfo(context.Background(), 1)
 	utils.AssertEqual(expectedMeeting.DefaultMeeting, meeting.DefaultMeeting, t)
 }
 
 func TestService_GetFullMeetingInfoMeetingNotFoundError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	_, err := service.GetFullMeetingInfo(repositoriesMock.GetNotExistsMeetingId())
+	_, err := service.GetFullMeetingInfo(context.Background(), repositoriesMock.GetNotExistsMeetingId())
 	utils.AssertErrorsEqual(errors.MeetingIdNotFound, err, t)
 }
 
 func TestService_GetFullMeetingInfoInternalError(t *testing.T) {
 	defer mock.MeetingsMockRepository.ResetState()
 
-	_, err := service.GetFullMeetingInfo(mock.BadMeetingId)
+	_, err := service.GetFullMeetingInfo(context.Background(), mock.BadMeetingId)
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
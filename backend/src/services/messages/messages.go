@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	"interfaces"
 	"internal_errors"
 	"models"
@@ -15,23 +16,33 @@ func New(repository interfaces.Messages) Service {
 	return Service{repository}
 }
 
-func (s Service) Save(message models.Message) error {
-	err := s.repository.Save(message)
+func (s Service) Save(ctx context.Context, message models.Message) (models.Message, error) {
+	savedMessage, err := s.repository.Save(ctx, message)
 
 	switch {
 	case err == nil:
-		return nil
+		return savedMessage, nil
 	case err == internal_errors.UnableToFindChatById:
-		return errors.ChatIdNotFound
+		return models.Message{}, errors.ChatIdNotFound
+	case err == internal_errors.ChatIsArchived:
+		return models.Message{}, errors.ChatArchived
+	case err == internal_errors.AnnouncementNotAllowed:
+		return models.Message{}, errors.AnnouncementDenied
+	case err == internal_errors.DirectMessagesNotAllowed:
+		return models.Message{}, errors.DirectMessagesDenied
 	case err == internal_errors.UnableToFindUserById:
-		return errors.UserIdNotFound
+		return models.Message{}, errors.UserIdNotFound
+	case err == internal_errors.UnableToFindReplyMessage:
+		return models.Message{}, errors.ReplyMessageNotFound
+	case err == internal_errors.UnableToFindAttachment:
+		return models.Message{}, errors.AttachmentNotFound
 	default:
-		return errors.InternalError
+		return models.Message{}, errors.InternalError
 	}
 }
 
-func (s Service) GetLastMessages(chatId, count uint) ([]models.Message, error) {
-	messages, err := s.repository.GetLastMessages(chatId, count)
+func (s Service) GetLastMessages(ctx context.Context, chatId, count, viewerId uint) ([]models.Message, error) {
+	messages, err := s.repository.GetLastMessages(ctx, chatId, count, viewerId)
 
 	switch err {
 	case nil:
@@ -41,8 +52,10 @@ func (s Service) GetLastMessages(chatId, count uint) ([]models.Message, error) {
 	}
 }
 
-func (s Service) GetLastMessagesAfter(chatId, messageId, count uint) ([]models.Message, error) {
-	messages, err := s.repository.GetLastMessagesAfter(chatId, messageId, count)
+func (s Service) GetLastMessagesAfter(
+	ctx context.Context, chatId, messageId, count, viewerId uint,
+) ([]models.Message, error) {
+	messages, err := s.repository.GetLastMessagesAfter(ctx, chatId, messageId, count, viewerId)
 
 	switch err {
 	case nil:
@@ -51,3 +64,16 @@ func (s Service) GetLastMessagesAfter(chatId, messageId, count uint) ([]models.M
 		return nil, errors.InternalError
 	}
 }
+
+func (s Service) GetThread(ctx context.Context, rootId, afterId, count, viewerId uint) (models.Thread, error) {
+	thread, err := s.repository.GetThread(ctx, rootId, afterId, count, viewerId)
+
+	switch err {
+	case nil:
+		return thread, nil
+	case internal_errors.UnableToFindMessageById:
+		return models.Thread{}, errors.MessageNotFound
+	default:
+		return models.Thread{}, errors.InternalError
+	}
+}
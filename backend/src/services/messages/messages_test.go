@@ -1,6 +1,7 @@
 package messages
 
 import (
+	"context"
 	repositoriesMock "mock/repositories"
 	mock "mock/services"
 	"services/errors"
@@ -13,15 +14,16 @@ var service = New(&mock.MessagesMockRepository)
 func TestService_SendSuccess(t *testing.T) {
 	defer mock.MessagesMockRepository.ResetState()
 
-	err := service.Save(repositoriesMock.GetAllMessages()[0])
+	message, err := service.Save(context.Background(), repositoriesMock.GetAllMessages()[0])
 
 	utils.AssertNil(err, t)
+	utils.AssertNotEqual(uint(0), message.Id, t)
 }
 
 func TestService_SendChatNotFound(t *testing.T) {
 	defer mock.MessagesMockRepository.ResetState()
 
-	err := service.Save(repositoriesMock.GetMessageWithNotExistsChatId())
+	_, err := service.Save(context.Background(), repositoriesMock.GetMessageWithNotExistsChatId())
 
 	utils.AssertErrorsEqual(errors.ChatIdNotFound, err, t)
 }
@@ -29,7 +31,7 @@ func TestService_SendChatNotFound(t *testing.T) {
 func TestService_SendUserIdNotFound(t *testing.T) {
 	defer mock.MessagesMockRepository.ResetState()
 
-	err := service.Save(repositoriesMock.GetMessageWithNotExistsUserId())
+	_, err := service.Save(context.Background(), repositoriesMock.GetMessageWithNotExistsUserId())
 
 	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
 }
@@ -37,7 +39,7 @@ func TestService_SendUserIdNotFound(t *testing.T) {
 func TestService_SendInternalError(t *testing.T) {
 	defer mock.MessagesMockRepository.ResetState()
 
-	err := service.Save(mock.GetMessageWithBadChatId())
+	_, err := service.Save(context.Background(), mock.GetMessageWithBadChatId())
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
@@ -46,7 +48,7 @@ func TestService_GetLastMessagesSuccess(t *testing.T) {
 	defer mock.MessagesMockRepository.ResetState()
 
 	messagesCount := 2
-	messages, err := service.GetLastMessages(1, uint(messagesCount))
+	messages, err := service.GetLastMessages(context.Background(), 1, uint(messagesCount), 1)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(len(messages) <= messagesCount && len(messages) > 0, t)
@@ -55,7 +57,7 @@ func TestService_GetLastMessagesSuccess(t *testing.T) {
 func TestService_GetLastMessagesInternalError(t *testing.T) {
 	defer mock.MessagesMockRepository.ResetState()
 
-	_, err := service.GetLastMessages(mock.BadChatId, 1)
+	_, err := service.GetLastMessages(context.Background(), mock.BadChatId, 1, 1)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
@@ -64,7 +66,7 @@ func TestService_GetLastMessagesAfterSuccess(t *testing.T) {
 	defer mock.MessagesMockRepository.ResetState()
 
 	messagesCount := 2
-	messages, err := service.GetLastMessagesAfter(1, 1, uint(messagesCount))
+	messages, err := service.GetLastMessagesAfter(context.Background(), 1, 1, uint(messagesCount), 1)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(len(messages) <= messagesCount && len(messages) > 0, t)
@@ -73,7 +75,95 @@ func TestService_GetLastMessagesAfterSuccess(t *testing.T) {
 func TestService_GetLastMessagesAfterInternalError(t *testing.T) {
 	defer mock.MessagesMockRepository.ResetState()
 
-	_, err := service.GetLastMessagesAfter(mock.BadChatId, 1, 1)
+	_, err := service.GetLastMessagesAfter(context.Background(), mock.BadChatId, 1, 1, 1)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
+
+func TestService_SendReplySuccess(t *testing.T) {
+	defer mock.MessagesMockRepository.ResetState()
+
+	_, err := service.Save(context.Background(), repositoriesMock.GetReplyMessage())
+
+	utils.AssertNil(err, t)
+}
+
+func TestService_SendReplyToMessageFromAnotherChat(t *testing.T) {
+	defer mock.MessagesMockRepository.ResetState()
+
+	_, err := service.Save(context.Background(), repositoriesMock.GetReplyToMessageFromAnotherChat())
+
+	utils.AssertErrorsEqual(errors.ReplyMessageNotFound, err, t)
+}
+
+func TestService_GetThreadSuccess(t *testing.T) {
+	root := repositoriesMock.GetAllMessages()[0]
+	thread, err := service.GetThread(context.Background(), root.Id, 0, 10, 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(root.Id, thread.Root.Id, t)
+}
+
+func TestService_GetThreadNotFound(t *testing.T) {
+	_, err := service.GetThread(context.Background(), repositoriesMock.GetNotExistsMessageId(), 0, 10, 1)
+
+	utils.AssertErrorsEqual(errors.MessageNotFound, err, t)
+}
+
+func TestService_GetThreadInternalError(t *testing.T) {
+	_, err := service.GetThread(context.Background(), mock.BadMessageId, 0, 10, 1)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_SendWithNotExistsAttachment(t *testing.T) {
+	defer mock.MessagesMockRepository.ResetState()
+
+	message := repositoriesMock.GetAllMessages()[0]
+	message.AttachmentIds = []uint{mock.NotExistsAttachmentId}
+	_, err := service.Save(context.Background(), message)
+
+	utils.AssertErrorsEqual(errors.AttachmentNotFound, err, t)
+}
+
+func TestService_SendToArchivedChat(t *testing.T) {
+	defer mock.MessagesMockRepository.ResetState()
+
+	message := repositoriesMock.GetAllMessages()[0]
+	message.ChatId = mock.ArchivedChatId
+	_, err := service.Save(context.Background(), message)
+
+	utils.AssertErrorsEqual(errors.ChatArchived, err, t)
+}
+
+func TestService_SendToDeniedDirectChat(t *testing.T) {
+	defer mock.MessagesMockRepository.ResetState()
+
+	message := repositoriesMock.GetAllMessages()[0]
+	message.ChatId = mock.DeniedDirectChatId
+	_, err := service.Save(context.Background(), message)
+
+	utils.AssertErrorsEqual(errors.DirectMessagesDenied, err, t)
+}
+
+func TestService_SendAnnouncementByMeetingAdmin(t *testing.T) {
+	defer mock.MessagesMockRepository.ResetState()
+
+	message := repositoriesMock.GetAllMessages()[0]
+	message.Announcement = true
+	message.SenderId = repositoriesMock.GetChatMeetingAdminId(message.ChatId)
+	_, err := service.Save(context.Background(), message)
+
+	utils.AssertNil(err, t)
+}
+
+func TestService_SendAnnouncementForbidden(t *testing.T) {
+	defer mock.MessagesMockRepository.ResetState()
+
+	message := repositoriesMock.GetAllMessages()[0]
+	message.Announcement = true
+	message.SenderId = repositoriesMock.GetChatMeetingAdminId(message.ChatId) + 1
+	_, err := service.Save(context.Background(), message)
+
+	utils.AssertErrorsEqual(errors.AnnouncementDenied, err, t)
+}
@@ -0,0 +1,129 @@
+package messages_editor
+
+import (
+	"context"
+	"interfaces"
+	"internal_errors"
+	"models"
+	"services/errors"
+	"time"
+)
+
+type Service struct {
+	repository interfaces.MessagesEditorRepository
+	// author can change message only during this time after sending
+	editWindow time.Duration
+}
+
+func New(repository interfaces.MessagesEditorRepository, editWindow time.Duration) Service {
+	return Service{repository, editWindow}
+}
+
+func (s Service) EditMessage(ctx context.Context, request models.EditMessageRequest) (models.Message, error) {
+	message, err := s.getManagedMessage(ctx, request.MessageId)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	if message.SenderId != request.UserId {
+		return models.Message{}, errors.MessageEditForbidden
+	} else if !s.inEditWindow(message) {
+		return models.Message{}, errors.EditWindowExpired
+	}
+
+	editedMessage, err := s.repository.EditMessage(ctx, request.MessageId, request.Text)
+	return editedMessage, s.mapChangeError(err)
+}
+
+// meeting admin can delete any message of meeting chat at any time
+func (s Service) DeleteMessage(ctx context.Context, request models.DeleteMessageRequest) (models.Message, error) {
+	message, err := s.getManagedMessage(ctx, request.MessageId)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	isAdmin := message.MeetingAdminId != 0 && message.MeetingAdminId == request.UserId
+	if !isAdmin && message.SenderId != request.UserId {
+		return models.Message{}, errors.MessageEditForbidden
+	} else if !isAdmin && !s.inEditWindow(message) {
+		return models.Message{}, errors.EditWindowExpired
+	}
+
+	deletedMessage, err := s.repository.DeleteMessage(ctx, request.MessageId)
+	return deletedMessage, s.mapChangeError(err)
+}
+
+func (s Service) GetMessageEdits(ctx context.Context, messageId uint) ([]models.MessageEdit, error) {
+	edits, err := s.repository.GetMessageEdits(ctx, messageId)
+	if err != nil {
+		return nil, errors.InternalError
+	}
+
+	return edits, nil
+}
+
+func (s Service) PinMessage(ctx context.Context, request models.PinMessageRequest) (models.Message, error) {
+	return s.setMessagePinned(ctx, request, true)
+}
+
+func (s Service) UnpinMessage(ctx context.Context, request models.PinMessageRequest) (models.Message, error) {
+	return s.setMessagePinned(ctx, request, false)
+}
+
+// only meeting admin can pin messages of meeting chat
+func (s Service) setMessagePinned(
+	ctx context.Context, request models.PinMessageRequest, pinned bool,
+) (models.Message, error) {
+	message, err := s.getManagedMessage(ctx, request.MessageId)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	if message.MeetingAdminId == 0 || message.MeetingAdminId != request.UserId {
+		return models.Message{}, errors.MessagePinForbidden
+	}
+
+	pinnedMessage, err := s.repository.SetMessagePinned(ctx, request.MessageId, pinned)
+	return pinnedMessage, s.mapChangeError(err)
+}
+
+func (s Service) GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error) {
+	messages, err := s.repository.GetPinnedMessages(ctx, chatId)
+	if err != nil {
+		return nil, errors.InternalError
+	}
+
+	return messages, nil
+}
+
+// deleted message and messages of archived chat can not be changed anymore
+func (s Service) getManagedMessage(ctx context.Context, messageId uint) (models.ManagedMessage, error) {
+	message, err := s.repository.GetManagedMessage(ctx, messageId)
+	switch {
+	case err == internal_errors.UnableToFindMessageById:
+		return models.ManagedMessage{}, errors.MessageNotFound
+	case err != nil:
+		return models.ManagedMessage{}, errors.InternalError
+	case message.DeletedAt != nil:
+		return models.ManagedMessage{}, errors.MessageNotFound
+	case message.ChatArchived:
+		return models.ManagedMessage{}, errors.ChatArchived
+	default:
+		return message, nil
+	}
+}
+
+func (s Service) inEditWindow(message models.ManagedMessage) bool {
+	return time.Duration(message.AgeSeconds)*time.Second <= s.editWindow
+}
+
+func (s Service) mapChangeError(err error) error {
+	switch err {
+	case nil:
+		return nil
+	case internal_errors.UnableToFindMessageById:
+		return errors.MessageNotFound
+	default:
+		return errors.InternalError
+	}
+}
@@ -0,0 +1,218 @@
+package messages_editor
+
+import (
+	"context"
+	repositoriesMock "mock/repositories"
+	mock "mock/services"
+	"models"
+	"services/errors"
+	"testing"
+	"utils"
+)
+
+var service = New(&mock.MessagesEditorRepository, mock.EditWindow)
+
+func getFirstMessage() models.Message {
+	return repositoriesMock.GetAllMessages()[0]
+}
+
+func TestService_EditMessageSuccess(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message := getFirstMessage()
+	editedMessage, err := service.EditMessage(context.Background(), models.EditMessageRequest{
+		MessageId: message.Id, UserId: message.SenderId, Text: "edited",
+	})
+	edits, _ := service.GetMessageEdits(context.Background(), message.Id)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual("edited", editedMessage.Text, t)
+	utils.AssertNotNil(editedMessage.EditedAt, t)
+	utils.AssertEqual(1, len(edits), t)
+	utils.AssertEqual(message.Text, edits[0].Text, t)
+}
+
+func TestService_EditMessageNotAuthor(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message := getFirstMessage()
+	_, err := service.EditMessage(context.Background(), models.EditMessageRequest{
+		MessageId: message.Id, UserId: message.SenderId + 1, Text: "edited",
+	})
+
+	utils.AssertErrorsEqual(errors.MessageEditForbidden, err, t)
+}
+
+func TestService_EditMessageWindowExpired(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message, _ := mock.MessagesEditorRepository.GetManagedMessage(context.Background(), mock.ExpiredMessageId)
+	_, err := service.EditMessage(context.Background(), models.EditMessageRequest{
+		MessageId: message.Id, UserId: message.SenderId, Text: "edited",
+	})
+
+	utils.AssertErrorsEqual(errors.EditWindowExpired, err, t)
+}
+
+func TestService_EditMessageNotFound(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	_, err := service.EditMessage(context.Background(), models.EditMessageRequest{
+		MessageId: repositoriesMock.GetNotExistsMessageId(), UserId: 1, Text: "edited",
+	})
+
+	utils.AssertErrorsEqual(errors.MessageNotFound, err, t)
+}
+
+func TestService_EditDeletedMessage(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message := getFirstMessage()
+	_, _ = service.DeleteMessage(
+		context.Background(), models.DeleteMessageRequest{MessageId: message.Id, UserId: message.SenderId})
+	_, err := service.EditMessage(context.Background(), models.EditMessageRequest{
+		MessageId: message.Id, UserId: message.SenderId, Text: "edited",
+	})
+
+	utils.AssertErrorsEqual(errors.MessageNotFound, err, t)
+}
+
+func TestService_EditMessageInternalError(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	_, err := service.EditMessage(
+		context.Background(), models.EditMessageRequest{MessageId: mock.BadMessageId, UserId: 1, Text: "edited"})
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_DeleteMessageByAuthor(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message := getFirstMessage()
+	deletedMessage, err := service.DeleteMessage(context.Background(), models.DeleteMessageRequest{
+		MessageId: message.Id, UserId: message.SenderId,
+	})
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual("", deletedMessage.Text, t)
+	utils.AssertNotNil(deletedMessage.DeletedAt, t)
+}
+
+func TestService_DeleteMessageByMeetingAdmin(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message, _ := mock.MessagesEditorRepository.GetManagedMessage(context.Background(), mock.ExpiredMessageId)
+	_, err := service.DeleteMessage(context.Background(), models.DeleteMessageRequest{
+		MessageId: message.Id, UserId: message.MeetingAdminId,
+	})
+
+	utils.AssertNil(err, t)
+}
+
+func TestService_DeleteMessageWindowExpired(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message, _ := mock.MessagesEditorRepository.GetManagedMessage(context.Background(), mock.ExpiredMessageId)
+	_, err := service.DeleteMessage(context.Background(), models.DeleteMessageRequest{
+		MessageId: message.Id, UserId: message.SenderId,
+	})
+
+	utils.AssertErrorsEqual(errors.EditWindowExpired, err, t)
+}
+
+func TestService_DeleteMessageForbidden(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message, _ := mock.MessagesEditorRepository.GetManagedMessage(context.Background(), mock.ExpiredMessageId)
+	_, err := service.DeleteMessage(context.Background(), models.DeleteMessageRequest{
+		MessageId: message.Id, UserId: repositoriesMock.GetNotExistsUserId(),
+	})
+
+	utils.AssertErrorsEqual(errors.MessageEditForbidden, err, t)
+}
+
+func TestService_GetMessageEditsInternalError(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	_, err := service.GetMessageEdits(context.Background(), mock.BadMessageId)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_EditMessageOfArchivedChat(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	_, err := service.EditMessage(context.Background(), models.EditMessageRequest{
+		MessageId: mock.ArchivedChatMessageId, UserId: 1, Text: "edited",
+	})
+
+	utils.AssertErrorsEqual(errors.ChatArchived, err, t)
+}
+
+func TestService_DeleteMessageOfArchivedChat(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	_, err := service.DeleteMessage(
+		context.Background(), models.DeleteMessageRequest{MessageId: mock.ArchivedChatMessageId, UserId: 1})
+
+	utils.AssertErrorsEqual(errors.ChatArchived, err, t)
+}
+
+func TestService_PinMessageByMeetingAdmin(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message, _ := mock.MessagesEditorRepository.GetManagedMessage(context.Background(), getFirstMessage().Id)
+	pinnedMessage, err := service.PinMessage(context.Background(), models.PinMessageRequest{
+		MessageId: message.Id, UserId: message.MeetingAdminId,
+	})
+	pinnedMessages, _ := service.GetPinnedMessages(context.Background(), message.ChatId)
+
+	utils.AssertNil(err, t)
+	utils.AssertNotNil(pinnedMessage.PinnedAt, t)
+	utils.AssertEqual(1, len(pinnedMessages), t)
+	utils.AssertEqual(message.Id, pinnedMessages[0].Id, t)
+}
+
+func TestService_UnpinMessage(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message, _ := mock.MessagesEditorRepository.GetManagedMessage(context.Background(), getFirstMessage().Id)
+	request := models.PinMessageRequest{MessageId: message.Id, UserId: message.MeetingAdminId}
+	_, _ = service.PinMessage(context.Background(), request)
+	unpinnedMessage, err := service.UnpinMessage(context.Background(), request)
+	pinnedMessages, _ := service.GetPinnedMessages(context.Background(), message.ChatId)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(unpinnedMessage.PinnedAt == nil, t)
+	utils.AssertEqual(0, len(pinnedMessages), t)
+}
+
+func TestService_PinMessageForbidden(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message, _ := mock.MessagesEditorRepository.GetManagedMessage(context.Background(), getFirstMessage().Id)
+	_, err := service.PinMessage(context.Background(), models.PinMessageRequest{
+		MessageId: message.Id, UserId: repositoriesMock.GetNotExistsUserId(),
+	})
+
+	utils.AssertErrorsEqual(errors.MessagePinForbidden, err, t)
+}
+
+func TestService_PinDeletedMessage(t *testing.T) {
+	defer mock.MessagesEditorRepository.ResetState()
+
+	message, _ := mock.MessagesEditorRepository.GetManagedMessage(context.Background(), getFirstMessage().Id)
+	_, _ = mock.MessagesEditorRepository.DeleteMessage(context.Background(), message.Id)
+	_, err := service.PinMessage(context.Background(), models.PinMessageRequest{
+		MessageId: message.Id, UserId: message.MeetingAdminId,
+	})
+
+	utils.AssertErrorsEqual(errors.MessageNotFound, err, t)
+}
+
+func TestService_GetPinnedMessagesInternalError(t *testing.T) {
+	_, err := service.GetPinnedMessages(context.Background(), mock.BadChatId)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
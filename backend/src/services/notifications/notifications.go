@@ -0,0 +1,25 @@
+package notifications
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"services/errors"
+)
+
+type Service struct {
+	repository interfaces.Notifications
+}
+
+func New(repository interfaces.Notifications) Service {
+	return Service{repository}
+}
+
+func (s Service) GetNotifications(ctx context.Context, userId, count uint) ([]models.Notification, error) {
+	notifications, err := s.repository.GetNotifications(ctx, userId, count)
+	if err != nil {
+		return nil, errors.InternalError
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,24 @@
+package notifications
+
+import (
+	"context"
+	mock "mock/services"
+	"services/errors"
+	"testing"
+	"utils"
+)
+
+var service = New(mock.NotificationsRepository)
+
+func TestService_GetNotificationsSuccess(t *testing.T) {
+	notifications, err := service.GetNotifications(context.Background(), 2, 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(1, len(notifications), t)
+}
+
+func TestService_GetNotificationsInternalError(t *testing.T) {
+	_, err := service.GetNotifications(context.Background(), mock.BadUserId, 1)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
@@ -1,6 +1,7 @@
 package participation
 
 import (
+	"context"
 	"fmt"
 	"interfaces"
 	"internal_errors"
@@ -20,22 +21,31 @@ const (
 type Service struct {
 	userSettingsRepository     interfaces.UsersSettings
 	meetingsSettingsRepository interfaces.MeetingsSettingsRepository
+	requestChatRepository      interfaces.MeetingRequestChatRepository
 }
 
 func New(
 	userSettingsRepository interfaces.UsersSettings,
 	meetingsSettingsRepository interfaces.MeetingsSettingsRepository,
+	requestChatRepository interfaces.MeetingRequestChatRepository,
 ) Service {
-	return Service{userSettingsRepository, meetingsSettingsRepository}
+	return Service{userSettingsRepository, meetingsSettingsRepository, requestChatRepository}
 }
 
-func (s Service) HandleParticipationRequest(request models.ParticipationRequest) (models.RejectInfo, error) {
-	userSettings, meetingSettings, err := s.getUserAndMeetingSettings(request)
+func (s Service) HandleParticipationRequest(
+	ctx context.Context, request models.ParticipationRequest,
+) (models.RejectInfo, error) {
+	userSettings, meetingSettings, err := s.getUserAndMeetingSettings(ctx, request)
 	if err != nil {
 		return models.RejectInfo{}, err
 	}
 
-	hasNearMeeting, err := s.hasNearMeeting(request, meetingSettings)
+	hasNearMeeting, err := s.hasNearMeeting(ctx, request, meetingSettings)
+	if err != nil {
+		return models.RejectInfo{}, err
+	}
+
+	err = s.openRequestChat(ctx, request)
 	if err != nil {
 		return models.RejectInfo{}, err
 	}
@@ -47,15 +57,43 @@ func (s Service) HandleParticipationRequest(request models.ParticipationRequest)
 	}, nil
 }
 
+// accepted request is decided by adding user to meeting, declined one only closes request chat
+func (s Service) DeclineParticipationRequest(ctx context.Context, meetingId, userId uint) error {
+	switch s.requestChatRepository.CloseMeetingRequestChat(ctx, meetingId, userId) {
+	case nil:
+		return nil
+	case internal_errors.UnableToFindMeetingRequestChat:
+		return errors.RequestNotFound
+	default:
+		return errors.InternalError
+	}
+}
+
+// reject info is only advice for meeting admin, so request chat is opened for any submitted request
+func (s Service) openRequestChat(ctx context.Context, request models.ParticipationRequest) error {
+	switch s.requestChatRepository.CreateMeetingRequestChat(ctx, request.MeetingId, request.UserId) {
+	// repeated request is discussed in already opened chat
+	case nil, internal_errors.MeetingRequestChatAlreadyExists:
+		return nil
+	case internal_errors.UnableToFindUserById:
+		return errors.UserIdNotFound
+	case internal_errors.UnableToFindMeetingById:
+		return errors.MeetingIdNotFound
+	default:
+		return errors.InternalError
+	}
+}
+
 func (s Service) getUserAndMeetingSettings(
-	request models.ParticipationRequest) (models.FullUserInfo, models.ParticipationMeetingSettings, error) {
+	ctx context.Context, request models.ParticipationRequest,
+) (models.FullUserInfo, models.ParticipationMeetingSettings, error) {
 	var (
 		userSettings    models.FullUserInfo
 		meetingSettings models.ParticipationMeetingSettings
 		err             error
 	)
 
-	userSettings, err = s.userSettingsRepository.GetUserSettings(request.UserId)
+	userSettings, err = s.userSettingsRepository.GetUserSettings(ctx, request.UserId)
 	switch err {
 	case nil:
 		break
@@ -65,7 +103,7 @@ func (s Service) getUserAndMeetingSettings(
 		return userSettings, meetingSettings, errors.InternalError
 	}
 
-	meetingSettings, err = s.meetingsSettingsRepository.GetMeetingSettings(request.MeetingId)
+	meetingSettings, err = s.meetingsSettingsRepository.GetMeetingSettings(ctx, request.MeetingId)
 	switch err {
 	case nil:
 		break
@@ -79,11 +117,11 @@ func (s Service) getUserAndMeetingSettings(
 }
 
 func (s Service) hasNearMeeting(
-	request models.ParticipationRequest,
+	ctx context.Context, request models.ParticipationRequest,
 	meetingSettings models.ParticipationMeetingSettings,
 ) (bool, error) {
 	meetings, err := s.meetingsSettingsRepository.GetNearMeetings(
-		models.UserTimeCheckData{
+		ctx, models.UserTimeCheckData{
 			UserId:    request.UserId,
 			MeetingId: request.MeetingId,
 		})
@@ -1,6 +1,8 @@
 package participation
 
 import (
+	"context"
+	repositoriesMock "mock/repositories"
 	mock "mock/services"
 	"models"
 	"services/errors"
@@ -11,18 +13,19 @@ import (
 var service = New(
 	&mock.UsersSettingsRepository,
 	&mock.MeetingsSettingsRepository,
+	&mock.ChatRepository,
 )
 
 func TestService_HandleParticipationRequestTooLowRatingTags(t *testing.T) {
 	request, tags := mock.TooLowRatingTagsRequest()
-	info, err := service.HandleParticipationRequest(request)
+	info, err := service.HandleParticipationRequest(context.Background(), request)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(mock.TagsEqual(tags, info.TooLowRatingTags), t)
 }
 
 func TestService_HandleParticipationRequestHasNearMeeting(t *testing.T) {
-	info, err := service.HandleParticipationRequest(mock.HasNearMeetingRequest())
+	info, err := service.HandleParticipationRequest(context.Background(), mock.HasNearMeetingRequest())
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(info.HasNearMeeting, t)
@@ -30,7 +33,7 @@ func TestService_HandleParticipationRequestHasNearMeeting(t *testing.T) {
 
 func TestService_HandleParticipationRequestInappropriateAge(t *testing.T) {
 	request, inappropriateAgeField := mock.InappropriateAgeRequest()
-	info, err := service.HandleParticipationRequest(request)
+	info, err := service.HandleParticipationRequest(context.Background(), request)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(mock.HasField(info.InappropriateInfoFields, inappropriateAgeField), t)
@@ -38,7 +41,7 @@ func TestService_HandleParticipationRequestInappropriateAge(t *testing.T) {
 
 func TestService_HandleParticipationRequestWrongGender(t *testing.T) {
 	request, inappropriateGenderField := mock.WrongGenderRequest()
-	info, err := service.HandleParticipationRequest(request)
+	info, err := service.HandleParticipationRequest(context.Background(), request)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(mock.HasField(info.InappropriateInfoFields, inappropriateGenderField), t)
@@ -46,7 +49,7 @@ func TestService_HandleParticipationRequestWrongGender(t *testing.T) {
 
 func TestService_HandleParticipationRequestMeetingWithoutGender(t *testing.T) {
 	request, inappropriateGenderField := mock.MeetingWithoutGenderRequest()
-	info, err := service.HandleParticipationRequest(request)
+	info, err := service.HandleParticipationRequest(context.Background(), request)
 
 	utils.AssertNil(err, t)
 	utils.AssertFalse(mock.HasField(info.InappropriateInfoFields, inappropriateGenderField), t)
@@ -54,57 +57,60 @@ func TestService_HandleParticipationRequestMeetingWithoutGender(t *testing.T) {
 
 func TestService_HandleParticipationRequestMaxUsersCountReached(t *testing.T) {
 	request, maxUsersCountReachedField := mock.MaxUsersCountReachedRequest()
-	info, err := service.HandleParticipationRequest(request)
+	info, err := service.HandleParticipationRequest(context.Background(), request)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(mock.HasField(info.InappropriateInfoFields, maxUsersCountReachedField), t)
 }
 
 func TestService_HandleParticipationRequestNotExistsMeeting(t *testing.T) {
-	_, err := service.HandleParticipationRequest(mock.NotExistsMeetingRequest())
+	_, err := service.HandleParticipationRequest(context.Background(), mock.NotExistsMeetingRequest())
 
 	utils.AssertErrorsEqual(errors.MeetingIdNotFound, err, t)
 }
 
 func TestService_HandleParticipationRequestNotExistsUser(t *testing.T) {
-	_, err := service.HandleParticipationRequest(mock.NotExistsUserRequest())
+	_, err := service.HandleParticipationRequest(context.Background(), mock.NotExistsUserRequest())
 
 	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
 }
 
 func TestService_HandleParticipationRequestInternalErrorBadMeetingId(t *testing.T) {
-	_, err := service.HandleParticipationRequest(mock.InternalErrorBadMeetingIdRequest())
+	_, err := service.HandleParticipationRequest(context.Background(), mock.InternalErrorBadMeetingIdRequest())
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestService_HasNearMeetingNotExistsMeeting(t *testing.T) {
-	_, err := service.hasNearMeeting(mock.NotExistsMeetingRequest(), models.ParticipationMeetingSettings{})
+	_, err := service.hasNearMeeting(
+		context.Background(), mock.NotExistsMeetingRequest(), models.ParticipationMeetingSettings{})
 
 	utils.AssertErrorsEqual(errors.MeetingIdNotFound, err, t)
 }
 
 func TestService_HasNearMeetingNotExistsUser(t *testing.T) {
-	_, err := service.hasNearMeeting(mock.NotExistsUserRequest(), models.ParticipationMeetingSettings{})
+	_, err := service.hasNearMeeting(
+		context.Background(), mock.NotExistsUserRequest(), models.ParticipationMeetingSettings{})
 
 	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
 }
 
 func TestService_HandleParticipationRequestInternalErrorBadUserId(t *testing.T) {
-	_, err := service.HandleParticipationRequest(mock.InternalErrorUserIdRequest())
+	_, err := service.HandleParticipationRequest(context.Background(), mock.InternalErrorUserIdRequest())
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestService_HasNearMeetingInternalError(t *testing.T) {
-	_, err := service.hasNearMeeting(mock.InternalErrorBadMeetingIdRequest(), models.ParticipationMeetingSettings{})
+	_, err := service.hasNearMeeting(
+		context.Background(), mock.InternalErrorBadMeetingIdRequest(), models.ParticipationMeetingSettings{})
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
 
 func TestService_HandleParticipationRequestParticipationDescriptionRequiredTrue(t *testing.T) {
 	request, descriptionRequiredField := mock.ParticipationWithoutDescriptionWhereItRequiredRequest()
-	info, err := service.HandleParticipationRequest(request)
+	info, err := service.HandleParticipationRequest(context.Background(), request)
 
 	utils.AssertNil(err, t)
 	utils.AssertTrue(mock.HasField(info.InappropriateInfoFields, descriptionRequiredField), t)
@@ -112,8 +118,55 @@ func TestService_HandleParticipationRequestParticipationDescriptionRequiredTrue(
 
 func TestService_HandleParticipationRequestParticipationDescriptionRequiredFalse(t *testing.T) {
 	request, descriptionRequiredField := mock.ParticipationWithoutDescriptionWhereNotRequiredRequest()
-	info, err := service.HandleParticipationRequest(request)
+	info, err := service.HandleParticipationRequest(context.Background(), request)
 
 	utils.AssertNil(err, t)
 	utils.AssertFalse(mock.HasField(info.InappropriateInfoFields, descriptionRequiredField), t)
 }
+
+func TestService_HandleParticipationRequestOpensRequestChat(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	request := mock.HasNearMeetingRequest()
+	_, err := service.HandleParticipationRequest(context.Background(), request)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(mock.ChatRepository.HasOpenedRequestChat(request.MeetingId, request.UserId), t)
+}
+
+func TestService_HandleParticipationRequestRepeated(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	request := mock.HasNearMeetingRequest()
+	_, _ = service.HandleParticipationRequest(context.Background(), request)
+	_, err := service.HandleParticipationRequest(context.Background(), request)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(mock.ChatRepository.HasOpenedRequestChat(request.MeetingId, request.UserId), t)
+}
+
+func TestService_DeclineParticipationRequestSuccess(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	err := service.DeclineParticipationRequest(context.Background(), 1, repositoriesMock.FirstMeetingApplicantId)
+
+	utils.AssertNil(err, t)
+	utils.AssertFalse(mock.ChatRepository.HasOpenedRequestChat(1, repositoriesMock.FirstMeetingApplicantId), t)
+}
+
+func TestService_DeclineParticipationRequestNotFound(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	err := service.DeclineParticipationRequest(context.Background(), 1, repositoriesMock.UserIdWithoutFirstRequestChat)
+
+	utils.AssertErrorsEqual(errors.RequestNotFound, err, t)
+}
+
+func TestService_DeclineParticipationRequestInternalError(t *testing.T) {
+	defer mock.ChatRepository.ResetState()
+
+	err := service.DeclineParticipationRequest(
+		context.Background(), mock.BadMeetingId, repositoriesMock.FirstMeetingApplicantId)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
@@ -0,0 +1,212 @@
+// generator writes tracing proxies of services declared in interfaces package,
+// proxy is generated for service if each its method takes context and returns error,
+// the same proxy traces both validation and service layers
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const lineLimit = 120
+
+const header = "// Code generated by services/proxies/tracing/generator. DO NOT EDIT.\n\npackage tracing\n\n"
+
+type method struct {
+	name    string
+	params  []string
+	args    []string
+	results []string
+}
+
+type service struct {
+	name    string
+	methods []method
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: generator <interfaces file> <output file>")
+	}
+
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, os.Args[1], nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := generate(fileSet, file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(os.Args[2], source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(fileSet *token.FileSet, file *ast.File) ([]byte, error) {
+	imports := map[string]string{}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		imports[path[strings.LastIndex(path, "/")+1:]] = path
+	}
+
+	var services []service
+	used := map[string]bool{"interfaces": true, "plugins/tracer": true}
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, isType := node.(*ast.TypeSpec)
+		if !isType {
+			return true
+		}
+		if iface, isInterface := spec.Type.(*ast.InterfaceType); isInterface {
+			if s, traced := getService(fileSet, spec.Name.Name, iface); traced {
+				services = append(services, s)
+				addUsedImports(iface, imports, used)
+			}
+		}
+		return false
+	})
+
+	var source bytes.Buffer
+	source.WriteString(header)
+	writeImports(&source, used)
+	for _, s := range services {
+		writeService(&source, s)
+	}
+
+	return format.Source(source.Bytes())
+}
+
+// embedded interfaces are not supported, service with them is not traced
+func getService(fileSet *token.FileSet, name string, iface *ast.InterfaceType) (service, bool) {
+	s := service{name: name}
+	for _, field := range iface.Methods.List {
+		funcType, isMethod := field.Type.(*ast.FuncType)
+		if !isMethod || !isTraced(funcType) {
+			return service{}, false
+		}
+
+		m := method{name: field.Names[0].Name}
+		for i, param := range funcType.Params.List {
+			var names []string
+			for _, paramName := range param.Names {
+				names = append(names, paramName.Name)
+			}
+			switch {
+			case i == 0:
+				// context is passed to service with started span
+				names = []string{"ctx"}
+			case len(names) == 0:
+				names = []string{fmt.Sprintf("arg%d", i)}
+			}
+			m.params = append(m.params, strings.Join(names, ", ")+" "+typeString(fileSet, param.Type))
+			m.args = append(m.args, names...)
+		}
+		for _, result := range funcType.Results.List {
+			m.results = append(m.results, typeString(fileSet, result.Type))
+		}
+		s.methods = append(s.methods, m)
+	}
+
+	return s, true
+}
+
+func isTraced(funcType *ast.FuncType) bool {
+	params, results := funcType.Params.List, funcType.Results
+	if len(params) == 0 || results == nil || len(results.List) == 0 {
+		return false
+	}
+
+	first, isSelector := params[0].Type.(*ast.SelectorExpr)
+	last, isIdent := results.List[len(results.List)-1].Type.(*ast.Ident)
+	return isSelector && first.Sel.Name == "Context" && isIdent && last.Name == "error" &&
+		len(params[0].Names) <= 1 && len(results.List[len(results.List)-1].Names) <= 1
+}
+
+func typeString(fileSet *token.FileSet, expr ast.Expr) string {
+	var buffer bytes.Buffer
+	_ = printer.Fprint(&buffer, fileSet, expr)
+	return buffer.String()
+}
+
+func addUsedImports(iface *ast.InterfaceType, imports map[string]string, used map[string]bool) {
+	ast.Inspect(iface, func(node ast.Node) bool {
+		if selector, isSelector := node.(*ast.SelectorExpr); isSelector {
+			if pkg, isIdent := selector.X.(*ast.Ident); isIdent {
+				used[imports[pkg.Name]] = true
+			}
+		}
+		return true
+	})
+}
+
+func writeImports(source *bytes.Buffer, used map[string]bool) {
+	var paths []string
+	for path := range used {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	source.WriteString("import (\n")
+	for _, path := range paths {
+		fmt.Fprintf(source, "\t%q\n", path)
+	}
+	source.WriteString(")\n")
+}
+
+// span of method is named <layer>.<service without Service suffix>.<method>
+func writeService(source *bytes.Buffer, s service) {
+	proxy := s.name + "Proxy"
+	fmt.Fprintf(source, "\ntype %s struct {\n\tservice    interfaces.%s\n\tspanPrefix string\n}\n", proxy, s.name)
+
+	constructor := fmt.Sprintf("func New%s(service interfaces.%s, layer Layer) %s {\n", proxy, s.name, proxy)
+	if len(constructor) > lineLimit {
+		constructor = fmt.Sprintf(
+			"func New%s(\n\tservice interfaces.%s,\n\tlayer Layer,\n) %s {\n", proxy, s.name, proxy)
+	}
+	fmt.Fprintf(source, "\n%s\treturn %s{service, string(layer) + %q}\n}\n",
+		constructor, proxy, "."+strings.TrimSuffix(s.name, "Service")+".")
+
+	for _, m := range s.methods {
+		var values []string
+		for i := range m.results[:len(m.results)-1] {
+			values = append(values, fmt.Sprintf("result%d", i))
+		}
+		if len(values) == 1 {
+			values[0] = "result"
+		}
+		values = append(values, "err")
+
+		writeSignature(source, proxy, m)
+		fmt.Fprintf(source, "\tctx, span := tracer.Start(ctx, p.spanPrefix+%q)\n", m.name)
+		fmt.Fprintf(source, "\t%s := p.service.%s(%s)\n",
+			strings.Join(values, ", "), m.name, strings.Join(m.args, ", "))
+		source.WriteString("\tend(span, err)\n\n")
+		fmt.Fprintf(source, "\treturn %s\n}\n", strings.Join(values, ", "))
+	}
+}
+
+// params are moved to separate line if signature is longer than line limit
+func writeSignature(source *bytes.Buffer, proxy string, m method) {
+	params, results := strings.Join(m.params, ", "), strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		results = "(" + results + ")"
+	}
+
+	signature := fmt.Sprintf("func (p %s) %s(%s) %s {\n", proxy, m.name, params, results)
+	if len(signature) > lineLimit {
+		signature = fmt.Sprintf("func (p %s) %s(\n\t%s,\n) %s {\n", proxy, m.name, params, results)
+	}
+	fmt.Fprint(source, "\n"+signature)
+}
@@ -0,0 +1,570 @@
+// Code generated by services/proxies/tracing/generator. DO NOT EDIT.
+
+package tracing
+
+import (
+	"context"
+	"interfaces"
+	"io"
+	"models"
+	"plugins/tracer"
+	"time"
+)
+
+type AuthenticationServiceProxy struct {
+	service    interfaces.AuthenticationService
+	spanPrefix string
+}
+
+func NewAuthenticationServiceProxy(service interfaces.AuthenticationService, layer Layer) AuthenticationServiceProxy {
+	return AuthenticationServiceProxy{service, string(layer) + ".Authentication."}
+}
+
+func (p AuthenticationServiceProxy) RegisterUser(ctx context.Context, credentials models.UserCredentials) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"RegisterUser")
+	err := p.service.RegisterUser(ctx, credentials)
+	end(span, err)
+
+	return err
+}
+
+func (p AuthenticationServiceProxy) Login(
+	ctx context.Context, credentials models.UserCredentials,
+) (models.UserSession, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"Login")
+	result, err := p.service.Login(ctx, credentials)
+	end(span, err)
+
+	return result, err
+}
+
+func (p AuthenticationServiceProxy) ChangePassword(ctx context.Context, userId uint, password string) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"ChangePassword")
+	err := p.service.ChangePassword(ctx, userId, password)
+	end(span, err)
+
+	return err
+}
+
+type MeetingsAccessorServiceProxy struct {
+	service    interfaces.MeetingsAccessorService
+	spanPrefix string
+}
+
+func NewMeetingsAccessorServiceProxy(
+	service interfaces.MeetingsAccessorService,
+	layer Layer,
+) MeetingsAccessorServiceProxy {
+	return MeetingsAccessorServiceProxy{service, string(layer) + ".MeetingsAccessor."}
+}
+
+func (p MeetingsAccessorServiceProxy) GetFullMeetingInfo(
+	ctx context.Context, meetingId uint,
+) (models.PrivateMeeting, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetFullMeetingInfo")
+	result, err := p.service.GetFullMeetingInfo(ctx, meetingId)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MeetingsAccessorServiceProxy) GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetPublicMeetings")
+	result, err := p.service.GetPublicMeetings(ctx)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MeetingsAccessorServiceProxy) GetExtendedMeetings(
+	ctx context.Context, userId uint,
+) ([]models.ExtendedMeeting, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetExtendedMeetings")
+	result, err := p.service.GetExtendedMeetings(ctx, userId)
+	end(span, err)
+
+	return result, err
+}
+
+type MeetingsProxy struct {
+	service    interfaces.Meetings
+	spanPrefix string
+}
+
+func NewMeetingsProxy(service interfaces.Meetings, layer Layer) MeetingsProxy {
+	return MeetingsProxy{service, string(layer) + ".Meetings."}
+}
+
+func (p MeetingsProxy) CreateMeeting(ctx context.Context, adminId uint, settings models.AllSettings) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"CreateMeeting")
+	err := p.service.CreateMeeting(ctx, adminId, settings)
+	end(span, err)
+
+	return err
+}
+
+func (p MeetingsProxy) DeleteMeeting(ctx context.Context, meetingId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"DeleteMeeting")
+	err := p.service.DeleteMeeting(ctx, meetingId)
+	end(span, err)
+
+	return err
+}
+
+func (p MeetingsProxy) UpdateSettings(ctx context.Context, meetingId uint, settings models.AllSettings) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"UpdateSettings")
+	err := p.service.UpdateSettings(ctx, meetingId, settings)
+	end(span, err)
+
+	return err
+}
+
+func (p MeetingsProxy) AddUserToMeeting(ctx context.Context, meetingId, userId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"AddUserToMeeting")
+	err := p.service.AddUserToMeeting(ctx, meetingId, userId)
+	end(span, err)
+
+	return err
+}
+
+func (p MeetingsProxy) KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"KickUserFromMeeting")
+	err := p.service.KickUserFromMeeting(ctx, meetingId, userId)
+	end(span, err)
+
+	return err
+}
+
+type ParticipationServiceProxy struct {
+	service    interfaces.ParticipationService
+	spanPrefix string
+}
+
+func NewParticipationServiceProxy(service interfaces.ParticipationService, layer Layer) ParticipationServiceProxy {
+	return ParticipationServiceProxy{service, string(layer) + ".Participation."}
+}
+
+func (p ParticipationServiceProxy) HandleParticipationRequest(
+	ctx context.Context, request models.ParticipationRequest,
+) (models.RejectInfo, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"HandleParticipationRequest")
+	result, err := p.service.HandleParticipationRequest(ctx, request)
+	end(span, err)
+
+	return result, err
+}
+
+func (p ParticipationServiceProxy) DeclineParticipationRequest(ctx context.Context, meetingId, userId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"DeclineParticipationRequest")
+	err := p.service.DeclineParticipationRequest(ctx, meetingId, userId)
+	end(span, err)
+
+	return err
+}
+
+type UsersSettingsProxy struct {
+	service    interfaces.UsersSettings
+	spanPrefix string
+}
+
+func NewUsersSettingsProxy(service interfaces.UsersSettings, layer Layer) UsersSettingsProxy {
+	return UsersSettingsProxy{service, string(layer) + ".UsersSettings."}
+}
+
+func (p UsersSettingsProxy) GetUserSettings(ctx context.Context, userId uint) (models.FullUserInfo, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetUserSettings")
+	result, err := p.service.GetUserSettings(ctx, userId)
+	end(span, err)
+
+	return result, err
+}
+
+func (p UsersSettingsProxy) UpdateUserSettings(ctx context.Context, userId uint, info models.UserSettings) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"UpdateUserSettings")
+	err := p.service.UpdateUserSettings(ctx, userId, info)
+	end(span, err)
+
+	return err
+}
+
+type UsersPrivacyProxy struct {
+	service    interfaces.UsersPrivacy
+	spanPrefix string
+}
+
+func NewUsersPrivacyProxy(service interfaces.UsersPrivacy, layer Layer) UsersPrivacyProxy {
+	return UsersPrivacyProxy{service, string(layer) + ".UsersPrivacy."}
+}
+
+func (p UsersPrivacyProxy) BlockUser(ctx context.Context, userId, blockedUserId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"BlockUser")
+	err := p.service.BlockUser(ctx, userId, blockedUserId)
+	end(span, err)
+
+	return err
+}
+
+func (p UsersPrivacyProxy) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"UnblockUser")
+	err := p.service.UnblockUser(ctx, userId, blockedUserId)
+	end(span, err)
+
+	return err
+}
+
+func (p UsersPrivacyProxy) SetDirectMessagesAllowed(ctx context.Context, userId uint, allowed bool) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"SetDirectMessagesAllowed")
+	err := p.service.SetDirectMessagesAllowed(ctx, userId, allowed)
+	end(span, err)
+
+	return err
+}
+
+type ChatAccessorProxy struct {
+	service    interfaces.ChatAccessor
+	spanPrefix string
+}
+
+func NewChatAccessorProxy(service interfaces.ChatAccessor, layer Layer) ChatAccessorProxy {
+	return ChatAccessorProxy{service, string(layer) + ".ChatAccessor."}
+}
+
+func (p ChatAccessorProxy) GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetMeetingChat")
+	result, err := p.service.GetMeetingChat(ctx, meetingId)
+	end(span, err)
+
+	return result, err
+}
+
+func (p ChatAccessorProxy) GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetUserChats")
+	result, err := p.service.GetUserChats(ctx, userId)
+	end(span, err)
+
+	return result, err
+}
+
+func (p ChatAccessorProxy) GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetChatMembers")
+	result, err := p.service.GetChatMembers(ctx, chatId, usersIds)
+	end(span, err)
+
+	return result, err
+}
+
+type ChatProxy struct {
+	service    interfaces.Chat
+	spanPrefix string
+}
+
+func NewChatProxy(service interfaces.Chat, layer Layer) ChatProxy {
+	return ChatProxy{service, string(layer) + ".Chat."}
+}
+
+func (p ChatProxy) CreateMeetingChat(ctx context.Context, meetingId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"CreateMeetingChat")
+	err := p.service.CreateMeetingChat(ctx, meetingId)
+	end(span, err)
+
+	return err
+}
+
+func (p ChatProxy) CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"CreateMeetingRequestChat")
+	err := p.service.CreateMeetingRequestChat(ctx, meetingId, applicantId)
+	end(span, err)
+
+	return err
+}
+
+func (p ChatProxy) CloseChat(ctx context.Context, chatId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"CloseChat")
+	err := p.service.CloseChat(ctx, chatId)
+	end(span, err)
+
+	return err
+}
+
+func (p ChatProxy) ReopenChat(ctx context.Context, chatId, adminId uint) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"ReopenChat")
+	err := p.service.ReopenChat(ctx, chatId, adminId)
+	end(span, err)
+
+	return err
+}
+
+type ChatTranscriptProxy struct {
+	service    interfaces.ChatTranscript
+	spanPrefix string
+}
+
+func NewChatTranscriptProxy(service interfaces.ChatTranscript, layer Layer) ChatTranscriptProxy {
+	return ChatTranscriptProxy{service, string(layer) + ".ChatTranscript."}
+}
+
+func (p ChatTranscriptProxy) ExportChat(ctx context.Context, request models.ChatExportRequest, w io.Writer) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"ExportChat")
+	err := p.service.ExportChat(ctx, request, w)
+	end(span, err)
+
+	return err
+}
+
+type DirectChatProxy struct {
+	service    interfaces.DirectChat
+	spanPrefix string
+}
+
+func NewDirectChatProxy(service interfaces.DirectChat, layer Layer) DirectChatProxy {
+	return DirectChatProxy{service, string(layer) + ".DirectChat."}
+}
+
+func (p DirectChatProxy) GetDirectChat(ctx context.Context, userId, interlocutorId uint) (models.Chat, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetDirectChat")
+	result, err := p.service.GetDirectChat(ctx, userId, interlocutorId)
+	end(span, err)
+
+	return result, err
+}
+
+func (p DirectChatProxy) GetOrCreateDirectChat(ctx context.Context, senderId, recipientId uint) (models.Chat, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetOrCreateDirectChat")
+	result, err := p.service.GetOrCreateDirectChat(ctx, senderId, recipientId)
+	end(span, err)
+
+	return result, err
+}
+
+type MessagesProxy struct {
+	service    interfaces.Messages
+	spanPrefix string
+}
+
+func NewMessagesProxy(service interfaces.Messages, layer Layer) MessagesProxy {
+	return MessagesProxy{service, string(layer) + ".Messages."}
+}
+
+func (p MessagesProxy) Save(ctx context.Context, message models.Message) (models.Message, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"Save")
+	result, err := p.service.Save(ctx, message)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MessagesProxy) GetLastMessages(ctx context.Context, chatId, count, viewerId uint) ([]models.Message, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetLastMessages")
+	result, err := p.service.GetLastMessages(ctx, chatId, count, viewerId)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MessagesProxy) GetLastMessagesAfter(
+	ctx context.Context, chatId, messageId, count, viewerId uint,
+) ([]models.Message, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetLastMessagesAfter")
+	result, err := p.service.GetLastMessagesAfter(ctx, chatId, messageId, count, viewerId)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MessagesProxy) GetThread(ctx context.Context, rootId, afterId, count, viewerId uint) (models.Thread, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetThread")
+	result, err := p.service.GetThread(ctx, rootId, afterId, count, viewerId)
+	end(span, err)
+
+	return result, err
+}
+
+type MessagesSearchProxy struct {
+	service    interfaces.MessagesSearch
+	spanPrefix string
+}
+
+func NewMessagesSearchProxy(service interfaces.MessagesSearch, layer Layer) MessagesSearchProxy {
+	return MessagesSearchProxy{service, string(layer) + ".MessagesSearch."}
+}
+
+func (p MessagesSearchProxy) SearchMessages(
+	ctx context.Context, query models.SearchQuery,
+) ([]models.SearchHit, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"SearchMessages")
+	result, err := p.service.SearchMessages(ctx, query)
+	end(span, err)
+
+	return result, err
+}
+
+type NotificationsProxy struct {
+	service    interfaces.Notifications
+	spanPrefix string
+}
+
+func NewNotificationsProxy(service interfaces.Notifications, layer Layer) NotificationsProxy {
+	return NotificationsProxy{service, string(layer) + ".Notifications."}
+}
+
+func (p NotificationsProxy) GetNotifications(ctx context.Context, userId, count uint) ([]models.Notification, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetNotifications")
+	result, err := p.service.GetNotifications(ctx, userId, count)
+	end(span, err)
+
+	return result, err
+}
+
+type AttachmentsProxy struct {
+	service    interfaces.Attachments
+	spanPrefix string
+}
+
+func NewAttachmentsProxy(service interfaces.Attachments, layer Layer) AttachmentsProxy {
+	return AttachmentsProxy{service, string(layer) + ".Attachments."}
+}
+
+func (p AttachmentsProxy) Upload(ctx context.Context, upload models.AttachmentUpload) (models.Attachment, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"Upload")
+	result, err := p.service.Upload(ctx, upload)
+	end(span, err)
+
+	return result, err
+}
+
+func (p AttachmentsProxy) Download(
+	ctx context.Context, attachmentId, userId uint, thumbnail bool,
+) (models.AttachmentContent, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"Download")
+	result, err := p.service.Download(ctx, attachmentId, userId, thumbnail)
+	end(span, err)
+
+	return result, err
+}
+
+func (p AttachmentsProxy) DeleteOrphans(ctx context.Context, olderThan time.Duration) (uint, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"DeleteOrphans")
+	result, err := p.service.DeleteOrphans(ctx, olderThan)
+	end(span, err)
+
+	return result, err
+}
+
+type ReactionsProxy struct {
+	service    interfaces.Reactions
+	spanPrefix string
+}
+
+func NewReactionsProxy(service interfaces.Reactions, layer Layer) ReactionsProxy {
+	return ReactionsProxy{service, string(layer) + ".Reactions."}
+}
+
+func (p ReactionsProxy) AddReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"AddReaction")
+	result, err := p.service.AddReaction(ctx, reaction)
+	end(span, err)
+
+	return result, err
+}
+
+func (p ReactionsProxy) RemoveReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"RemoveReaction")
+	result, err := p.service.RemoveReaction(ctx, reaction)
+	end(span, err)
+
+	return result, err
+}
+
+type MessagesEditorProxy struct {
+	service    interfaces.MessagesEditor
+	spanPrefix string
+}
+
+func NewMessagesEditorProxy(service interfaces.MessagesEditor, layer Layer) MessagesEditorProxy {
+	return MessagesEditorProxy{service, string(layer) + ".MessagesEditor."}
+}
+
+func (p MessagesEditorProxy) EditMessage(
+	ctx context.Context, request models.EditMessageRequest,
+) (models.Message, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"EditMessage")
+	result, err := p.service.EditMessage(ctx, request)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MessagesEditorProxy) DeleteMessage(
+	ctx context.Context, request models.DeleteMessageRequest,
+) (models.Message, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"DeleteMessage")
+	result, err := p.service.DeleteMessage(ctx, request)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MessagesEditorProxy) GetMessageEdits(ctx context.Context, messageId uint) ([]models.MessageEdit, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetMessageEdits")
+	result, err := p.service.GetMessageEdits(ctx, messageId)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MessagesEditorProxy) PinMessage(
+	ctx context.Context, request models.PinMessageRequest,
+) (models.Message, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"PinMessage")
+	result, err := p.service.PinMessage(ctx, request)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MessagesEditorProxy) UnpinMessage(
+	ctx context.Context, request models.PinMessageRequest,
+) (models.Message, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"UnpinMessage")
+	result, err := p.service.UnpinMessage(ctx, request)
+	end(span, err)
+
+	return result, err
+}
+
+func (p MessagesEditorProxy) GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetPinnedMessages")
+	result, err := p.service.GetPinnedMessages(ctx, chatId)
+	end(span, err)
+
+	return result, err
+}
+
+type ReadReceiptsProxy struct {
+	service    interfaces.ReadReceipts
+	spanPrefix string
+}
+
+func NewReadReceiptsProxy(service interfaces.ReadReceipts, layer Layer) ReadReceiptsProxy {
+	return ReadReceiptsProxy{service, string(layer) + ".ReadReceipts."}
+}
+
+func (p ReadReceiptsProxy) MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"MarkAsRead")
+	err := p.service.MarkAsRead(ctx, receipt)
+	end(span, err)
+
+	return err
+}
+
+func (p ReadReceiptsProxy) GetUnreadCounts(ctx context.Context, userId uint) (models.UnreadCounts, error) {
+	ctx, span := tracer.Start(ctx, p.spanPrefix+"GetUnreadCounts")
+	result, err := p.service.GetUnreadCounts(ctx, userId)
+	end(span, err)
+
+	return result, err
+}
@@ -0,0 +1,34 @@
+package tracing
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"models"
+	"plugins/tracer"
+)
+
+//go:generate go run ./generator ../../../interfaces/services.go proxies.go
+
+// Layer is the first part of span names of proxy. Service is wrapped by validation proxy and both of them
+// are wrapped by tracing proxies, so span of validation layer contains span of service layer
+// if request passes validation
+type Layer string
+
+const (
+	ValidationLayer Layer = "validation"
+	ServiceLayer    Layer = "service"
+)
+
+// validation errors describe each invalid field of request
+type fieldsError interface {
+	Fields() []models.FieldError
+}
+
+// proxy of validation layer wraps validation proxy, so request rejected by validation is marked
+// to be told apart from failed service
+func end(span trace.Span, err error) {
+	if _, isValidationError := err.(fieldsError); isValidationError {
+		span.SetAttributes(attribute.Bool("validation.failed", true))
+	}
+	tracer.End(span, err)
+}
@@ -0,0 +1,89 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"mock/plugins"
+	"models"
+	"services/proxies/validation"
+	"testing"
+	"utils"
+)
+
+type chatAccessorMock struct {
+	err error
+}
+
+func (m chatAccessorMock) GetMeetingChat(context.Context, uint) (models.Chat, error) {
+	return models.Chat{}, m.err
+}
+
+func (m chatAccessorMock) GetUserChats(context.Context, uint) ([]models.UserChat, error) {
+	return nil, m.err
+}
+
+func (m chatAccessorMock) GetChatMembers(context.Context, uint, []uint) ([]uint, error) {
+	return nil, m.err
+}
+
+// proxies are layered in the same way as in services factory
+func getLayeredProxy() ChatAccessorProxy {
+	service := NewChatAccessorProxy(chatAccessorMock{}, ServiceLayer)
+	return NewChatAccessorProxy(validation.NewChatAccessorProxy(service), ValidationLayer)
+}
+
+func TestProxy_StartsSpanOfService(t *testing.T) {
+	spans := plugins.RecordSpans()
+
+	_, err := NewChatAccessorProxy(chatAccessorMock{}, ServiceLayer).GetUserChats(context.Background(), 1)
+
+	utils.AssertNil(err, t)
+	ended := spans.GetSpans()
+	utils.AssertEqual(1, len(ended), t)
+	utils.AssertEqual("service.ChatAccessor.GetUserChats", ended[0].Name, t)
+	utils.AssertEqual(codes.Unset, ended[0].Status.Code, t)
+}
+
+func TestProxy_RecordsError(t *testing.T) {
+	spans := plugins.RecordSpans()
+	serviceError := errors.New("chat is not found")
+
+	_, err := NewChatAccessorProxy(chatAccessorMock{serviceError}, ServiceLayer).GetMeetingChat(context.Background(), 1)
+
+	utils.AssertErrorsEqual(serviceError, err, t)
+	ended := spans.GetSpans()
+	utils.AssertEqual(1, len(ended), t)
+	utils.AssertEqual(codes.Error, ended[0].Status.Code, t)
+	utils.AssertEqual(1, len(ended[0].Events), t)
+}
+
+func TestProxy_MarksValidationFailure(t *testing.T) {
+	spans := plugins.RecordSpans()
+	_, err := getLayeredProxy().GetUserChats(context.Background(), 0)
+
+	utils.AssertNotNil(err, t)
+	// service is not called, so there is only span of validation
+	ended := spans.GetSpans()
+	utils.AssertEqual(1, len(ended), t)
+	utils.AssertEqual("validation.ChatAccessor.GetUserChats", ended[0].Name, t)
+	utils.AssertEqual(codes.Error, ended[0].Status.Code, t)
+	utils.AssertEqual(validation.InvalidId, ended[0].Status.Description, t)
+	utils.AssertEqual(1, len(ended[0].Attributes), t)
+	utils.AssertEqual(attribute.Bool("validation.failed", true), ended[0].Attributes[0], t)
+}
+
+func TestProxy_SeparatesValidationAndService(t *testing.T) {
+	spans := plugins.RecordSpans()
+
+	_, err := getLayeredProxy().GetUserChats(context.Background(), 1)
+
+	utils.AssertNil(err, t)
+	// span of service ends first and it is a child of validation span
+	ended := spans.GetSpans()
+	utils.AssertEqual(2, len(ended), t)
+	utils.AssertEqual("service.ChatAccessor.GetUserChats", ended[0].Name, t)
+	utils.AssertEqual("validation.ChatAccessor.GetUserChats", ended[1].Name, t)
+	utils.AssertEqual(ended[1].SpanContext.SpanID(), ended[0].Parent.SpanID(), t)
+}
@@ -0,0 +1,48 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"services/proxies/validation/plugins/validation"
+	"time"
+)
+
+type AttachmentsProxy struct {
+	service interfaces.Attachments
+}
+
+func NewAttachmentsProxy(service interfaces.Attachments) AttachmentsProxy {
+	return AttachmentsProxy{service}
+}
+
+func (p AttachmentsProxy) Upload(ctx context.Context, upload models.AttachmentUpload) (models.Attachment, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("uploader_id", upload.UploaderId)
+	if !validation.ValidFileName(upload.FileName) {
+		validationResults.AddWithParams("file_name", InvalidAttachmentName, ruleParams(validation.Rules().FileName))
+	}
+
+	if validationResults.HasErrors() {
+		return models.Attachment{}, validationResults
+	} else {
+		return p.service.Upload(ctx, upload)
+	}
+}
+
+func (p AttachmentsProxy) Download(
+	ctx context.Context, attachmentId, userId uint, thumbnail bool,
+) (models.AttachmentContent, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("attachment_id", attachmentId)
+	validationResults.CheckId("user_id", userId)
+	if validationResults.HasErrors() {
+		return models.AttachmentContent{}, validationResults
+	}
+
+	return p.service.Download(ctx, attachmentId, userId, thumbnail)
+}
+
+func (p AttachmentsProxy) DeleteOrphans(ctx context.Context, olderThan time.Duration) (uint, error) {
+	return p.service.DeleteOrphans(ctx, olderThan)
+}
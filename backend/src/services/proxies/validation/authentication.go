@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"services/proxies/validation/plugins/validation"
@@ -14,50 +15,54 @@ func NewAuthenticationServiceProxy(service interfaces.AuthenticationService) Aut
 	return AuthenticationServiceProxy{service}
 }
 
-func (p AuthenticationServiceProxy) RegisterUser(credentials models.UserCredentials) error {
+func (p AuthenticationServiceProxy) RegisterUser(ctx context.Context, credentials models.UserCredentials) error {
 	validationResults := p.validateCredentials(credentials)
 
 	if validationResults.HasErrors() {
 		return validationResults
 	} else {
-		return p.service.RegisterUser(credentials)
+		return p.service.RegisterUser(ctx, credentials)
 	}
 }
 
 func (p AuthenticationServiceProxy) validateCredentials(credentials models.UserCredentials) validationResults {
 	validationResults := validationResults{}
 	if !validation.ValidEmail(credentials.Email) {
-		validationResults.Add(InvalidEmail)
+		validationResults.Add("email", InvalidEmail)
 	}
 	if !validation.ValidPassword(credentials.Password) {
-		validationResults.Add(InvalidPassword)
+		validationResults.AddWithParams("password", InvalidPassword, passwordParams())
 	}
 
 	return validationResults
 }
 
-func (p AuthenticationServiceProxy) Login(credentials models.UserCredentials) (models.UserSession, error) {
+func passwordParams() map[string]interface{} {
+	return ruleParams(validation.Rules().Password)
+}
+
+func (p AuthenticationServiceProxy) Login(
+	ctx context.Context, credentials models.UserCredentials,
+) (models.UserSession, error) {
 	validationResults := p.validateCredentials(credentials)
 
 	if validationResults.HasErrors() {
 		return models.UserSession{}, validationResults
 	} else {
-		return p.service.Login(credentials)
+		return p.service.Login(ctx, credentials)
 	}
 }
 
-func (p AuthenticationServiceProxy) ChangePassword(userId uint, password string) error {
+func (p AuthenticationServiceProxy) ChangePassword(ctx context.Context, userId uint, password string) error {
 	validationResults := validationResults{}
-	if !validation.ValidWholePositiveNumber(float64(userId)) {
-		validationResults.Add(InvalidId)
-	}
+	validationResults.CheckId("user_id", userId)
 	if !validation.ValidPassword(password) {
-		validationResults.Add(InvalidPassword)
+		validationResults.AddWithParams("password", InvalidPassword, passwordParams())
 	}
 
 	if validationResults.HasErrors() {
 		return validationResults
 	} else {
-		return p.service.ChangePassword(userId, password)
+		return p.service.ChangePassword(ctx, userId, password)
 	}
 }
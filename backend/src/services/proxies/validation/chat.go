@@ -1,8 +1,8 @@
 package validation
 
 import (
+	"context"
 	"interfaces"
-	"services/proxies/validation/plugins/validation"
 )
 
 type ChatProxy struct {
@@ -13,32 +13,44 @@ func NewChatProxy(service interfaces.Chat) ChatProxy {
 	return ChatProxy{service}
 }
 
-func (p ChatProxy) CreateMeetingChat(meetingId uint) error {
-	if !validation.ValidWholePositiveNumber(float64(meetingId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p ChatProxy) CreateMeetingChat(ctx context.Context, meetingId uint) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("meeting_id", meetingId)
+	if validationResults.HasErrors() {
 		return validationResults
 	}
 
-	return p.service.CreateMeetingChat(meetingId)
+	return p.service.CreateMeetingChat(ctx, meetingId)
 }
 
-func (p ChatProxy) CreateMeetingRequestChat(meetingId uint) error {
-	if !validation.ValidWholePositiveNumber(float64(meetingId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p ChatProxy) CreateMeetingRequestChat(ctx context.Context, meetingId, applicantId uint) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("meeting_id", meetingId)
+	validationResults.CheckId("applicant_id", applicantId)
+	if validationResults.HasErrors() {
 		return validationResults
 	}
 
-	return p.service.CreateMeetingRequestChat(meetingId)
+	return p.service.CreateMeetingRequestChat(ctx, meetingId, applicantId)
 }
 
-func (p ChatProxy) CloseChat(chatId uint) error {
-	if !validation.ValidWholePositiveNumber(float64(chatId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p ChatProxy) CloseChat(ctx context.Context, chatId uint) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("chat_id", chatId)
+	if validationResults.HasErrors() {
 		return validationResults
 	}
 
-	return p.service.CloseChat(chatId)
+	return p.service.CloseChat(ctx, chatId)
+}
+
+func (p ChatProxy) ReopenChat(ctx context.Context, chatId, adminId uint) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("chat_id", chatId)
+	validationResults.CheckId("admin_id", adminId)
+	if validationResults.HasErrors() {
+		return validationResults
+	}
+
+	return p.service.ReopenChat(ctx, chatId, adminId)
 }
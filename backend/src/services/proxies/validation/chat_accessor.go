@@ -1,9 +1,9 @@
 package validation
 
 import (
+	"context"
 	"interfaces"
 	"models"
-	"services/proxies/validation/plugins/validation"
 )
 
 type ChatAccessorProxy struct {
@@ -14,22 +14,32 @@ func NewChatAccessorProxy(service interfaces.ChatAccessor) ChatAccessorProxy {
 	return ChatAccessorProxy{service}
 }
 
-func (p ChatAccessorProxy) GetMeetingChat(meetingId uint) (models.Chat, error) {
-	if !validation.ValidWholePositiveNumber(float64(meetingId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p ChatAccessorProxy) GetMeetingChat(ctx context.Context, meetingId uint) (models.Chat, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("meeting_id", meetingId)
+	if validationResults.HasErrors() {
 		return models.Chat{}, validationResults
 	}
 
-	return p.service.GetMeetingChat(meetingId)
+	return p.service.GetMeetingChat(ctx, meetingId)
 }
 
-func (p ChatAccessorProxy) GetUserChats(userId uint) ([]models.Chat, error) {
-	if !validation.ValidWholePositiveNumber(float64(userId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p ChatAccessorProxy) GetUserChats(ctx context.Context, userId uint) ([]models.UserChat, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("user_id", userId)
+	if validationResults.HasErrors() {
 		return nil, validationResults
 	}
 
-	return p.service.GetUserChats(userId)
+	return p.service.GetUserChats(ctx, userId)
+}
+
+func (p ChatAccessorProxy) GetChatMembers(ctx context.Context, chatId uint, usersIds []uint) ([]uint, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("chat_id", chatId)
+	if validationResults.HasErrors() {
+		return nil, validationResults
+	}
+
+	return p.service.GetChatMembers(ctx, chatId, usersIds)
 }
@@ -0,0 +1,32 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+	"io"
+	"models"
+	"services/proxies/validation/plugins/validation"
+)
+
+type ChatTranscriptProxy struct {
+	service interfaces.ChatTranscript
+}
+
+func NewChatTranscriptProxy(service interfaces.ChatTranscript) ChatTranscriptProxy {
+	return ChatTranscriptProxy{service}
+}
+
+func (p ChatTranscriptProxy) ExportChat(ctx context.Context, request models.ChatExportRequest, w io.Writer) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("chat_id", request.ChatId)
+	validationResults.CheckId("admin_id", request.AdminId)
+	if !validation.ValidTranscriptFormat(request.Format) {
+		validationResults.Add("format", InvalidTranscriptFormat)
+	}
+
+	if validationResults.HasErrors() {
+		return validationResults
+	} else {
+		return p.service.ExportChat(ctx, request, w)
+	}
+}
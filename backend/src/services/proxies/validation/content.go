@@ -0,0 +1,89 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"services/proxies/validation/plugins/content_filter"
+)
+
+// kinds of checked content
+const (
+	MessageContent         = "message"
+	MeetingContent         = "meeting"
+	MeetingSettingsContent = "meeting_settings"
+	NicknameContent        = "nickname"
+)
+
+// checks user texts with content filter, zero value accepts any text
+type ContentChecker struct {
+	filter     *content_filter.Filter
+	moderation interfaces.ModerationRepository
+}
+
+func NewContentChecker(filter content_filter.Filter, moderation interfaces.ModerationRepository) ContentChecker {
+	return ContentChecker{&filter, moderation}
+}
+
+// collects flags of one request, they are saved only if request succeeds
+type contentReview struct {
+	checker ContentChecker
+	flags   []models.ContentFlag
+}
+
+func (c ContentChecker) review() *contentReview {
+	return &contentReview{checker: c}
+}
+
+// text is masked in place in mask mode, in reject mode error is added to validation results
+func (r *contentReview) check(
+	kind string, sourceId uint, field string, text *string, results *validationResults, errorCode string,
+) {
+	filter := r.checker.filter
+	if filter == nil {
+		return
+	}
+
+	result := filter.Check(*text)
+	if len(result.Reasons) == 0 {
+		return
+	}
+
+	switch filter.Mode() {
+	case content_filter.MaskMode:
+		*text = result.Masked
+	case content_filter.FlagMode:
+		r.flags = append(r.flags, models.ContentFlag{Kind: kind, SourceId: sourceId, Text: *text, Reasons: result.Reasons})
+	default:
+		results.AddWithParams(field, errorCode, reasonsParams(result.Reasons))
+	}
+}
+
+// masked text can not be used where only some symbols are allowed, so such text is rejected instead of masking
+func (r *contentReview) checkNotMaskable(
+	kind string, sourceId uint, field string, text string, results *validationResults, errorCode string,
+) {
+	if r.checker.filter != nil && r.checker.filter.Mode() == content_filter.MaskMode {
+		if reasons := r.checker.filter.Check(text).Reasons; len(reasons) != 0 {
+			results.AddWithParams(field, errorCode, reasonsParams(reasons))
+		}
+		return
+	}
+
+	r.check(kind, sourceId, field, &text, results, errorCode)
+}
+
+func reasonsParams(reasons []string) map[string]interface{} {
+	return map[string]interface{}{"reasons": reasons}
+}
+
+// flag is not important enough to fail request, so errors are only logged by repository
+func (r *contentReview) saveFlags(ctx context.Context, err error) {
+	if err != nil {
+		return
+	}
+
+	for _, flag := range r.flags {
+		_ = r.checker.moderation.FlagContent(ctx, flag)
+	}
+}
@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"context"
+	"mock/plugins"
+	mock "mock/services"
+	"models"
+	"services/messages"
+	"services/proxies/validation/plugins/content_filter"
+	"strings"
+	"testing"
+	"utils"
+)
+
+func getMessagesProxy(mode string) MessagesProxy {
+	config := plugins.ContentFilterConfig
+	config.Mode = mode
+
+	return NewMessagesProxy(
+		messages.New(&mock.MessagesMockRepository),
+		NewContentChecker(content_filter.New(config), &mock.ModerationRepository),
+	)
+}
+
+func getProfaneMessage() models.Message {
+	return models.Message{ChatId: 1, SenderId: 1, Text: "hello badword"}
+}
+
+func TestMessagesProxy_RejectInappropriateText(t *testing.T) {
+	defer mock.ModerationRepository.ResetState()
+
+	_, err := getMessagesProxy(content_filter.RejectMode).Save(context.Background(), getProfaneMessage())
+
+	utils.AssertNotNil(err, t)
+	utils.AssertTrue(strings.Contains(err.Error(), InappropriateMessageText), t)
+	utils.AssertEqual(0, len(mock.ModerationRepository.Flags), t)
+}
+
+func TestMessagesProxy_MaskInappropriateText(t *testing.T) {
+	defer mock.ModerationRepository.ResetState()
+
+	message, err := getMessagesProxy(content_filter.MaskMode).Save(context.Background(), getProfaneMessage())
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual("hello *******", message.Text, t)
+	utils.AssertEqual(0, len(mock.ModerationRepository.Flags), t)
+}
+
+func TestMessagesProxy_FlagInappropriateText(t *testing.T) {
+	defer mock.ModerationRepository.ResetState()
+
+	message, err := getMessagesProxy(content_filter.FlagMode).Save(context.Background(), getProfaneMessage())
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(getProfaneMessage().Text, message.Text, t)
+	utils.AssertEqual(1, len(mock.ModerationRepository.Flags), t)
+	utils.AssertEqual(MessageContent, mock.ModerationRepository.Flags[0].Kind, t)
+}
+
+func TestMessagesProxy_NotSavedMessageIsNotFlagged(t *testing.T) {
+	defer mock.ModerationRepository.ResetState()
+
+	message := getProfaneMessage()
+	message.ChatId = mock.BadChatId
+	_, err := getMessagesProxy(content_filter.FlagMode).Save(context.Background(), message)
+
+	utils.AssertNotNil(err, t)
+	utils.AssertEqual(0, len(mock.ModerationRepository.Flags), t)
+}
+
+func TestMessagesProxy_WithoutContentChecker(t *testing.T) {
+	proxy := NewMessagesProxy(messages.New(&mock.MessagesMockRepository), ContentChecker{})
+	message, err := proxy.Save(context.Background(), getProfaneMessage())
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(getProfaneMessage().Text, message.Text, t)
+}
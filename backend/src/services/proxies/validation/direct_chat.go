@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"services/proxies/validation/plugins/validation"
+)
+
+type DirectChatProxy struct {
+	service interfaces.DirectChat
+}
+
+func NewDirectChatProxy(service interfaces.DirectChat) DirectChatProxy {
+	return DirectChatProxy{service}
+}
+
+func (p DirectChatProxy) GetDirectChat(ctx context.Context, userId, interlocutorId uint) (models.Chat, error) {
+	validationResults := validationResults{}
+	validationResults.checkUsersPair(userId, "interlocutor_id", interlocutorId)
+	if validationResults.HasErrors() {
+		return models.Chat{}, validationResults
+	}
+
+	return p.service.GetDirectChat(ctx, userId, interlocutorId)
+}
+
+func (p DirectChatProxy) GetOrCreateDirectChat(ctx context.Context, senderId, recipientId uint) (models.Chat, error) {
+	validationResults := validationResults{}
+	validationResults.checkUsersPair(senderId, "recipient_id", recipientId)
+	if validationResults.HasErrors() {
+		return models.Chat{}, validationResults
+	}
+
+	return p.service.GetOrCreateDirectChat(ctx, senderId, recipientId)
+}
+
+// user can not be paired with himself, so the same second id is invalid too
+func (e *validationResults) checkUsersPair(userId uint, secondField string, secondUserId uint) {
+	e.CheckId("user_id", userId)
+	e.CheckId(secondField, secondUserId)
+	if validation.ValidWholePositiveNumber(float64(userId)) && userId == secondUserId {
+		e.Add(secondField, InvalidId)
+	}
+}
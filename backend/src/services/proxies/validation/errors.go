@@ -1,21 +1,54 @@
 package validation
 
-import "strings"
+import (
+	"models"
+	"services/proxies/validation/plugins/validation"
+	"strings"
+)
 
 type validationResults struct {
-	validation []string
+	errors []models.FieldError
+}
+
+func (e *validationResults) Add(field, code string) {
+	e.AddWithParams(field, code, nil)
+}
+
+func (e *validationResults) AddWithParams(field, code string, params map[string]interface{}) {
+	e.errors = append(e.errors, models.FieldError{Field: field, Code: code, Params: params})
 }
 
-func (e *validationResults) Add(err string) {
-	e.validation = append(e.validation, err)
+func (e *validationResults) CheckId(field string, id uint) {
+	if !validation.ValidWholePositiveNumber(float64(id)) {
+		e.Add(field, InvalidId)
+	}
 }
 
 func (e validationResults) HasErrors() bool {
-	return len(e.validation) != 0
+	return len(e.errors) != 0
 }
 
+func (e validationResults) Fields() []models.FieldError {
+	return e.errors
+}
+
+// every code is included once, so clients that do not read fields get the same error detail as before
 func (e validationResults) Error() string {
-	return strings.Join(e.validation, "|")
+	var codes []string
+	included := map[string]bool{}
+	for _, err := range e.errors {
+		if !included[err.Code] {
+			included[err.Code] = true
+			codes = append(codes, err.Code)
+		}
+	}
+
+	return strings.Join(codes, "|")
+}
+
+// limits of length are returned to client, pattern is not
+func ruleParams(rule models.TextRule) map[string]interface{} {
+	return map[string]interface{}{"min": rule.MinLength, "max": rule.MaxLength}
 }
 
 const (
@@ -41,4 +74,13 @@ const (
 	InvalidCount                           = "invalid-count"
 	InvalidDate                            = "invalid-date"
 	InvalidMessageText                     = "invalid-message-text"
+	InvalidEmoji                           = "invalid-emoji"
+	InvalidAttachmentName                  = "invalid-attachment-name"
+	InvalidSearchText                      = "invalid-search-text"
+	InvalidTranscriptFormat                = "invalid-transcript-format"
+	InappropriateMessageText               = "inappropriate-message-text"
+	InappropriateMeetingTitle              = "inappropriate-meeting-title"
+	InappropriateMeetingDescription        = "inappropriate-meeting-description"
+	InappropriateMeetingLabel              = "inappropriate-meeting-label"
+	InappropriateUserNickname              = "inappropriate-user-nickname"
 )
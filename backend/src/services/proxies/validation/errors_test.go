@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"context"
+	mock "mock/services"
+	"models"
+	"services/messages"
+	"services/proxies/validation/plugins/validation"
+	"strings"
+	"testing"
+	"utils"
+)
+
+func TestValidationResults_ErrorContainsEachCodeOnce(t *testing.T) {
+	results := validationResults{}
+	results.CheckId("chat_id", 0)
+	results.CheckId("sender_id", 0)
+	results.Add("count", InvalidCount)
+
+	utils.AssertTrue(results.HasErrors(), t)
+	utils.AssertEqual(3, len(results.Fields()), t)
+	utils.AssertEqual(InvalidId+"|"+InvalidCount, results.Error(), t)
+}
+
+func TestValidationResults_ValidId(t *testing.T) {
+	results := validationResults{}
+	results.CheckId("chat_id", 1)
+
+	utils.AssertFalse(results.HasErrors(), t)
+}
+
+func TestMessagesProxy_FieldErrors(t *testing.T) {
+	proxy := NewMessagesProxy(messages.New(&mock.MessagesMockRepository), ContentChecker{})
+	text := strings.Repeat("д", validation.Rules().Message.MaxLength+1)
+	_, err := proxy.Save(context.Background(), models.Message{ChatId: 1, Text: text})
+
+	fields := err.(validationResults).Fields()
+	utils.AssertEqual(2, len(fields), t)
+	utils.AssertEqual("sender_id", fields[0].Field, t)
+	utils.AssertEqual(InvalidId, fields[0].Code, t)
+	utils.AssertEqual("text", fields[1].Field, t)
+	utils.AssertEqual(InvalidMessageText, fields[1].Code, t)
+	utils.AssertEqual(validation.Rules().Message.MaxLength, fields[1].Params["max"], t)
+}
+
+func TestMessagesProxy_UnicodeTextLengthIsCountedInSymbols(t *testing.T) {
+	proxy := NewMessagesProxy(messages.New(&mock.MessagesMockRepository), ContentChecker{})
+	// each symbol takes two bytes, so text would be too long in bytes
+	text := strings.Repeat("д", validation.Rules().Message.MaxLength)
+	message, err := proxy.Save(context.Background(), models.Message{ChatId: 1, SenderId: 1, Text: text})
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(text, message.Text, t)
+}
+
+func TestValidationResults_SameUsersPairIsInvalid(t *testing.T) {
+	results := validationResults{}
+	results.checkUsersPair(1, "blocked_user_id", 1)
+
+	fields := results.Fields()
+	utils.AssertEqual(1, len(fields), t)
+	utils.AssertEqual("blocked_user_id", fields[0].Field, t)
+}
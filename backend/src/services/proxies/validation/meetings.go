@@ -1,6 +1,8 @@
 package validation
 
 import (
+	"context"
+	"fmt"
 	"interfaces"
 	"models"
 	"services/proxies/validation/plugins/validation"
@@ -8,105 +10,125 @@ import (
 
 type MeetingsServiceProxy struct {
 	service interfaces.Meetings
+	content ContentChecker
 }
 
-func NewMeetingsServiceProxy(service interfaces.Meetings) MeetingsServiceProxy {
-	return MeetingsServiceProxy{service}
+func NewMeetingsServiceProxy(service interfaces.Meetings, content ContentChecker) MeetingsServiceProxy {
+	return MeetingsServiceProxy{service, content}
 }
 
-func (p MeetingsServiceProxy) CreateMeeting(adminId uint, settings models.AllSettings) error {
+func (p MeetingsServiceProxy) CreateMeeting(ctx context.Context, adminId uint, settings models.AllSettings) error {
 	validationResults := p.validateAllSettings(settings)
-	if !validation.ValidWholePositiveNumber(float64(adminId)) {
-		validationResults.Add(InvalidId)
-	}
+	validationResults.CheckId("admin_id", adminId)
+	review := p.content.review()
+	checkSettingsContent(review, MeetingContent, adminId, &settings, &validationResults)
 
 	if validationResults.HasErrors() {
 		return validationResults
-	} else {
-		return p.service.CreateMeeting(adminId, settings)
 	}
+
+	err := p.service.CreateMeeting(ctx, adminId, settings)
+	review.saveFlags(ctx, err)
+	return err
+}
+
+func checkSettingsContent(
+	review *contentReview, kind string, sourceId uint, settings *models.AllSettings, results *validationResults,
+) {
+	review.check(kind, sourceId, "title", &settings.Title, results, InappropriateMeetingTitle)
+	review.check(kind, sourceId, "description", &settings.Description, results, InappropriateMeetingDescription)
+	review.check(kind, sourceId, "label", &settings.Label, results, InappropriateMeetingLabel)
 }
 
 func (p MeetingsServiceProxy) validateAllSettings(settings models.AllSettings) validationResults {
 	validationResults := validationResults{}
 	if !validation.ValidTitle(settings.Title) {
-		validationResults.Add(InvalidMeetingTitle)
+		validationResults.AddWithParams("title", InvalidMeetingTitle, ruleParams(validation.Rules().Title))
 	}
 	if !validation.ValidDescription(settings.Description) {
-		validationResults.Add(InvalidMeetingDescription)
+		validationResults.AddWithParams("description", InvalidMeetingDescription, ruleParams(validation.Rules().Description))
 	}
-	for _, tag := range settings.Tags {
+	for i, tag := range settings.Tags {
 		if !validation.ValidName(tag) {
-			validationResults.Add(InvalidMeetingTag)
-			break
+			validationResults.AddWithParams(fmt.Sprintf("tags[%d]", i), InvalidMeetingTag,
+				ruleParams(validation.Rules().Name))
 		}
 	}
 	if !validation.ValidDate(settings.DateTime.Format(validation.DateFormat)) {
-		validationResults.Add(InvalidDate)
+		validationResults.Add("date_time", InvalidDate)
 	}
 	if !validation.ValidWholePositiveNumber(float64(settings.MaxUsers)) {
-		validationResults.Add(InvalidMeetingMaxUsers)
+		validationResults.Add("max_users", InvalidMeetingMaxUsers)
 	}
 	if !validation.ValidWholePositiveNumber(float64(settings.Duration)) {
-		validationResults.Add(InvalidMeetingDuration)
+		validationResults.Add("duration", InvalidMeetingDuration)
 	}
 	if !validation.ValidWholePositiveNumber(float64(settings.MinAge)) {
-		validationResults.Add(InvalidMeetingMinAge)
+		validationResults.Add("min_age", InvalidMeetingMinAge)
 	}
 	if !validation.ValidGender(settings.Gender) {
-		validationResults.Add(InvalidMeetingGender)
+		validationResults.Add("gender", InvalidMeetingGender)
 	}
 	if !validation.ValidLatitude(float64(settings.GetLatitude())) {
-		validationResults.Add(InvalidMeetingLatitude)
+		validationResults.Add("latitude", InvalidMeetingLatitude)
 	}
 	if !validation.ValidLongitude(float64(settings.GetLongitude())) {
-		validationResults.Add(InvalidMeetingLongitude)
+		validationResults.Add("longitude", InvalidMeetingLongitude)
 	}
 	if !validation.ValidTitle(settings.Label) {
-		validationResults.Add(InvalidMeetingLabel)
+		validationResults.AddWithParams("label", InvalidMeetingLabel, ruleParams(validation.Rules().Title))
 	}
 
 	return validationResults
 }
 
-func (p MeetingsServiceProxy) DeleteMeeting(meetingId uint) error {
-	if !validation.ValidWholePositiveNumber(float64(meetingId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p MeetingsServiceProxy) DeleteMeeting(ctx context.Context, meetingId uint) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("meeting_id", meetingId)
+	if validationResults.HasErrors() {
 		return validationResults
 	}
 
-	return p.service.DeleteMeeting(meetingId)
+	return p.service.DeleteMeeting(ctx, meetingId)
 }
 
-func (p MeetingsServiceProxy) UpdateSettings(meetingId uint, settings models.AllSettings) error {
+func (p MeetingsServiceProxy) UpdateSettings(ctx context.Context, meetingId uint, settings models.AllSettings) error {
+	// only content errors are returned, other settings errors are ignored by update as before
+	contentResults := validationResults{}
 	validationResults := p.validateAllSettings(settings)
 	if !validation.ValidWholePositiveNumber(float64(meetingId)) {
-		validationResults.Add(InvalidId)
+		validationResults.Add("meeting_id", InvalidId)
 		return validationResults
 	}
+	review := p.content.review()
+	checkSettingsContent(review, MeetingSettingsContent, meetingId, &settings, &contentResults)
+	if contentResults.HasErrors() {
+		return contentResults
+	}
 
-	return p.service.UpdateSettings(meetingId, settings)
+	err := p.service.UpdateSettings(ctx, meetingId, settings)
+	review.saveFlags(ctx, err)
+	return err
 }
 
-func (p MeetingsServiceProxy) AddUserToMeeting(meetingId, userId uint) error {
-	if !validation.ValidWholePositiveNumber(float64(meetingId)) ||
-		!validation.ValidWholePositiveNumber(float64(userId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p MeetingsServiceProxy) AddUserToMeeting(ctx context.Context, meetingId, userId uint) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("meeting_id", meetingId)
+	validationResults.CheckId("user_id", userId)
+	if validationResults.HasErrors() {
 		return validationResults
 	}
 
-	return p.service.AddUserToMeeting(meetingId, userId)
+	return p.service.AddUserToMeeting(ctx, meetingId, userId)
 }
 
-func (p MeetingsServiceProxy) KickUserFromMeeting(meetingId, userId uint) error {
-	if !validation.ValidWholePositiveNumber(float64(meetingId)) ||
-		!validation.ValidWholePositiveNumber(float64(userId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p MeetingsServiceProxy) KickUserFromMeeting(ctx context.Context, meetingId, userId uint) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("meeting_id", meetingId)
+	validationResults.CheckId("user_id", userId)
+	if validationResults.HasErrors() {
 		return validationResults
 	}
 
-	return p.service.KickUserFromMeeting(meetingId, userId)
+	return p.service.KickUserFromMeeting(ctx, meetingId, userId)
 }
@@ -1,9 +1,9 @@
 package validation
 
 import (
+	"context"
 	"interfaces"
 	"models"
-	"services/proxies/validation/plugins/validation"
 )
 
 type MeetingsAccessorServiceProxy struct {
@@ -14,26 +14,30 @@ func NewMeetingsAccessorServiceProxy(service interfaces.MeetingsAccessorService)
 	return MeetingsAccessorServiceProxy{service}
 }
 
-func (p MeetingsAccessorServiceProxy) GetFullMeetingInfo(meetingId uint) (models.PrivateMeeting, error) {
-	if !validation.ValidWholePositiveNumber(float64(meetingId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p MeetingsAccessorServiceProxy) GetFullMeetingInfo(
+	ctx context.Context, meetingId uint,
+) (models.PrivateMeeting, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("meeting_id", meetingId)
+	if validationResults.HasErrors() {
 		return models.PrivateMeeting{}, validationResults
 	}
 
-	return p.service.GetFullMeetingInfo(meetingId)
+	return p.service.GetFullMeetingInfo(ctx, meetingId)
 }
 
-func (p MeetingsAccessorServiceProxy) GetPublicMeetings() ([]models.PublicMeeting, error) {
-	return p.service.GetPublicMeetings()
+func (p MeetingsAccessorServiceProxy) GetPublicMeetings(ctx context.Context) ([]models.PublicMeeting, error) {
+	return p.service.GetPublicMeetings(ctx)
 }
 
-func (p MeetingsAccessorServiceProxy) GetExtendedMeetings(userId uint) ([]models.ExtendedMeeting, error) {
-	if !validation.ValidWholePositiveNumber(float64(userId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p MeetingsAccessorServiceProxy) GetExtendedMeetings(
+	ctx context.Context, userId uint,
+) ([]models.ExtendedMeeting, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("user_id", userId)
+	if validationResults.HasErrors() {
 		return nil, validationResults
 	}
 
-	return p.service.GetExtendedMeetings(userId)
+	return p.service.GetExtendedMeetings(ctx, userId)
 }
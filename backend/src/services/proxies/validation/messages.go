@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"services/proxies/validation/plugins/validation"
@@ -8,61 +9,93 @@ import (
 
 type MessagesProxy struct {
 	service interfaces.Messages
+	content ContentChecker
 }
 
-func NewMessagesProxy(service interfaces.Messages) MessagesProxy {
-	return MessagesProxy{service}
+func NewMessagesProxy(service interfaces.Messages, content ContentChecker) MessagesProxy {
+	return MessagesProxy{service, content}
 }
 
-func (p MessagesProxy) Save(message models.Message) error {
+func (p MessagesProxy) Save(ctx context.Context, message models.Message) (models.Message, error) {
 	validationResults := validationResults{}
-	if !validation.ValidWholePositiveNumber(float64(message.ChatId)) ||
-		!validation.ValidWholePositiveNumber(float64(message.SenderId)) {
-		validationResults.Add(InvalidId)
+	validationResults.CheckId("chat_id", message.ChatId)
+	validationResults.CheckId("sender_id", message.SenderId)
+	if message.ReplyTo != nil {
+		validationResults.CheckId("reply_to", *message.ReplyTo)
+	}
+	if !validIds(message.AttachmentIds) {
+		validationResults.Add("attachment_ids", InvalidId)
 	}
 	if !validation.ValidDate(message.SendingTime.Format(validation.DateFormat)) {
-		validationResults.Add(InvalidDate)
+		validationResults.Add("sending_time", InvalidDate)
 	}
-	if !validation.ValidMessage(message.Text) {
-		validationResults.Add(InvalidMessageText)
+	// message with attachments can be sent without text
+	if !(message.Text == "" && len(message.AttachmentIds) != 0) && !validation.ValidMessage(message.Text) {
+		validationResults.AddWithParams("text", InvalidMessageText, ruleParams(validation.Rules().Message))
 	}
+	review := p.content.review()
+	review.check(MessageContent, message.SenderId, "text", &message.Text, &validationResults, InappropriateMessageText)
 
 	if validationResults.HasErrors() {
-		return validationResults
-	} else {
-		return p.service.Save(message)
+		return models.Message{}, validationResults
 	}
+
+	savedMessage, err := p.service.Save(ctx, message)
+	review.saveFlags(ctx, err)
+	return savedMessage, err
 }
 
-func (p MessagesProxy) GetLastMessages(chatId, count uint) ([]models.Message, error) {
+func (p MessagesProxy) GetLastMessages(ctx context.Context, chatId, count, viewerId uint) ([]models.Message, error) {
 	validationResults := validationResults{}
-	if !validation.ValidWholePositiveNumber(float64(chatId)) {
-		validationResults.Add(InvalidId)
-	}
+	validationResults.CheckId("chat_id", chatId)
 	if !validation.ValidWholePositiveNumber(float64(count)) {
-		validationResults.Add(InvalidCount)
+		validationResults.Add("count", InvalidCount)
 	}
 
 	if validationResults.HasErrors() {
 		return nil, validationResults
 	} else {
-		return p.service.GetLastMessages(chatId, count)
+		return p.service.GetLastMessages(ctx, chatId, count, viewerId)
 	}
 }
 
-func (p MessagesProxy) GetLastMessagesAfter(chatId, messageId, count uint) ([]models.Message, error) {
+func (p MessagesProxy) GetLastMessagesAfter(
+	ctx context.Context, chatId, messageId, count, viewerId uint,
+) ([]models.Message, error) {
 	validationResults := validationResults{}
-	if !validation.ValidWholePositiveNumber(float64(chatId)) ||
-		!validation.ValidWholePositiveNumber(float64(messageId)) {
-		validationResults.Add(InvalidId)
-	}
+	validationResults.CheckId("chat_id", chatId)
+	validationResults.CheckId("message_id", messageId)
 	if !validation.ValidWholePositiveNumber(float64(count)) {
-		validationResults.Add(InvalidCount)
+		validationResults.Add("count", InvalidCount)
 	}
 
 	if validationResults.HasErrors() {
 		return nil, validationResults
 	} else {
-		return p.service.GetLastMessagesAfter(chatId, messageId, count)
+		return p.service.GetLastMessagesAfter(ctx, chatId, messageId, count, viewerId)
+	}
+}
+
+func (p MessagesProxy) GetThread(ctx context.Context, rootId, afterId, count, viewerId uint) (models.Thread, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("root_id", rootId)
+	if !validation.ValidWholePositiveNumber(float64(count)) {
+		validationResults.Add("count", InvalidCount)
+	}
+
+	if validationResults.HasErrors() {
+		return models.Thread{}, validationResults
+	} else {
+		return p.service.GetThread(ctx, rootId, afterId, count, viewerId)
 	}
 }
+
+func validIds(ids []uint) bool {
+	for _, id := range ids {
+		if !validation.ValidWholePositiveNumber(float64(id)) {
+			return false
+		}
+	}
+
+	return true
+}
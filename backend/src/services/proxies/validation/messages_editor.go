@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"services/proxies/validation/plugins/validation"
+)
+
+type MessagesEditorProxy struct {
+	service interfaces.MessagesEditor
+}
+
+func NewMessagesEditorProxy(service interfaces.MessagesEditor) MessagesEditorProxy {
+	return MessagesEditorProxy{service}
+}
+
+func (p MessagesEditorProxy) EditMessage(
+	ctx context.Context, request models.EditMessageRequest,
+) (models.Message, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("message_id", request.MessageId)
+	validationResults.CheckId("user_id", request.UserId)
+	if !validation.ValidMessage(request.Text) {
+		validationResults.AddWithParams("text", InvalidMessageText, ruleParams(validation.Rules().Message))
+	}
+
+	if validationResults.HasErrors() {
+		return models.Message{}, validationResults
+	} else {
+		return p.service.EditMessage(ctx, request)
+	}
+}
+
+func (p MessagesEditorProxy) DeleteMessage(
+	ctx context.Context, request models.DeleteMessageRequest,
+) (models.Message, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("message_id", request.MessageId)
+	validationResults.CheckId("user_id", request.UserId)
+	if validationResults.HasErrors() {
+		return models.Message{}, validationResults
+	}
+
+	return p.service.DeleteMessage(ctx, request)
+}
+
+func (p MessagesEditorProxy) GetMessageEdits(ctx context.Context, messageId uint) ([]models.MessageEdit, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("message_id", messageId)
+	if validationResults.HasErrors() {
+		return nil, validationResults
+	}
+
+	return p.service.GetMessageEdits(ctx, messageId)
+}
+
+func (p MessagesEditorProxy) PinMessage(ctx context.Context, request models.PinMessageRequest) (models.Message, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("message_id", request.MessageId)
+	validationResults.CheckId("user_id", request.UserId)
+	if validationResults.HasErrors() {
+		return models.Message{}, validationResults
+	}
+
+	return p.service.PinMessage(ctx, request)
+}
+
+func (p MessagesEditorProxy) UnpinMessage(
+	ctx context.Context, request models.PinMessageRequest,
+) (models.Message, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("message_id", request.MessageId)
+	validationResults.CheckId("user_id", request.UserId)
+	if validationResults.HasErrors() {
+		return models.Message{}, validationResults
+	}
+
+	return p.service.UnpinMessage(ctx, request)
+}
+
+func (p MessagesEditorProxy) GetPinnedMessages(ctx context.Context, chatId uint) ([]models.Message, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("chat_id", chatId)
+	if validationResults.HasErrors() {
+		return nil, validationResults
+	}
+
+	return p.service.GetPinnedMessages(ctx, chatId)
+}
@@ -0,0 +1,30 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"services/proxies/validation/plugins/validation"
+)
+
+type NotificationsProxy struct {
+	service interfaces.Notifications
+}
+
+func NewNotificationsProxy(service interfaces.Notifications) NotificationsProxy {
+	return NotificationsProxy{service}
+}
+
+func (p NotificationsProxy) GetNotifications(ctx context.Context, userId, count uint) ([]models.Notification, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("user_id", userId)
+	if !validation.ValidWholePositiveNumber(float64(count)) {
+		validationResults.Add("count", InvalidCount)
+	}
+
+	if validationResults.HasErrors() {
+		return nil, validationResults
+	} else {
+		return p.service.GetNotifications(ctx, userId, count)
+	}
+}
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"services/proxies/validation/plugins/validation"
@@ -15,19 +16,29 @@ func NewParticipationServiceProxy(service interfaces.ParticipationService) Parti
 }
 
 func (p ParticipationServiceProxy) HandleParticipationRequest(
-	request models.ParticipationRequest) (models.RejectInfo, error) {
+	ctx context.Context, request models.ParticipationRequest) (models.RejectInfo, error) {
 	validationResults := validationResults{}
-	if !validation.ValidWholePositiveNumber(float64(request.UserId)) ||
-		!validation.ValidWholePositiveNumber(float64(request.MeetingId)) {
-		validationResults.Add(InvalidId)
-	}
+	validationResults.CheckId("user_id", request.UserId)
+	validationResults.CheckId("meeting_id", request.MeetingId)
 	if request.RequestDescription != "" && !validation.ValidDescription(request.RequestDescription) {
-		validationResults.Add(InvalidParticipationRequestDescription)
+		validationResults.AddWithParams("request_description", InvalidParticipationRequestDescription,
+			ruleParams(validation.Rules().Description))
 	}
 
 	if validationResults.HasErrors() {
 		return models.RejectInfo{}, validationResults
 	} else {
-		return p.service.HandleParticipationRequest(request)
+		return p.service.HandleParticipationRequest(ctx, request)
 	}
 }
+
+func (p ParticipationServiceProxy) DeclineParticipationRequest(ctx context.Context, meetingId, userId uint) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("meeting_id", meetingId)
+	validationResults.CheckId("user_id", userId)
+	if validationResults.HasErrors() {
+		return validationResults
+	}
+
+	return p.service.DeclineParticipationRequest(ctx, meetingId, userId)
+}
@@ -0,0 +1,191 @@
+package content_filter
+
+import (
+	"models"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// what to do with text that violates filter rules
+const (
+	RejectMode = "reject"
+	MaskMode   = "mask"
+	// text is saved as is and sent to moderators
+	FlagMode = "flag"
+)
+
+// violated rules
+const (
+	ProfanityReason     = "profanity"
+	LinkSpamReason      = "link-spam"
+	RepeatedCharsReason = "repeated-characters"
+)
+
+const (
+	maskSymbol = '*'
+	// word ending with this symbol matches all words with the same prefix
+	prefixSymbol = "*"
+)
+
+var linkReg = regexp.MustCompile(
+	`(?i)(?:https?://|www\.)\S+|\b[a-z0-9-]+\.(?:com|net|org|ru|info|biz|io|me|xyz|top|online|site)\b(?:/\S*)?`)
+
+type Filter struct {
+	mode             string
+	words            map[string]bool
+	prefixes         []string
+	maxLinks         int
+	maxRepeatedChars int
+}
+
+type Result struct {
+	// empty if text is clean
+	Reasons []string
+	// text with masked words and links above limit, long sequences of the same character are shortened
+	Masked string
+}
+
+// words of all languages are checked together, because language of text is unknown
+func New(config models.ContentFilterConfig) Filter {
+	filter := Filter{
+		mode:             config.Mode,
+		words:            map[string]bool{},
+		maxLinks:         config.MaxLinks,
+		maxRepeatedChars: config.MaxRepeatedChars,
+	}
+	if filter.mode != MaskMode && filter.mode != FlagMode {
+		filter.mode = RejectMode
+	}
+
+	for _, words := range config.Words {
+		for _, word := range words {
+			word = strings.ToLower(strings.TrimSpace(word))
+			if strings.HasSuffix(word, prefixSymbol) {
+				filter.prefixes = append(filter.prefixes, strings.TrimSuffix(word, prefixSymbol))
+			} else if word != "" {
+				filter.words[word] = true
+			}
+		}
+	}
+
+	return filter
+}
+
+func (f Filter) Mode() string {
+	return f.mode
+}
+
+func (f Filter) Check(text string) Result {
+	var result Result
+
+	masked, repeated := f.shortenRepeatedChars(text)
+	if repeated {
+		result.Reasons = append(result.Reasons, RepeatedCharsReason)
+	}
+
+	masked, profane := f.maskWords(masked)
+	if profane {
+		result.Reasons = append(result.Reasons, ProfanityReason)
+	}
+
+	masked, spam := f.maskLinks(masked)
+	if spam {
+		result.Reasons = append(result.Reasons, LinkSpamReason)
+	}
+
+	result.Masked = masked
+	return result
+}
+
+func (f Filter) shortenRepeatedChars(text string) (string, bool) {
+	if f.maxRepeatedChars <= 0 {
+		return text, false
+	}
+
+	var builder strings.Builder
+	var previous rune
+	repeated, found := 0, false
+	for i, r := range text {
+		if i != 0 && r == previous {
+			repeated++
+		} else {
+			previous, repeated = r, 1
+		}
+
+		if repeated > f.maxRepeatedChars {
+			found = true
+			continue
+		}
+		builder.WriteRune(r)
+	}
+
+	return builder.String(), found
+}
+
+// word is a sequence of letters and digits
+func (f Filter) maskWords(text string) (string, bool) {
+	runes := []rune(text)
+	found := false
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+		if f.isProfane(strings.ToLower(string(runes[start:end]))) {
+			found = true
+			for i := start; i < end; i++ {
+				runes[i] = maskSymbol
+			}
+		}
+		start = end
+	}
+
+	return string(runes), found
+}
+
+func (f Filter) isProfane(word string) bool {
+	if f.words[word] {
+		return true
+	}
+
+	for _, prefix := range f.prefixes {
+		if strings.HasPrefix(word, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// links within limit are kept
+func (f Filter) maskLinks(text string) (string, bool) {
+	if f.maxLinks < 0 {
+		return text, false
+	}
+
+	links := linkReg.FindAllStringIndex(text, -1)
+	if len(links) <= f.maxLinks {
+		return text, false
+	}
+
+	var builder strings.Builder
+	previousEnd := 0
+	for _, link := range links[f.maxLinks:] {
+		builder.WriteString(text[previousEnd:link[0]])
+		builder.WriteString(strings.Repeat(string(maskSymbol), len([]rune(text[link[0]:link[1]]))))
+		previousEnd = link[1]
+	}
+	builder.WriteString(text[previousEnd:])
+
+	return builder.String(), true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
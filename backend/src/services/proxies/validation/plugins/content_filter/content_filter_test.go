@@ -0,0 +1,61 @@
+package content_filter
+
+import (
+	"mock/plugins"
+	"strings"
+	"testing"
+	"utils"
+)
+
+var filter = New(plugins.ContentFilterConfig)
+
+func TestFilter_CleanTexts(t *testing.T) {
+	for _, text := range plugins.CleanTexts {
+		result := filter.Check(text)
+
+		utils.AssertEqual(0, len(result.Reasons), t)
+		utils.AssertEqual(text, result.Masked, t)
+	}
+}
+
+func TestFilter_Profanity(t *testing.T) {
+	result := filter.Check("You BadWord, это плохослово")
+
+	utils.AssertEqual(ProfanityReason, strings.Join(result.Reasons, ","), t)
+	utils.AssertEqual("You *******, это **********", result.Masked, t)
+}
+
+func TestFilter_ProfanityPrefix(t *testing.T) {
+	result := filter.Check("scammers_everywhere")
+
+	utils.AssertEqual(ProfanityReason, strings.Join(result.Reasons, ","), t)
+	utils.AssertEqual("********_everywhere", result.Masked, t)
+}
+
+func TestFilter_LinkSpam(t *testing.T) {
+	result := filter.Check("visit www.first.com and second.ru/page")
+
+	utils.AssertEqual(LinkSpamReason, strings.Join(result.Reasons, ","), t)
+	utils.AssertEqual("visit www.first.com and **************", result.Masked, t)
+}
+
+func TestFilter_RepeatedChars(t *testing.T) {
+	result := filter.Check("Heeeeeeey")
+
+	utils.AssertEqual(RepeatedCharsReason, strings.Join(result.Reasons, ","), t)
+	utils.AssertEqual("Heeeey", result.Masked, t)
+}
+
+func TestFilter_SeveralReasons(t *testing.T) {
+	result := filter.Check("badword!!!!!!")
+
+	utils.AssertEqual(RepeatedCharsReason+","+ProfanityReason, strings.Join(result.Reasons, ","), t)
+	utils.AssertEqual("*******!!!!", result.Masked, t)
+}
+
+func TestFilter_UnknownModeIsReject(t *testing.T) {
+	config := plugins.ContentFilterConfig
+	config.Mode = "unknown"
+
+	utils.AssertEqual(RejectMode, New(config).Mode(), t)
+}
@@ -0,0 +1,130 @@
+package validation
+
+import (
+	"errors"
+	"models"
+	"plugins/logger"
+	"regexp"
+)
+
+var InvalidRules = errors.New("invalid validation rules")
+
+type compiledRule struct {
+	models.TextRule
+	reg *regexp.Regexp
+}
+
+type compiledRules struct {
+	rules                                  models.ValidationRules
+	nickname, password, name, title        compiledRule
+	description, message, searchText, file compiledRule
+	emoji                                  compiledRule
+}
+
+var rules = mustCompileRules(DefaultRules())
+
+func DefaultRules() models.ValidationRules {
+	return models.ValidationRules{
+		Nickname:    models.TextRule{MinLength: 3, MaxLength: 16, Pattern: nicknamePattern},
+		Password:    models.TextRule{MinLength: 8, MaxLength: 32, Pattern: passwordPattern},
+		Name:        models.TextRule{MinLength: 3, MaxLength: 16, Pattern: namePattern},
+		Title:       models.TextRule{MinLength: 3, MaxLength: 255, Pattern: lineTextPattern},
+		Description: models.TextRule{MinLength: 15, MaxLength: 1024, Pattern: multilineTextPattern},
+		Message:     models.TextRule{MinLength: 1, MaxLength: 1024, Pattern: multilineTextPattern},
+		// search text is parsed by DB, so any symbols are allowed
+		SearchText: models.TextRule{MinLength: 1, MaxLength: 255},
+		// file name is stored only for downloading, so almost any name is allowed
+		FileName: models.TextRule{MinLength: 1, MaxLength: 255},
+		// emoji can be sequence of symbols joined by zero width joiner with modifiers
+		Emoji: models.TextRule{MinLength: 1, MaxLength: 8},
+	}
+}
+
+// current rules with defaults of not configured values
+func Rules() models.ValidationRules {
+	return rules.rules
+}
+
+// must be called at startup before requests are handled, zero values of configured rules keep default values
+func SetRules(configured models.ValidationRules) error {
+	merged := DefaultRules()
+	for _, r := range []struct {
+		name               string
+		configured, merged *models.TextRule
+	}{
+		{"nickname", &configured.Nickname, &merged.Nickname},
+		{"password", &configured.Password, &merged.Password},
+		{"name", &configured.Name, &merged.Name},
+		{"title", &configured.Title, &merged.Title},
+		{"description", &configured.Description, &merged.Description},
+		{"message", &configured.Message, &merged.Message},
+		{"search_text", &configured.SearchText, &merged.SearchText},
+		{"file_name", &configured.FileName, &merged.FileName},
+		{"emoji", &configured.Emoji, &merged.Emoji},
+	} {
+		if r.configured.MinLength != 0 {
+			r.merged.MinLength = r.configured.MinLength
+		}
+		if r.configured.MaxLength != 0 {
+			r.merged.MaxLength = r.configured.MaxLength
+		}
+		if r.configured.Pattern != "" {
+			r.merged.Pattern = r.configured.Pattern
+		}
+
+		if r.merged.MinLength < 0 || r.merged.MinLength > r.merged.MaxLength {
+			logger.ErrorF("Validation rule %s has invalid length limits: %d-%d",
+				r.name, r.merged.MinLength, r.merged.MaxLength)
+			return InvalidRules
+		}
+	}
+
+	compiled, err := compileRules(merged)
+	if err != nil {
+		logger.ErrorF("Error while compiling validation rules: %v", err)
+		return InvalidRules
+	}
+
+	rules = compiled
+	return nil
+}
+
+func mustCompileRules(r models.ValidationRules) compiledRules {
+	compiled, err := compileRules(r)
+	if err != nil {
+		panic(err)
+	}
+
+	return compiled
+}
+
+func compileRules(r models.ValidationRules) (compiled compiledRules, err error) {
+	compiled.rules = r
+	for _, rule := range []struct {
+		target *compiledRule
+		rule   models.TextRule
+	}{
+		{&compiled.nickname, r.Nickname},
+		{&compiled.password, r.Password},
+		{&compiled.name, r.Name},
+		{&compiled.title, r.Title},
+		{&compiled.description, r.Description},
+		{&compiled.message, r.Message},
+		{&compiled.searchText, r.SearchText},
+		{&compiled.file, r.FileName},
+		{&compiled.emoji, r.Emoji},
+	} {
+		rule.target.TextRule = rule.rule
+		if rule.rule.Pattern != "" {
+			if rule.target.reg, err = regexp.Compile(rule.rule.Pattern); err != nil {
+				return compiledRules{}, err
+			}
+		}
+	}
+
+	return compiled, nil
+}
+
+func (r compiledRule) valid(s string) bool {
+	return (r.reg == nil || r.reg.MatchString(s)) && inLength(s, r.MinLength, r.MaxLength)
+}
@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"models"
+	"testing"
+	"utils"
+)
+
+func resetRules() {
+	_ = SetRules(models.ValidationRules{})
+}
+
+func TestSetRules_NotConfiguredValuesAreDefault(t *testing.T) {
+	defer resetRules()
+
+	err := SetRules(models.ValidationRules{Nickname: models.TextRule{MaxLength: 20}})
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(20, Rules().Nickname.MaxLength, t)
+	utils.AssertEqual(DefaultRules().Nickname.MinLength, Rules().Nickname.MinLength, t)
+	utils.AssertEqual(DefaultRules().Nickname.Pattern, Rules().Nickname.Pattern, t)
+	utils.AssertEqual(DefaultRules().Title, Rules().Title, t)
+}
+
+func TestSetRules_ConfiguredRulesAreApplied(t *testing.T) {
+	defer resetRules()
+
+	err := SetRules(models.ValidationRules{
+		Nickname: models.TextRule{MaxLength: 20},
+		Password: models.TextRule{Pattern: `^[0-9]+$`},
+	})
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(ValidNickname("very_long_nickname"), t)
+	utils.AssertTrue(ValidPassword("1234567890"), t)
+	utils.AssertFalse(ValidPassword("mYStRoNg*PwD12"), t)
+}
+
+func TestSetRules_InvalidLengthLimits(t *testing.T) {
+	defer resetRules()
+
+	err := SetRules(models.ValidationRules{Title: models.TextRule{MinLength: 300}})
+
+	utils.AssertErrorsEqual(InvalidRules, err, t)
+	utils.AssertEqual(DefaultRules(), Rules(), t)
+}
+
+func TestSetRules_InvalidPattern(t *testing.T) {
+	defer resetRules()
+
+	err := SetRules(models.ValidationRules{Name: models.TextRule{Pattern: `^[a-z+$`}})
+
+	utils.AssertErrorsEqual(InvalidRules, err, t)
+	utils.AssertEqual(DefaultRules(), Rules(), t)
+}
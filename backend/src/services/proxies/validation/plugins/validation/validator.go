@@ -3,25 +3,20 @@ package validation
 import (
 	"fmt"
 	"github.com/asaskevich/govalidator"
-	"regexp"
+	"unicode"
+	"unicode/utf8"
 )
 
 const (
-	nicknamePattern = `^[-a-zA-Z0-9_$]{3,16}$`
-	passwordPattern = `^[-a-zA-Z0-9_$*%&]{8,32}$`
-	namePattern     = `^[a-zA-Za-zA-Zа-яА-ЯёЁ0-9. ]{3,16}$`
-	textPattern     = `^[-_%$?:.,*()a-zA-Zа-яА-ЯёЁ0-9 ]+$`
-
-	shortTextMinLength, shortTextMaxLength = 3, 255
-	longTextMinLength, longTextMaxLength   = 15, 1024
-	DateFormat                             = `02-15-2006 15:04:05`
-)
-
-var (
-	textReg     = regexp.MustCompile(textPattern)
-	nameReg     = regexp.MustCompile(namePattern)
-	nicknameReg = regexp.MustCompile(nicknamePattern)
-	passwordReg = regexp.MustCompile(passwordPattern)
+	nicknamePattern = `^[-\p{L}\p{N}_$]+$`
+	passwordPattern = `^[-a-zA-Z0-9_$*%&]+$`
+	namePattern     = `^[\p{L}\p{M}\p{N}.' -]+$`
+	// letters, digits, punctuation, symbols (emoji) and spaces except angle brackets
+	lineTextPattern = `^[^<>\p{C}\p{Zl}\p{Zp}]+$`
+	// the same as line text but with line breaks and tabs
+	multilineTextPattern = `^(?:[^<>\p{C}\p{Zl}\p{Zp}]|[\r\n\t])+$`
+
+	DateFormat = `02-15-2006 15:04:05`
 )
 
 func ValidEmail(email string) bool {
@@ -29,7 +24,7 @@ func ValidEmail(email string) bool {
 }
 
 func ValidNickname(n string) bool {
-	return nicknameReg.MatchString(n)
+	return rules.nickname.valid(n)
 }
 
 func ValidLongitude(l float64) bool {
@@ -45,24 +40,61 @@ func ValidWholePositiveNumber(n float64) bool {
 }
 
 func ValidPassword(p string) bool {
-	return passwordReg.MatchString(p)
+	return rules.password.valid(p)
 }
 
+// title is single line text
 func ValidTitle(t string) bool {
-	return textReg.MatchString(trim(t)) && govalidator.InRange(len(t), shortTextMinLength, shortTextMaxLength)
+	return trim(t) != "" && rules.title.valid(t)
 }
 
 func ValidDescription(d string) bool {
-	return textReg.MatchString(trim(d)) && govalidator.InRange(len(d), longTextMinLength, longTextMaxLength)
+	return trim(d) != "" && rules.description.valid(d)
 }
 
 func ValidMessage(m string) bool {
-	// TODO must be improved
-	return textReg.MatchString(trim(m)) && len(m) < longTextMaxLength
+	return trim(m) != "" && rules.message.valid(m)
+}
+
+func ValidEmoji(e string) bool {
+	if !rules.emoji.valid(e) {
+		return false
+	}
+
+	hasSymbol := false
+	for _, r := range e {
+		switch {
+		case unicode.Is(unicode.So, r):
+			hasSymbol = true
+		case !unicode.In(r, unicode.Sk, unicode.Mn, unicode.Me, unicode.Cf):
+			return false
+		}
+	}
+
+	return hasSymbol
+}
+
+func ValidFileName(n string) bool {
+	if trim(n) == "" || n == "." || n == ".." || !rules.file.valid(n) {
+		return false
+	}
+
+	for _, r := range n {
+		if r == '/' || r == '\\' || unicode.IsControl(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func ValidSearchText(s string) bool {
+	return trim(s) != "" && rules.searchText.valid(s)
 }
 
 func ValidName(n string) bool {
-	return nameReg.MatchString(trim(n))
+	n = trim(n)
+	return rules.name.valid(n)
 }
 
 func ValidDate(d string) bool {
@@ -73,10 +105,18 @@ func ValidGender(g string) bool {
 	return g == "male" || g == "female" || g == ""
 }
 
+func ValidTranscriptFormat(f string) bool {
+	return f == "json" || f == "csv" || f == "text"
+}
+
 func ValidURL(u string) bool {
 	return govalidator.IsURL(u)
 }
 
+func inLength(s string, min, max int) bool {
+	return govalidator.InRange(utf8.RuneCountInString(s), min, max)
+}
+
 func trim(s string) string {
 	return govalidator.Trim(s, "")
 }
@@ -138,6 +138,54 @@ func TestValidDate_False(t *testing.T) {
 	}
 }
 
+func TestValidEmoji_True(t *testing.T) {
+	for _, e := range plugins.ValidEmojis {
+		utils.AssertTrue(ValidEmoji(e), t)
+	}
+}
+
+func TestValidEmoji_False(t *testing.T) {
+	for _, e := range plugins.InvalidEmojis {
+		utils.AssertFalse(ValidEmoji(e), t)
+	}
+}
+
+func TestValidFileName_True(t *testing.T) {
+	for _, n := range plugins.ValidFileNames {
+		utils.AssertTrue(ValidFileName(n), t)
+	}
+}
+
+func TestValidFileName_False(t *testing.T) {
+	for _, n := range plugins.InvalidFileNames {
+		utils.AssertFalse(ValidFileName(n), t)
+	}
+}
+
+func TestValidSearchText_True(t *testing.T) {
+	for _, s := range plugins.ValidSearchTexts {
+		utils.AssertTrue(ValidSearchText(s), t)
+	}
+}
+
+func TestValidSearchText_False(t *testing.T) {
+	for _, s := range plugins.InvalidSearchTexts {
+		utils.AssertFalse(ValidSearchText(s), t)
+	}
+}
+
+func TestValidTranscriptFormat_True(t *testing.T) {
+	for _, f := range plugins.ValidTranscriptFormats {
+		utils.AssertTrue(ValidTranscriptFormat(f), t)
+	}
+}
+
+func TestValidTranscriptFormat_False(t *testing.T) {
+	for _, f := range plugins.InvalidTranscriptFormats {
+		utils.AssertFalse(ValidTranscriptFormat(f), t)
+	}
+}
+
 func TestValidGender_True(t *testing.T) {
 	for _, g := range plugins.ValidGenders {
 		utils.AssertTrue(ValidGender(g), t)
@@ -0,0 +1,50 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"services/proxies/validation/plugins/validation"
+)
+
+type ReactionsProxy struct {
+	service interfaces.Reactions
+}
+
+func NewReactionsProxy(service interfaces.Reactions) ReactionsProxy {
+	return ReactionsProxy{service}
+}
+
+func (p ReactionsProxy) AddReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	if err := p.validate(reaction); err != nil {
+		return models.ReactionChange{}, err
+	}
+
+	return p.service.AddReaction(ctx, reaction)
+}
+
+func (p ReactionsProxy) RemoveReaction(
+	ctx context.Context, reaction models.MessageReaction,
+) (models.ReactionChange, error) {
+	if err := p.validate(reaction); err != nil {
+		return models.ReactionChange{}, err
+	}
+
+	return p.service.RemoveReaction(ctx, reaction)
+}
+
+func (p ReactionsProxy) validate(reaction models.MessageReaction) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("message_id", reaction.MessageId)
+	validationResults.CheckId("user_id", reaction.UserId)
+	if !validation.ValidEmoji(reaction.Emoji) {
+		validationResults.AddWithParams("emoji", InvalidEmoji, ruleParams(validation.Rules().Emoji))
+	}
+
+	if validationResults.HasErrors() {
+		return validationResults
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+	"models"
+)
+
+type ReadReceiptsProxy struct {
+	service interfaces.ReadReceipts
+}
+
+func NewReadReceiptsProxy(service interfaces.ReadReceipts) ReadReceiptsProxy {
+	return ReadReceiptsProxy{service}
+}
+
+func (p ReadReceiptsProxy) MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("chat_id", receipt.ChatId)
+	validationResults.CheckId("user_id", receipt.UserId)
+	validationResults.CheckId("message_id", receipt.MessageId)
+	if validationResults.HasErrors() {
+		return validationResults
+	}
+
+	return p.service.MarkAsRead(ctx, receipt)
+}
+
+func (p ReadReceiptsProxy) GetUnreadCounts(ctx context.Context, userId uint) (models.UnreadCounts, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("user_id", userId)
+	if validationResults.HasErrors() {
+		return models.UnreadCounts{}, validationResults
+	}
+
+	return p.service.GetUnreadCounts(ctx, userId)
+}
@@ -0,0 +1,11 @@
+package validation
+
+import (
+	"models"
+	"services/proxies/validation/plugins/validation"
+)
+
+// rules are checked by all proxies, so they must be set before services are used
+func SetRules(rules models.ValidationRules) error {
+	return validation.SetRules(rules)
+}
@@ -0,0 +1,162 @@
+package validation
+
+import (
+	"context"
+	mock "mock/services"
+	"models"
+	"services/messages"
+	"strings"
+	"testing"
+	"utils"
+)
+
+// rules are reset to defaults by returned function
+func setRules(rules models.ValidationRules, t *testing.T) func() {
+	if err := SetRules(rules); err != nil {
+		t.Fatal(err)
+	}
+
+	return func() {
+		_ = SetRules(models.ValidationRules{})
+	}
+}
+
+func findFieldError(err error, field string) (models.FieldError, bool) {
+	results, ok := err.(validationResults)
+	if !ok {
+		return models.FieldError{}, false
+	}
+
+	for _, fieldError := range results.Fields() {
+		if fieldError.Field == field {
+			return fieldError, true
+		}
+	}
+
+	return models.FieldError{}, false
+}
+
+func assertFieldError(err error, field, code string, t *testing.T) models.FieldError {
+	fieldError, found := findFieldError(err, field)
+	utils.AssertTrue(found, t)
+	utils.AssertEqual(code, fieldError.Code, t)
+
+	return fieldError
+}
+
+func TestAuthenticationProxy_ConfiguredPasswordRule(t *testing.T) {
+	defer setRules(models.ValidationRules{Password: models.TextRule{MinLength: 12}}, t)()
+
+	err := NewAuthenticationServiceProxy(nil).RegisterUser(
+		context.Background(), models.UserCredentials{Email: "mail@ya.ru", Password: "hello&world"})
+
+	fieldError := assertFieldError(err, "password", InvalidPassword, t)
+	utils.AssertEqual(12, fieldError.Params["min"], t)
+	utils.AssertEqual(32, fieldError.Params["max"], t)
+}
+
+func TestMeetingsProxy_ConfiguredTitleAndTagRules(t *testing.T) {
+	defer setRules(models.ValidationRules{
+		Title: models.TextRule{MaxLength: 5},
+		Name:  models.TextRule{Pattern: `^[a-z]+$`},
+	}, t)()
+
+	settings := models.AllSettings{}
+	settings.Title = "meeting"
+	settings.Label = "place"
+	settings.Tags = []string{"tag", "Тег"}
+	err := NewMeetingsServiceProxy(nil, ContentChecker{}).CreateMeeting(context.Background(), 1, settings)
+
+	fieldError := assertFieldError(err, "title", InvalidMeetingTitle, t)
+	utils.AssertEqual(5, fieldError.Params["max"], t)
+	_, found := findFieldError(err, "label")
+	utils.AssertFalse(found, t)
+	_, found = findFieldError(err, "tags[0]")
+	utils.AssertFalse(found, t)
+	assertFieldError(err, "tags[1]", InvalidMeetingTag, t)
+}
+
+func TestMessagesProxy_ConfiguredMessageRule(t *testing.T) {
+	defer setRules(models.ValidationRules{Message: models.TextRule{MaxLength: 5}}, t)()
+
+	_, err := NewMessagesProxy(nil, ContentChecker{}).Save(
+		context.Background(), models.Message{ChatId: 1, SenderId: 1, Text: "hello world"})
+
+	fieldError := assertFieldError(err, "text", InvalidMessageText, t)
+	utils.AssertEqual(5, fieldError.Params["max"], t)
+}
+
+func TestMessagesProxy_LongerMessageIsAllowedByConfiguredRule(t *testing.T) {
+	defer setRules(models.ValidationRules{Message: models.TextRule{MaxLength: 2048}}, t)()
+
+	text := strings.Repeat("a", 2000)
+	message, err := NewMessagesProxy(messages.New(&mock.MessagesMockRepository), ContentChecker{}).Save(
+		context.Background(), models.Message{ChatId: 1, SenderId: 1, Text: text})
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(text, message.Text, t)
+}
+
+func TestMessagesEditorProxy_ConfiguredMessageRule(t *testing.T) {
+	defer setRules(models.ValidationRules{Message: models.TextRule{Pattern: `^[a-z ]+$`}}, t)()
+
+	_, err := NewMessagesEditorProxy(nil).EditMessage(
+		context.Background(), models.EditMessageRequest{MessageId: 1, UserId: 1, Text: "Привет"})
+
+	assertFieldError(err, "text", InvalidMessageText, t)
+}
+
+func TestParticipationProxy_ConfiguredDescriptionRule(t *testing.T) {
+	defer setRules(models.ValidationRules{Description: models.TextRule{MinLength: 30}}, t)()
+
+	ctx := context.Background()
+	_, err := NewParticipationServiceProxy(nil).HandleParticipationRequest(ctx, models.ParticipationRequest{
+		UserId: 1, MeetingId: 1, RequestDescription: "Some description of request",
+	})
+
+	fieldError := assertFieldError(err, "request_description", InvalidParticipationRequestDescription, t)
+	utils.AssertEqual(30, fieldError.Params["min"], t)
+}
+
+func TestUserSettingsProxy_ConfiguredNicknameAndNameRules(t *testing.T) {
+	defer setRules(models.ValidationRules{
+		Nickname: models.TextRule{Pattern: `^[a-z]+$`},
+		Name:     models.TextRule{MaxLength: 5},
+	}, t)()
+
+	ctx := context.Background()
+	err := NewUserSettingsServiceProxy(nil, ContentChecker{}).UpdateUserSettings(ctx, 1, models.UserSettings{
+		Name: "John Doe", Nickname: "killer228", Age: 20, AvatarUrl: "https://vk.com",
+	})
+
+	assertFieldError(err, "nickname", InvalidUserNickname, t)
+	fieldError := assertFieldError(err, "name", InvalidUserName, t)
+	utils.AssertEqual(5, fieldError.Params["max"], t)
+}
+
+func TestMessagesSearchProxy_ConfiguredSearchTextRule(t *testing.T) {
+	defer setRules(models.ValidationRules{SearchText: models.TextRule{MaxLength: 3}}, t)()
+
+	_, err := NewMessagesSearchProxy(nil).SearchMessages(
+		context.Background(), models.SearchQuery{UserId: 1, Text: "meet", Count: 10})
+
+	assertFieldError(err, "text", InvalidSearchText, t)
+}
+
+func TestAttachmentsProxy_ConfiguredFileNameRule(t *testing.T) {
+	defer setRules(models.ValidationRules{FileName: models.TextRule{MaxLength: 5}}, t)()
+
+	_, err := NewAttachmentsProxy(nil).Upload(
+		context.Background(), models.AttachmentUpload{UploaderId: 1, FileName: "photo.jpg"})
+
+	assertFieldError(err, "file_name", InvalidAttachmentName, t)
+}
+
+func TestReactionsProxy_ConfiguredEmojiRule(t *testing.T) {
+	defer setRules(models.ValidationRules{Emoji: models.TextRule{MaxLength: 1}}, t)()
+
+	_, err := NewReactionsProxy(nil).AddReaction(
+		context.Background(), models.MessageReaction{MessageId: 1, UserId: 1, Emoji: "👍🏽"})
+
+	assertFieldError(err, "emoji", InvalidEmoji, t)
+}
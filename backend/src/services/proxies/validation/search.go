@@ -0,0 +1,33 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"services/proxies/validation/plugins/validation"
+)
+
+type MessagesSearchProxy struct {
+	service interfaces.MessagesSearch
+}
+
+func NewMessagesSearchProxy(service interfaces.MessagesSearch) MessagesSearchProxy {
+	return MessagesSearchProxy{service}
+}
+
+func (p MessagesSearchProxy) SearchMessages(ctx context.Context, query models.SearchQuery) ([]models.SearchHit, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("user_id", query.UserId)
+	if !validation.ValidSearchText(query.Text) {
+		validationResults.AddWithParams("text", InvalidSearchText, ruleParams(validation.Rules().SearchText))
+	}
+	if !validation.ValidWholePositiveNumber(float64(query.Count)) {
+		validationResults.Add("count", InvalidCount)
+	}
+
+	if validationResults.HasErrors() {
+		return nil, validationResults
+	} else {
+		return p.service.SearchMessages(ctx, query)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"interfaces"
 	"models"
 	"net/http"
-	"services/proxies/validation/plugins/validation"
 )
 
 type SessionServiceProxy struct {
@@ -20,9 +19,9 @@ func (p SessionServiceProxy) GetSession(r *http.Request) (map[string]interface{}
 }
 
 func (p SessionServiceProxy) SetSession(r *http.Request, session models.UserSession) error {
-	if !validation.ValidWholePositiveNumber(float64(session.Id)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+	validationResults := validationResults{}
+	validationResults.CheckId("id", session.Id)
+	if validationResults.HasErrors() {
 		return validationResults
 	}
 
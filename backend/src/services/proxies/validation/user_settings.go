@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"context"
 	"interfaces"
 	"models"
 	"services/proxies/validation/plugins/validation"
@@ -8,46 +9,49 @@ import (
 
 type UserSettingsServiceProxy struct {
 	service interfaces.UsersSettings
+	content ContentChecker
 }
 
-func NewUserSettingsServiceProxy(service interfaces.UsersSettings) UserSettingsServiceProxy {
-	return UserSettingsServiceProxy{service}
+func NewUserSettingsServiceProxy(service interfaces.UsersSettings, content ContentChecker) UserSettingsServiceProxy {
+	return UserSettingsServiceProxy{service, content}
 }
 
-func (p UserSettingsServiceProxy) GetUserSettings(userId uint) (models.FullUserInfo, error) {
-	if !validation.ValidWholePositiveNumber(float64(userId)) {
-		validationResults := validationResults{}
-		validationResults.Add(InvalidId)
+func (p UserSettingsServiceProxy) GetUserSettings(ctx context.Context, userId uint) (models.FullUserInfo, error) {
+	validationResults := validationResults{}
+	validationResults.CheckId("user_id", userId)
+	if validationResults.HasErrors() {
 		return models.FullUserInfo{}, validationResults
 	}
 
-	return p.service.GetUserSettings(userId)
+	return p.service.GetUserSettings(ctx, userId)
 }
 
-func (p UserSettingsServiceProxy) UpdateUserSettings(userId uint, info models.UserSettings) error {
+func (p UserSettingsServiceProxy) UpdateUserSettings(ctx context.Context, userId uint, info models.UserSettings) error {
 	validationResults := validationResults{}
-	if !validation.ValidWholePositiveNumber(float64(userId)) {
-		validationResults.Add(InvalidId)
-	}
+	validationResults.CheckId("user_id", userId)
 	if !validation.ValidName(info.Name) {
-		validationResults.Add(InvalidUserName)
+		validationResults.AddWithParams("name", InvalidUserName, ruleParams(validation.Rules().Name))
 	}
 	if !validation.ValidNickname(info.Nickname) {
-		validationResults.Add(InvalidUserNickname)
+		validationResults.AddWithParams("nickname", InvalidUserNickname, ruleParams(validation.Rules().Nickname))
 	}
 	if !validation.ValidGender(info.Gender) {
-		validationResults.Add(InvalidUserGender)
+		validationResults.Add("gender", InvalidUserGender)
 	}
 	if !validation.ValidWholePositiveNumber(float64(info.Age)) {
-		validationResults.Add(InvalidUserAge)
+		validationResults.Add("age", InvalidUserAge)
 	}
 	if !validation.ValidURL(info.AvatarUrl) {
-		validationResults.Add(InvalidUserAvatarURL)
+		validationResults.Add("avatar_url", InvalidUserAvatarURL)
 	}
+	review := p.content.review()
+	review.checkNotMaskable(NicknameContent, userId, "nickname", info.Nickname, &validationResults, InappropriateUserNickname)
 
 	if validationResults.HasErrors() {
 		return validationResults
-	} else {
-		return p.service.UpdateUserSettings(userId, info)
 	}
+
+	err := p.service.UpdateUserSettings(ctx, userId, info)
+	review.saveFlags(ctx, err)
+	return err
 }
@@ -0,0 +1,44 @@
+package validation
+
+import (
+	"context"
+	"interfaces"
+)
+
+type UsersPrivacyProxy struct {
+	service interfaces.UsersPrivacy
+}
+
+func NewUsersPrivacyProxy(service interfaces.UsersPrivacy) UsersPrivacyProxy {
+	return UsersPrivacyProxy{service}
+}
+
+func (p UsersPrivacyProxy) BlockUser(ctx context.Context, userId, blockedUserId uint) error {
+	validationResults := validationResults{}
+	validationResults.checkUsersPair(userId, "blocked_user_id", blockedUserId)
+	if validationResults.HasErrors() {
+		return validationResults
+	}
+
+	return p.service.BlockUser(ctx, userId, blockedUserId)
+}
+
+func (p UsersPrivacyProxy) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	validationResults := validationResults{}
+	validationResults.checkUsersPair(userId, "blocked_user_id", blockedUserId)
+	if validationResults.HasErrors() {
+		return validationResults
+	}
+
+	return p.service.UnblockUser(ctx, userId, blockedUserId)
+}
+
+func (p UsersPrivacyProxy) SetDirectMessagesAllowed(ctx context.Context, userId uint, allowed bool) error {
+	validationResults := validationResults{}
+	validationResults.CheckId("user_id", userId)
+	if validationResults.HasErrors() {
+		return validationResults
+	}
+
+	return p.service.SetDirectMessagesAllowed(ctx, userId, allowed)
+}
@@ -0,0 +1,42 @@
+package reactions
+
+import (
+	"context"
+	"interfaces"
+	"internal_errors"
+	"models"
+	"services/errors"
+)
+
+type Service struct {
+	repository interfaces.Reactions
+}
+
+func New(repository interfaces.Reactions) Service {
+	return Service{repository}
+}
+
+func (s Service) AddReaction(ctx context.Context, reaction models.MessageReaction) (models.ReactionChange, error) {
+	change, err := s.repository.AddReaction(ctx, reaction)
+	return change, s.mapError(err)
+}
+
+func (s Service) RemoveReaction(ctx context.Context, reaction models.MessageReaction) (models.ReactionChange, error) {
+	change, err := s.repository.RemoveReaction(ctx, reaction)
+	return change, s.mapError(err)
+}
+
+func (s Service) mapError(err error) error {
+	switch err {
+	case nil:
+		return nil
+	case internal_errors.UnableToFindMessageById:
+		return errors.MessageNotFound
+	case internal_errors.UnableToFindUserById:
+		return errors.UserIdNotFound
+	case internal_errors.ChatIsArchived:
+		return errors.ChatArchived
+	default:
+		return errors.InternalError
+	}
+}
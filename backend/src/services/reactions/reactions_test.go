@@ -0,0 +1,81 @@
+package reactions
+
+import (
+	"context"
+	repositoriesMock "mock/repositories"
+	mock "mock/services"
+	"models"
+	"services/errors"
+	"testing"
+	"utils"
+)
+
+var service = New(&mock.ReactionsRepository)
+
+func getFirstMessageReaction() models.MessageReaction {
+	message := repositoriesMock.GetAllMessages()[0]
+	return models.MessageReaction{MessageId: message.Id, UserId: 1, Emoji: "👍"}
+}
+
+func TestService_AddReactionSuccess(t *testing.T) {
+	defer mock.ReactionsRepository.ResetState()
+
+	reaction := getFirstMessageReaction()
+	_, _ = service.AddReaction(context.Background(), reaction)
+	change, err := service.AddReaction(context.Background(), reaction)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(repositoriesMock.GetAllMessages()[0].ChatId, change.ChatId, t)
+	utils.AssertEqual(uint(1), change.Count, t)
+}
+
+func TestService_AddReactionMessageNotFound(t *testing.T) {
+	defer mock.ReactionsRepository.ResetState()
+
+	reaction := getFirstMessageReaction()
+	reaction.MessageId = repositoriesMock.GetNotExistsMessageId()
+	_, err := service.AddReaction(context.Background(), reaction)
+
+	utils.AssertErrorsEqual(errors.MessageNotFound, err, t)
+}
+
+func TestService_AddReactionUserNotFound(t *testing.T) {
+	defer mock.ReactionsRepository.ResetState()
+
+	reaction := getFirstMessageReaction()
+	reaction.UserId = repositoriesMock.GetNotExistsUserId()
+	_, err := service.AddReaction(context.Background(), reaction)
+
+	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
+}
+
+func TestService_RemoveReactionSuccess(t *testing.T) {
+	defer mock.ReactionsRepository.ResetState()
+
+	reaction := getFirstMessageReaction()
+	_, _ = service.AddReaction(context.Background(), reaction)
+	change, err := service.RemoveReaction(context.Background(), reaction)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(uint(0), change.Count, t)
+}
+
+func TestService_RemoveReactionInternalError(t *testing.T) {
+	defer mock.ReactionsRepository.ResetState()
+
+	reaction := getFirstMessageReaction()
+	reaction.UserId = mock.BadUserId
+	_, err := service.RemoveReaction(context.Background(), reaction)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_AddReactionToArchivedChat(t *testing.T) {
+	defer mock.ReactionsRepository.ResetState()
+
+	reaction := getFirstMessageReaction()
+	reaction.MessageId = mock.ArchivedChatMessageId
+	_, err := service.AddReaction(context.Background(), reaction)
+
+	utils.AssertErrorsEqual(errors.ChatArchived, err, t)
+}
@@ -0,0 +1,42 @@
+package read_receipts
+
+import (
+	"context"
+	"interfaces"
+	"internal_errors"
+	"models"
+	"services/errors"
+)
+
+type Service struct {
+	repository interfaces.ReadReceiptsRepository
+}
+
+func New(repository interfaces.ReadReceiptsRepository) Service {
+	return Service{repository}
+}
+
+func (s Service) MarkAsRead(ctx context.Context, receipt models.ReadReceipt) error {
+	switch err := s.repository.MarkAsRead(ctx, receipt); err {
+	case nil:
+		return nil
+	case internal_errors.UnableToFindChatMessage:
+		return errors.ChatMessageNotFound
+	default:
+		return errors.InternalError
+	}
+}
+
+func (s Service) GetUnreadCounts(ctx context.Context, userId uint) (models.UnreadCounts, error) {
+	counts, err := s.repository.GetUnreadCounts(ctx, userId)
+	if err != nil {
+		return models.UnreadCounts{}, errors.InternalError
+	}
+
+	unreadCounts := models.UnreadCounts{Chats: counts}
+	for _, count := range counts {
+		unreadCounts.Total += count.Count
+	}
+
+	return unreadCounts, nil
+}
@@ -0,0 +1,64 @@
+package read_receipts
+
+import (
+	"context"
+	repositoriesMock "mock/repositories"
+	mock "mock/services"
+	"services/errors"
+	"testing"
+	"utils"
+)
+
+var service = New(&mock.ReadReceiptsRepository)
+
+func TestService_MarkAsReadSuccess(t *testing.T) {
+	defer mock.ReadReceiptsRepository.ResetState()
+
+	receipt := repositoriesMock.GetFirstChatReadReceipt()
+	before, _ := service.GetUnreadCounts(context.Background(), receipt.UserId)
+	err := service.MarkAsRead(context.Background(), receipt)
+	after, _ := service.GetUnreadCounts(context.Background(), receipt.UserId)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(before.Total > after.Total, t)
+}
+
+func TestService_MarkAsReadMessageNotFound(t *testing.T) {
+	defer mock.ReadReceiptsRepository.ResetState()
+
+	err := service.MarkAsRead(context.Background(), repositoriesMock.GetReadReceiptWithMessageFromAnotherChat())
+
+	utils.AssertErrorsEqual(errors.ChatMessageNotFound, err, t)
+}
+
+func TestService_MarkAsReadInternalError(t *testing.T) {
+	defer mock.ReadReceiptsRepository.ResetState()
+
+	receipt := repositoriesMock.GetFirstChatReadReceipt()
+	receipt.UserId = mock.BadUserId
+	err := service.MarkAsRead(context.Background(), receipt)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_GetUnreadCountsSuccess(t *testing.T) {
+	defer mock.ReadReceiptsRepository.ResetState()
+
+	counts, err := service.GetUnreadCounts(context.Background(), 1)
+
+	utils.AssertNil(err, t)
+	var total uint
+	for _, count := range counts.Chats {
+		total += count.Count
+	}
+	utils.AssertEqual(total, counts.Total, t)
+	utils.AssertTrue(counts.Total > 0, t)
+}
+
+func TestService_GetUnreadCountsInternalError(t *testing.T) {
+	defer mock.ReadReceiptsRepository.ResetState()
+
+	_, err := service.GetUnreadCounts(context.Background(), mock.BadUserId)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
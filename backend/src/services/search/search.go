@@ -0,0 +1,25 @@
+package search
+
+import (
+	"context"
+	"interfaces"
+	"models"
+	"services/errors"
+)
+
+type Service struct {
+	repository interfaces.MessagesSearch
+}
+
+func New(repository interfaces.MessagesSearch) Service {
+	return Service{repository}
+}
+
+func (s Service) SearchMessages(ctx context.Context, query models.SearchQuery) ([]models.SearchHit, error) {
+	hits, err := s.repository.SearchMessages(ctx, query)
+	if err != nil {
+		return nil, errors.InternalError
+	}
+
+	return hits, nil
+}
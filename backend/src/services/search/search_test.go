@@ -0,0 +1,29 @@
+package search
+
+import (
+	"context"
+	repositoriesMock "mock/repositories"
+	mock "mock/services"
+	"services/errors"
+	"testing"
+	"utils"
+)
+
+var service = New(mock.MessagesSearchRepository)
+
+func TestService_SearchMessagesSuccess(t *testing.T) {
+	query := repositoriesMock.GetFirstUserSearchQuery()
+	hits, err := service.SearchMessages(context.Background(), query)
+
+	utils.AssertNil(err, t)
+	utils.AssertEqual(1, len(hits), t)
+	utils.AssertEqual(query.ChatId, hits[0].ChatId, t)
+}
+
+func TestService_SearchMessagesInternalError(t *testing.T) {
+	query := repositoriesMock.GetFirstUserSearchQuery()
+	query.UserId = mock.BadUserId
+	_, err := service.SearchMessages(context.Background(), query)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
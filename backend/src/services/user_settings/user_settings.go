@@ -1,6 +1,7 @@
 package user_settings
 
 import (
+	"context"
 	"interfaces"
 	"internal_errors"
 	"models"
@@ -15,8 +16,8 @@ func New(repository interfaces.UsersSettings) Service {
 	return Service{repository}
 }
 
-func (s Service) GetUserSettings(userId uint) (models.FullUserInfo, error) {
-	info, err := s.repository.GetUserSettings(userId)
+func (s Service) GetUserSettings(ctx context.Context, userId uint) (models.FullUserInfo, error) {
+	info, err := s.repository.GetUserSettings(ctx, userId)
 
 	switch err {
 	case nil:
@@ -28,8 +29,8 @@ func (s Service) GetUserSettings(userId uint) (models.FullUserInfo, error) {
 	}
 }
 
-func (s Service) UpdateUserSettings(userId uint, info models.UserSettings) error {
-	switch err := s.repository.UpdateUserSettings(userId, info); err {
+func (s Service) UpdateUserSettings(ctx context.Context, userId uint, info models.UserSettings) error {
+	switch err := s.repository.UpdateUserSettings(ctx, userId, info); err {
 	case nil:
 		return nil
 	case internal_errors.UnableToFindUserById:
@@ -1,6 +1,7 @@
 package user_settings
 
 import (
+	"context"
 	mock "mock/services"
 	"services/errors"
 	"testing"
@@ -12,19 +13,19 @@ var service = New(&mock.UsersSettingsRepository)
 func TestUsersSettingsService_GetUserInfoSuccess(t *testing.T) {
 	defer mock.UsersSettingsRepository.ResetState()
 
-	full, err := service.GetUserSettings(1)
+	full, err := service.GetUserSettings(context.Background(), 1)
 	utils.AssertNil(err, t)
 	utils.AssertEqual(mock.UsersSettingsRepository.Settings[1].UserSettings, full.UserSettings, t)
 }
 
 func TestUsersSettingsService_GetUserInfoUserNotFoundError(t *testing.T) {
-	_, err := service.GetUserSettings(11)
+	_, err := service.GetUserSettings(context.Background(), 11)
 
 	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
 }
 
 func TestUsersSettingsService_GetUserInfoInternalError(t *testing.T) {
-	_, err := service.GetUserSettings(mock.BadUserId)
+	_, err := service.GetUserSettings(context.Background(), mock.BadUserId)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
@@ -32,19 +33,19 @@ func TestUsersSettingsService_GetUserInfoInternalError(t *testing.T) {
 func TestUsersSettingsService_UpdateUserInfoSuccess(t *testing.T) {
 	defer mock.UsersSettingsRepository.ResetState()
 
-	err := service.UpdateUserSettings(1, mock.NewUserInfo)
+	err := service.UpdateUserSettings(context.Background(), 1, mock.NewUserInfo)
 	utils.AssertNil(err, t)
 	utils.AssertEqual(mock.UsersSettingsRepository.Settings[1].UserSettings, mock.NewUserInfo, t)
 }
 
 func TestUsersSettingsService_UpdateUserInfoUserNotFoundError(t *testing.T) {
-	err := service.UpdateUserSettings(11, mock.NewUserInfo)
+	err := service.UpdateUserSettings(context.Background(), 11, mock.NewUserInfo)
 
 	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
 }
 
 func TestUsersSettingsService_UpdateUserInfoInternalError(t *testing.T) {
-	err := service.UpdateUserSettings(mock.BadUserId, mock.NewUserInfo)
+	err := service.UpdateUserSettings(context.Background(), mock.BadUserId, mock.NewUserInfo)
 
 	utils.AssertErrorsEqual(errors.InternalError, err, t)
 }
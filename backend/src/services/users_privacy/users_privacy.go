@@ -0,0 +1,47 @@
+package users_privacy
+
+import (
+	"context"
+	"interfaces"
+	"internal_errors"
+	"services/errors"
+)
+
+type Service struct {
+	repository interfaces.UsersPrivacyRepository
+}
+
+func New(repository interfaces.UsersPrivacyRepository) Service {
+	return Service{repository}
+}
+
+func (s Service) BlockUser(ctx context.Context, userId, blockedUserId uint) error {
+	switch err := s.repository.BlockUser(ctx, userId, blockedUserId); err {
+	case nil:
+		return nil
+	case internal_errors.UnableToFindUserById:
+		return errors.UserIdNotFound
+	default:
+		return errors.InternalError
+	}
+}
+
+func (s Service) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	switch err := s.repository.UnblockUser(ctx, userId, blockedUserId); err {
+	case nil:
+		return nil
+	default:
+		return errors.InternalError
+	}
+}
+
+func (s Service) SetDirectMessagesAllowed(ctx context.Context, userId uint, allowed bool) error {
+	switch err := s.repository.SetDirectMessagesAllowed(ctx, userId, allowed); err {
+	case nil:
+		return nil
+	case internal_errors.UnableToFindUserById:
+		return errors.UserIdNotFound
+	default:
+		return errors.InternalError
+	}
+}
@@ -0,0 +1,83 @@
+package users_privacy
+
+import (
+	"context"
+	repositoriesMock "mock/repositories"
+	mock "mock/services"
+	"services/errors"
+	"testing"
+	"utils"
+)
+
+var service = New(&mock.UsersPrivacyRepository)
+
+func TestService_BlockUserSuccess(t *testing.T) {
+	defer mock.UsersPrivacyRepository.ResetState()
+
+	err := service.BlockUser(context.Background(), 1, 2)
+	allowed, _ := mock.UsersPrivacyRepository.DirectMessagesAllowed(context.Background(), 2, 1)
+
+	utils.AssertNil(err, t)
+	utils.AssertFalse(allowed, t)
+}
+
+func TestService_BlockUserIdNotFound(t *testing.T) {
+	defer mock.UsersPrivacyRepository.ResetState()
+
+	err := service.BlockUser(context.Background(), 1, repositoriesMock.GetNotExistsUserId())
+
+	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
+}
+
+func TestService_BlockUserInternalError(t *testing.T) {
+	defer mock.UsersPrivacyRepository.ResetState()
+
+	err := service.BlockUser(context.Background(), mock.BadUserId, 1)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_UnblockUserSuccess(t *testing.T) {
+	defer mock.UsersPrivacyRepository.ResetState()
+
+	err := service.UnblockUser(context.Background(), repositoriesMock.UserIdThatBlockedFirstUser, 1)
+	allowed, _ := mock.UsersPrivacyRepository.DirectMessagesAllowed(
+		context.Background(), 1, repositoriesMock.UserIdThatBlockedFirstUser)
+
+	utils.AssertNil(err, t)
+	utils.AssertTrue(allowed, t)
+}
+
+func TestService_UnblockUserInternalError(t *testing.T) {
+	defer mock.UsersPrivacyRepository.ResetState()
+
+	err := service.UnblockUser(context.Background(), mock.BadUserId, 1)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
+
+func TestService_SetDirectMessagesAllowedSuccess(t *testing.T) {
+	defer mock.UsersPrivacyRepository.ResetState()
+
+	err := service.SetDirectMessagesAllowed(context.Background(), 2, false)
+	allowed, _ := mock.UsersPrivacyRepository.DirectMessagesAllowed(context.Background(), 1, 2)
+
+	utils.AssertNil(err, t)
+	utils.AssertFalse(allowed, t)
+}
+
+func TestService_SetDirectMessagesAllowedUserNotFound(t *testing.T) {
+	defer mock.UsersPrivacyRepository.ResetState()
+
+	err := service.SetDirectMessagesAllowed(context.Background(), repositoriesMock.GetNotExistsUserId(), false)
+
+	utils.AssertErrorsEqual(errors.UserIdNotFound, err, t)
+}
+
+func TestService_SetDirectMessagesAllowedInternalError(t *testing.T) {
+	defer mock.UsersPrivacyRepository.ResetState()
+
+	err := service.SetDirectMessagesAllowed(context.Background(), mock.BadUserId, false)
+
+	utils.AssertErrorsEqual(errors.InternalError, err, t)
+}
@@ -19,6 +19,10 @@ type RequestData struct {
 	Router                 *mux.Router
 	Method, Endpoint, Data string
 	Cookie                 *http.Cookie
+	// JSON is used if content type is not set
+	ContentType string
+	// Accept-Language header, not sent if empty
+	Language string
 }
 
 func GetTestServer(r *mux.Router) *httptest.Server {
@@ -30,6 +34,12 @@ func MakeRequest(rd RequestData) io.ReadCloser {
 	defer srv.Close()
 
 	req := getHttpRequest(rd.Method, fmt.Sprintf("%s/%s", srv.URL, rd.Endpoint), rd.Data)
+	if rd.ContentType != "" {
+		req.Header.Set("Content-Type", rd.ContentType)
+	}
+	if rd.Language != "" {
+		req.Header.Set("Accept-Language", rd.Language)
+	}
 	req.AddCookie(rd.Cookie)
 	return doRequestAndGetBody(req)
 }
@@ -9,7 +9,7 @@ import {ErrorServerResponse} from '../../types/models';
 export class MessagesService implements IMessagesObservable {
   private connectionEstablished = false;
   private readonly messagesObservers: Set<IMessagesObserver> = new Set<IMessagesObserver>();
-  private readonly ws = new WebSocket(`ws://${environment.domain}/api/ws/`);
+  private readonly ws = new WebSocket(`ws://${environment.domain}/api/v1/ws`);
 
   constructor() {
     this.ws.onopen = () => this.connectionEstablished = true;
@@ -1,6 +1,6 @@
 import { Injectable } from '@angular/core';
 import {CsrfService} from './classes/csrf/csrf';
-import {HttpClient, HttpRequest, HttpResponse} from '@angular/common/http';
+import {HttpClient, HttpErrorResponse, HttpRequest, HttpResponse} from '@angular/common/http';
 
 @Injectable({
   providedIn: 'root'
@@ -13,14 +13,14 @@ export class RequestService {
   ) { }
 
   private static getAPIUrl(endpoint: string): string {
-    return `/api/${endpoint}`;
+    return `/api/v1/${endpoint}`;
   }
 
   public async get<T>(endpoint: string): Promise<T> {
-    const response = (await this.http.request(new HttpRequest(
+    const response = await this.send<T>(new HttpRequest(
       'GET',
       RequestService.getAPIUrl(endpoint)
-    )).toPromise()) as HttpResponse<T>;
+    ));
     const csrfPublicKey = response.headers.get(this.csrf.csrfHeaderName);
 
     if (!!csrfPublicKey) {
@@ -60,9 +60,21 @@ export class RequestService {
       .set('Content-Type', 'application/json')
       .set(csrfHeader.name, csrfHeader.value);
 
-    const response = (await this.http.request(request).toPromise()) as HttpResponse<T>;
+    const response = await this.send<T>(request);
     this.csrf.setPublicKey(response.headers.get(this.csrf.csrfHeaderName));
 
     return response.body;
   }
+
+  // API errors have 4xx/5xx status with JSON body, callers check status of body as before
+  private async send<T>(request: HttpRequest<any>): Promise<HttpResponse<T>> {
+    try {
+      return (await this.http.request(request).toPromise()) as HttpResponse<T>;
+    } catch (e) {
+      if (e instanceof HttpErrorResponse && !!e.error && e.error.status === 'error') {
+        return new HttpResponse<T>({body: e.error, headers: e.headers, status: e.status});
+      }
+      throw e;
+    }
+  }
 }
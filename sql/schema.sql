@@ -2,7 +2,7 @@
 
 CREATE TYPE GENDER AS ENUM('male', 'female', '');
 CREATE TYPE MEETING_STATUS AS ENUM('pending', 'archived');
-CREATE TYPE CHAT_TYPE AS ENUM('meeting', 'meeting_request');
+CREATE TYPE CHAT_TYPE AS ENUM('meeting', 'meeting_request', 'direct');
 CREATE TYPE CHAT_STATUS AS ENUM('chatting', 'archived');
 
 CREATE TABLE IF NOT EXISTS users(
@@ -24,7 +24,8 @@ CREATE TABLE IF NOT EXISTS users_info(
 	nickname VARCHAR(255) NOT NULL,
 	gender GENDER DEFAULT '',
 	age INTEGER DEFAULT 0,
-	avatar_url VARCHAR DEFAULT ''
+	avatar_url VARCHAR DEFAULT '',
+	direct_messages_allowed BOOLEAN DEFAULT TRUE
 );
 
 CREATE TABLE IF NOT EXISTS users_rating(
@@ -35,6 +36,13 @@ CREATE TABLE IF NOT EXISTS users_rating(
 	UNIQUE (user_id, tag)
 );
 
+CREATE TABLE IF NOT EXISTS users_blocks(
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	blocked_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	UNIQUE (user_id, blocked_user_id)
+);
+
 CREATE TABLE IF NOT EXISTS meetings(
 	id SERIAL PRIMARY KEY,
 	admin_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
@@ -68,16 +76,106 @@ CREATE TABLE IF NOT EXISTS meetings_places(
 
 CREATE TABLE IF NOT EXISTS chats(
 	id SERIAL PRIMARY KEY,
-	meeting_id INTEGER NOT NULL REFERENCES meetings(id) ON DELETE CASCADE,
+	meeting_id INTEGER DEFAULT NULL REFERENCES meetings(id) ON DELETE CASCADE,
+	-- user that requested participation in meeting, set only for meeting_request chats
+	applicant_id INTEGER DEFAULT NULL REFERENCES users(id) ON DELETE CASCADE,
 	type CHAT_TYPE NOT NULL,
 	status CHAT_STATUS DEFAULT 'chatting',
 	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+-- applicant has at most one undecided participation request per meeting
+CREATE UNIQUE INDEX IF NOT EXISTS chats_meeting_request_idx ON chats(meeting_id, applicant_id)
+WHERE type = 'meeting_request' AND status != 'archived';
+
+CREATE TABLE IF NOT EXISTS direct_chats(
+	id SERIAL PRIMARY KEY,
+	chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+	first_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	second_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	CHECK (first_user_id < second_user_id),
+	UNIQUE (first_user_id, second_user_id)
+);
+
+CREATE TABLE IF NOT EXISTS chats_members(
+	id SERIAL PRIMARY KEY,
+	chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	last_read_message_id INTEGER DEFAULT NULL,
+	joined_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (chat_id, user_id)
+);
+
 CREATE TABLE IF NOT EXISTS messages(
 	id SERIAL PRIMARY KEY,
 	chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
 	sender_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
 	text TEXT NOT NULL,
-	sending_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	sending_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	edited_at TIMESTAMP DEFAULT NULL,
+	-- deleted message is kept as tombstone without text
+	deleted_at TIMESTAMP DEFAULT NULL,
+	reply_to INTEGER DEFAULT NULL REFERENCES messages(id) ON DELETE SET NULL,
+	-- the first message of replies chain, NULL for messages that are not replies
+	thread_root_id INTEGER DEFAULT NULL REFERENCES messages(id) ON DELETE SET NULL,
+	-- announcement can be sent only by meeting admin, members are notified about it
+	announcement BOOLEAN NOT NULL DEFAULT FALSE,
+	-- NULL for not pinned messages
+	pinned_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS messages_thread_root_id_idx ON messages(thread_root_id);
+-- russian configuration stems english words too, search queries must use the same expression
+CREATE INDEX IF NOT EXISTS messages_text_search_idx ON messages USING GIN (to_tsvector('russian', text));
+CREATE INDEX IF NOT EXISTS messages_pinned_idx ON messages(chat_id, pinned_at) WHERE pinned_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS notifications(
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (user_id, message_id)
+);
+
+CREATE TABLE IF NOT EXISTS attachments(
+	id SERIAL PRIMARY KEY,
+	uploader_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	-- NULL until message with attachment is sent
+	message_id INTEGER DEFAULT NULL REFERENCES messages(id) ON DELETE SET NULL,
+	file_name VARCHAR(255) NOT NULL,
+	mime_type VARCHAR(127) NOT NULL,
+	size BIGINT NOT NULL,
+	blob_id VARCHAR(64) NOT NULL UNIQUE,
+	has_thumbnail BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS attachments_message_id_idx ON attachments(message_id);
+
+CREATE TABLE IF NOT EXISTS messages_reactions(
+	id SERIAL PRIMARY KEY,
+	message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	emoji VARCHAR(32) NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (message_id, user_id, emoji)
+);
+
+-- previous versions of edited messages
+CREATE TABLE IF NOT EXISTS messages_edits(
+	id SERIAL PRIMARY KEY,
+	message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+	text TEXT NOT NULL,
+	edited_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- texts saved despite of content filter violations, they wait for moderator review
+CREATE TABLE IF NOT EXISTS moderation_flags(
+	id SERIAL PRIMARY KEY,
+	-- message, meeting, meeting_settings or nickname
+	kind VARCHAR(16) NOT NULL,
+	source_id INTEGER NOT NULL,
+	text TEXT NOT NULL,
+	reasons TEXT[] NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );